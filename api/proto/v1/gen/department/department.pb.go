@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.0
+// 	protoc-gen-go v1.36.10
 // 	protoc        v5.28.3
 // source: department.proto
 
@@ -11,8 +11,10 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -22,20 +24,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StaffingEnforcement int32
+
+const (
+	StaffingEnforcement_STAFFING_ENFORCEMENT_UNSPECIFIED StaffingEnforcement = 0
+	StaffingEnforcement_STAFFING_ENFORCEMENT_WARN        StaffingEnforcement = 1
+	StaffingEnforcement_STAFFING_ENFORCEMENT_BLOCK       StaffingEnforcement = 2
+)
+
+// Enum value maps for StaffingEnforcement.
+var (
+	StaffingEnforcement_name = map[int32]string{
+		0: "STAFFING_ENFORCEMENT_UNSPECIFIED",
+		1: "STAFFING_ENFORCEMENT_WARN",
+		2: "STAFFING_ENFORCEMENT_BLOCK",
+	}
+	StaffingEnforcement_value = map[string]int32{
+		"STAFFING_ENFORCEMENT_UNSPECIFIED": 0,
+		"STAFFING_ENFORCEMENT_WARN":        1,
+		"STAFFING_ENFORCEMENT_BLOCK":       2,
+	}
+)
+
+func (x StaffingEnforcement) Enum() *StaffingEnforcement {
+	p := new(StaffingEnforcement)
+	*p = x
+	return p
+}
+
+func (x StaffingEnforcement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffingEnforcement) Descriptor() protoreflect.EnumDescriptor {
+	return file_department_proto_enumTypes[0].Descriptor()
+}
+
+func (StaffingEnforcement) Type() protoreflect.EnumType {
+	return &file_department_proto_enumTypes[0]
+}
+
+func (x StaffingEnforcement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffingEnforcement.Descriptor instead.
+func (StaffingEnforcement) EnumDescriptor() ([]byte, []int) {
+	return file_department_proto_rawDescGZIP(), []int{0}
+}
+
 type Department struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ManagerId     string                 `protobuf:"bytes,4,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
-	ManagerName   string                 `protobuf:"bytes,5,opt,name=manager_name,json=managerName,proto3" json:"manager_name,omitempty"`
-	EmployeeCount int32                  `protobuf:"varint,6,opt,name=employee_count,json=employeeCount,proto3" json:"employee_count,omitempty"`
-	Budget        float64                `protobuf:"fixed64,7,opt,name=budget,proto3" json:"budget,omitempty"`
-	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ManagerId             string                 `protobuf:"bytes,4,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	ManagerName           string                 `protobuf:"bytes,5,opt,name=manager_name,json=managerName,proto3" json:"manager_name,omitempty"`
+	EmployeeCount         int32                  `protobuf:"varint,6,opt,name=employee_count,json=employeeCount,proto3" json:"employee_count,omitempty"`
+	Budget                float64                `protobuf:"fixed64,7,opt,name=budget,proto3" json:"budget,omitempty"`
+	Location              string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MaxConcurrentAbsences int32                  `protobuf:"varint,11,opt,name=max_concurrent_absences,json=maxConcurrentAbsences,proto3" json:"max_concurrent_absences,omitempty"`
+	StaffingEnforcement   StaffingEnforcement    `protobuf:"varint,12,opt,name=staffing_enforcement,json=staffingEnforcement,proto3,enum=hr.department.v1.StaffingEnforcement" json:"staffing_enforcement,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Department) Reset() {
@@ -138,15 +191,31 @@ func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Department) GetMaxConcurrentAbsences() int32 {
+	if x != nil {
+		return x.MaxConcurrentAbsences
+	}
+	return 0
+}
+
+func (x *Department) GetStaffingEnforcement() StaffingEnforcement {
+	if x != nil {
+		return x.StaffingEnforcement
+	}
+	return StaffingEnforcement_STAFFING_ENFORCEMENT_UNSPECIFIED
+}
+
 type CreateDepartmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ManagerId     string                 `protobuf:"bytes,3,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
-	Budget        float64                `protobuf:"fixed64,4,opt,name=budget,proto3" json:"budget,omitempty"`
-	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ManagerId             string                 `protobuf:"bytes,3,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	Budget                float64                `protobuf:"fixed64,4,opt,name=budget,proto3" json:"budget,omitempty"`
+	Location              string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	MaxConcurrentAbsences int32                  `protobuf:"varint,6,opt,name=max_concurrent_absences,json=maxConcurrentAbsences,proto3" json:"max_concurrent_absences,omitempty"`
+	StaffingEnforcement   StaffingEnforcement    `protobuf:"varint,7,opt,name=staffing_enforcement,json=staffingEnforcement,proto3,enum=hr.department.v1.StaffingEnforcement" json:"staffing_enforcement,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CreateDepartmentRequest) Reset() {
@@ -214,6 +283,20 @@ func (x *CreateDepartmentRequest) GetLocation() string {
 	return ""
 }
 
+func (x *CreateDepartmentRequest) GetMaxConcurrentAbsences() int32 {
+	if x != nil {
+		return x.MaxConcurrentAbsences
+	}
+	return 0
+}
+
+func (x *CreateDepartmentRequest) GetStaffingEnforcement() StaffingEnforcement {
+	if x != nil {
+		return x.StaffingEnforcement
+	}
+	return StaffingEnforcement_STAFFING_ENFORCEMENT_UNSPECIFIED
+}
+
 type CreateDepartmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
@@ -347,15 +430,17 @@ func (x *GetDepartmentResponse) GetDepartment() *Department {
 }
 
 type UpdateDepartmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ManagerId     string                 `protobuf:"bytes,4,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
-	Budget        float64                `protobuf:"fixed64,5,opt,name=budget,proto3" json:"budget,omitempty"`
-	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ManagerId             string                 `protobuf:"bytes,4,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	Budget                float64                `protobuf:"fixed64,5,opt,name=budget,proto3" json:"budget,omitempty"`
+	Location              string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	MaxConcurrentAbsences *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=max_concurrent_absences,json=maxConcurrentAbsences,proto3" json:"max_concurrent_absences,omitempty"`
+	StaffingEnforcement   StaffingEnforcement    `protobuf:"varint,8,opt,name=staffing_enforcement,json=staffingEnforcement,proto3,enum=hr.department.v1.StaffingEnforcement" json:"staffing_enforcement,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *UpdateDepartmentRequest) Reset() {
@@ -430,6 +515,20 @@ func (x *UpdateDepartmentRequest) GetLocation() string {
 	return ""
 }
 
+func (x *UpdateDepartmentRequest) GetMaxConcurrentAbsences() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxConcurrentAbsences
+	}
+	return nil
+}
+
+func (x *UpdateDepartmentRequest) GetStaffingEnforcement() StaffingEnforcement {
+	if x != nil {
+		return x.StaffingEnforcement
+	}
+	return StaffingEnforcement_STAFFING_ENFORCEMENT_UNSPECIFIED
+}
+
 type UpdateDepartmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
@@ -648,184 +747,139 @@ func (x *ListDepartmentsResponse) GetPageSize() int32 {
 
 var File_department_proto protoreflect.FileDescriptor
 
-var file_department_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x10, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xe5, 0x02, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x17, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x62, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x58, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x55, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a,
-	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x61, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0xab, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x32, 0x8a, 0x04, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x68, 0x72, 0x2e,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x29, 0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x66, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29,
-	0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x72, 0x2e, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x68, 0x72, 0x2e, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x72, 0x2e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x2c, 0x5a, 0x2a, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x3b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_department_proto_rawDesc = "" +
+	"\n" +
+	"\x10department.proto\x12\x10hr.department.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xf7\x03\n" +
+	"\n" +
+	"Department\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x04 \x01(\tR\tmanagerId\x12!\n" +
+	"\fmanager_name\x18\x05 \x01(\tR\vmanagerName\x12%\n" +
+	"\x0eemployee_count\x18\x06 \x01(\x05R\remployeeCount\x12\x16\n" +
+	"\x06budget\x18\a \x01(\x01R\x06budget\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
+	"\x17max_concurrent_absences\x18\v \x01(\x05R\x15maxConcurrentAbsences\x12X\n" +
+	"\x14staffing_enforcement\x18\f \x01(\x0e2%.hr.department.v1.StaffingEnforcementR\x13staffingEnforcement\"\xb4\x02\n" +
+	"\x17CreateDepartmentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x03 \x01(\tR\tmanagerId\x12\x16\n" +
+	"\x06budget\x18\x04 \x01(\x01R\x06budget\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x126\n" +
+	"\x17max_concurrent_absences\x18\x06 \x01(\x05R\x15maxConcurrentAbsences\x12X\n" +
+	"\x14staffing_enforcement\x18\a \x01(\x0e2%.hr.department.v1.StaffingEnforcementR\x13staffingEnforcement\"X\n" +
+	"\x18CreateDepartmentResponse\x12<\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x1c.hr.department.v1.DepartmentR\n" +
+	"department\"&\n" +
+	"\x14GetDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x15GetDepartmentResponse\x12<\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x1c.hr.department.v1.DepartmentR\n" +
+	"department\"\xe1\x02\n" +
+	"\x17UpdateDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x04 \x01(\tR\tmanagerId\x12\x16\n" +
+	"\x06budget\x18\x05 \x01(\x01R\x06budget\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12S\n" +
+	"\x17max_concurrent_absences\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\x15maxConcurrentAbsences\x12X\n" +
+	"\x14staffing_enforcement\x18\b \x01(\x0e2%.hr.department.v1.StaffingEnforcementR\x13staffingEnforcement\"X\n" +
+	"\x18UpdateDepartmentResponse\x12<\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x1c.hr.department.v1.DepartmentR\n" +
+	"department\")\n" +
+	"\x17DeleteDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"a\n" +
+	"\x16ListDepartmentsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\"\xab\x01\n" +
+	"\x17ListDepartmentsResponse\x12>\n" +
+	"\vdepartments\x18\x01 \x03(\v2\x1c.hr.department.v1.DepartmentR\vdepartments\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*z\n" +
+	"\x13StaffingEnforcement\x12$\n" +
+	" STAFFING_ENFORCEMENT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19STAFFING_ENFORCEMENT_WARN\x10\x01\x12\x1e\n" +
+	"\x1aSTAFFING_ENFORCEMENT_BLOCK\x10\x022\x8a\x04\n" +
+	"\x11DepartmentService\x12`\n" +
+	"\rGetDepartment\x12&.hr.department.v1.GetDepartmentRequest\x1a'.hr.department.v1.GetDepartmentResponse\x12U\n" +
+	"\x10DeleteDepartment\x12).hr.department.v1.DeleteDepartmentRequest\x1a\x16.google.protobuf.Empty\x12f\n" +
+	"\x0fListDepartments\x12(.hr.department.v1.ListDepartmentsRequest\x1a).hr.department.v1.ListDepartmentsResponse\x12i\n" +
+	"\x10CreateDepartment\x12).hr.department.v1.CreateDepartmentRequest\x1a*.hr.department.v1.CreateDepartmentResponse\x12i\n" +
+	"\x10UpdateDepartment\x12).hr.department.v1.UpdateDepartmentRequest\x1a*.hr.department.v1.UpdateDepartmentResponseB,Z*./api/proto/v1/gen/department;departmentv1b\x06proto3"
 
 var (
 	file_department_proto_rawDescOnce sync.Once
-	file_department_proto_rawDescData = file_department_proto_rawDesc
+	file_department_proto_rawDescData []byte
 )
 
 func file_department_proto_rawDescGZIP() []byte {
 	file_department_proto_rawDescOnce.Do(func() {
-		file_department_proto_rawDescData = protoimpl.X.CompressGZIP(file_department_proto_rawDescData)
+		file_department_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_department_proto_rawDesc), len(file_department_proto_rawDesc)))
 	})
 	return file_department_proto_rawDescData
 }
 
+var file_department_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_department_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_department_proto_goTypes = []any{
-	(*Department)(nil),               // 0: hr.department.v1.Department
-	(*CreateDepartmentRequest)(nil),  // 1: hr.department.v1.CreateDepartmentRequest
-	(*CreateDepartmentResponse)(nil), // 2: hr.department.v1.CreateDepartmentResponse
-	(*GetDepartmentRequest)(nil),     // 3: hr.department.v1.GetDepartmentRequest
-	(*GetDepartmentResponse)(nil),    // 4: hr.department.v1.GetDepartmentResponse
-	(*UpdateDepartmentRequest)(nil),  // 5: hr.department.v1.UpdateDepartmentRequest
-	(*UpdateDepartmentResponse)(nil), // 6: hr.department.v1.UpdateDepartmentResponse
-	(*DeleteDepartmentRequest)(nil),  // 7: hr.department.v1.DeleteDepartmentRequest
-	(*ListDepartmentsRequest)(nil),   // 8: hr.department.v1.ListDepartmentsRequest
-	(*ListDepartmentsResponse)(nil),  // 9: hr.department.v1.ListDepartmentsResponse
-	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 11: google.protobuf.Empty
+	(StaffingEnforcement)(0),         // 0: hr.department.v1.StaffingEnforcement
+	(*Department)(nil),               // 1: hr.department.v1.Department
+	(*CreateDepartmentRequest)(nil),  // 2: hr.department.v1.CreateDepartmentRequest
+	(*CreateDepartmentResponse)(nil), // 3: hr.department.v1.CreateDepartmentResponse
+	(*GetDepartmentRequest)(nil),     // 4: hr.department.v1.GetDepartmentRequest
+	(*GetDepartmentResponse)(nil),    // 5: hr.department.v1.GetDepartmentResponse
+	(*UpdateDepartmentRequest)(nil),  // 6: hr.department.v1.UpdateDepartmentRequest
+	(*UpdateDepartmentResponse)(nil), // 7: hr.department.v1.UpdateDepartmentResponse
+	(*DeleteDepartmentRequest)(nil),  // 8: hr.department.v1.DeleteDepartmentRequest
+	(*ListDepartmentsRequest)(nil),   // 9: hr.department.v1.ListDepartmentsRequest
+	(*ListDepartmentsResponse)(nil),  // 10: hr.department.v1.ListDepartmentsResponse
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil),    // 12: google.protobuf.Int32Value
+	(*emptypb.Empty)(nil),            // 13: google.protobuf.Empty
 }
 var file_department_proto_depIdxs = []int32{
-	10, // 0: hr.department.v1.Department.created_at:type_name -> google.protobuf.Timestamp
-	10, // 1: hr.department.v1.Department.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: hr.department.v1.CreateDepartmentResponse.department:type_name -> hr.department.v1.Department
-	0,  // 3: hr.department.v1.GetDepartmentResponse.department:type_name -> hr.department.v1.Department
-	0,  // 4: hr.department.v1.UpdateDepartmentResponse.department:type_name -> hr.department.v1.Department
-	0,  // 5: hr.department.v1.ListDepartmentsResponse.departments:type_name -> hr.department.v1.Department
-	3,  // 6: hr.department.v1.DepartmentService.GetDepartment:input_type -> hr.department.v1.GetDepartmentRequest
-	7,  // 7: hr.department.v1.DepartmentService.DeleteDepartment:input_type -> hr.department.v1.DeleteDepartmentRequest
-	8,  // 8: hr.department.v1.DepartmentService.ListDepartments:input_type -> hr.department.v1.ListDepartmentsRequest
-	1,  // 9: hr.department.v1.DepartmentService.CreateDepartment:input_type -> hr.department.v1.CreateDepartmentRequest
-	5,  // 10: hr.department.v1.DepartmentService.UpdateDepartment:input_type -> hr.department.v1.UpdateDepartmentRequest
-	4,  // 11: hr.department.v1.DepartmentService.GetDepartment:output_type -> hr.department.v1.GetDepartmentResponse
-	11, // 12: hr.department.v1.DepartmentService.DeleteDepartment:output_type -> google.protobuf.Empty
-	9,  // 13: hr.department.v1.DepartmentService.ListDepartments:output_type -> hr.department.v1.ListDepartmentsResponse
-	2,  // 14: hr.department.v1.DepartmentService.CreateDepartment:output_type -> hr.department.v1.CreateDepartmentResponse
-	6,  // 15: hr.department.v1.DepartmentService.UpdateDepartment:output_type -> hr.department.v1.UpdateDepartmentResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	11, // 0: hr.department.v1.Department.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: hr.department.v1.Department.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: hr.department.v1.Department.staffing_enforcement:type_name -> hr.department.v1.StaffingEnforcement
+	0,  // 3: hr.department.v1.CreateDepartmentRequest.staffing_enforcement:type_name -> hr.department.v1.StaffingEnforcement
+	1,  // 4: hr.department.v1.CreateDepartmentResponse.department:type_name -> hr.department.v1.Department
+	1,  // 5: hr.department.v1.GetDepartmentResponse.department:type_name -> hr.department.v1.Department
+	12, // 6: hr.department.v1.UpdateDepartmentRequest.max_concurrent_absences:type_name -> google.protobuf.Int32Value
+	0,  // 7: hr.department.v1.UpdateDepartmentRequest.staffing_enforcement:type_name -> hr.department.v1.StaffingEnforcement
+	1,  // 8: hr.department.v1.UpdateDepartmentResponse.department:type_name -> hr.department.v1.Department
+	1,  // 9: hr.department.v1.ListDepartmentsResponse.departments:type_name -> hr.department.v1.Department
+	4,  // 10: hr.department.v1.DepartmentService.GetDepartment:input_type -> hr.department.v1.GetDepartmentRequest
+	8,  // 11: hr.department.v1.DepartmentService.DeleteDepartment:input_type -> hr.department.v1.DeleteDepartmentRequest
+	9,  // 12: hr.department.v1.DepartmentService.ListDepartments:input_type -> hr.department.v1.ListDepartmentsRequest
+	2,  // 13: hr.department.v1.DepartmentService.CreateDepartment:input_type -> hr.department.v1.CreateDepartmentRequest
+	6,  // 14: hr.department.v1.DepartmentService.UpdateDepartment:input_type -> hr.department.v1.UpdateDepartmentRequest
+	5,  // 15: hr.department.v1.DepartmentService.GetDepartment:output_type -> hr.department.v1.GetDepartmentResponse
+	13, // 16: hr.department.v1.DepartmentService.DeleteDepartment:output_type -> google.protobuf.Empty
+	10, // 17: hr.department.v1.DepartmentService.ListDepartments:output_type -> hr.department.v1.ListDepartmentsResponse
+	3,  // 18: hr.department.v1.DepartmentService.CreateDepartment:output_type -> hr.department.v1.CreateDepartmentResponse
+	7,  // 19: hr.department.v1.DepartmentService.UpdateDepartment:output_type -> hr.department.v1.UpdateDepartmentResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_department_proto_init() }
@@ -837,18 +891,18 @@ func file_department_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_department_proto_rawDesc,
-			NumEnums:      0,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_department_proto_rawDesc), len(file_department_proto_rawDesc)),
+			NumEnums:      1,
 			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_department_proto_goTypes,
 		DependencyIndexes: file_department_proto_depIdxs,
+		EnumInfos:         file_department_proto_enumTypes,
 		MessageInfos:      file_department_proto_msgTypes,
 	}.Build()
 	File_department_proto = out.File
-	file_department_proto_rawDesc = nil
 	file_department_proto_goTypes = nil
 	file_department_proto_depIdxs = nil
 }
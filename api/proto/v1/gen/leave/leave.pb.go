@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.0
+// 	protoc-gen-go v1.36.10
 // 	protoc        v5.28.3
 // source: leave.proto
 
@@ -13,6 +13,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -22,6 +23,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ApproverType int32
+
+const (
+	ApproverType_APPROVER_TYPE_UNSPECIFIED        ApproverType = 0
+	ApproverType_APPROVER_TYPE_MANAGER            ApproverType = 1
+	ApproverType_APPROVER_TYPE_DEPARTMENT_MANAGER ApproverType = 2
+	ApproverType_APPROVER_TYPE_HR                 ApproverType = 3
+)
+
+// Enum value maps for ApproverType.
+var (
+	ApproverType_name = map[int32]string{
+		0: "APPROVER_TYPE_UNSPECIFIED",
+		1: "APPROVER_TYPE_MANAGER",
+		2: "APPROVER_TYPE_DEPARTMENT_MANAGER",
+		3: "APPROVER_TYPE_HR",
+	}
+	ApproverType_value = map[string]int32{
+		"APPROVER_TYPE_UNSPECIFIED":        0,
+		"APPROVER_TYPE_MANAGER":            1,
+		"APPROVER_TYPE_DEPARTMENT_MANAGER": 2,
+		"APPROVER_TYPE_HR":                 3,
+	}
+)
+
+func (x ApproverType) Enum() *ApproverType {
+	p := new(ApproverType)
+	*p = x
+	return p
+}
+
+func (x ApproverType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApproverType) Descriptor() protoreflect.EnumDescriptor {
+	return file_leave_proto_enumTypes[0].Descriptor()
+}
+
+func (ApproverType) Type() protoreflect.EnumType {
+	return &file_leave_proto_enumTypes[0]
+}
+
+func (x ApproverType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApproverType.Descriptor instead.
+func (ApproverType) EnumDescriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{0}
+}
+
+type ApprovalStepStatus int32
+
+const (
+	ApprovalStepStatus_APPROVAL_STEP_STATUS_UNSPECIFIED ApprovalStepStatus = 0
+	ApprovalStepStatus_APPROVAL_STEP_STATUS_PENDING     ApprovalStepStatus = 1
+	ApprovalStepStatus_APPROVAL_STEP_STATUS_APPROVED    ApprovalStepStatus = 2
+	ApprovalStepStatus_APPROVAL_STEP_STATUS_REJECTED    ApprovalStepStatus = 3
+	ApprovalStepStatus_APPROVAL_STEP_STATUS_SKIPPED     ApprovalStepStatus = 4
+)
+
+// Enum value maps for ApprovalStepStatus.
+var (
+	ApprovalStepStatus_name = map[int32]string{
+		0: "APPROVAL_STEP_STATUS_UNSPECIFIED",
+		1: "APPROVAL_STEP_STATUS_PENDING",
+		2: "APPROVAL_STEP_STATUS_APPROVED",
+		3: "APPROVAL_STEP_STATUS_REJECTED",
+		4: "APPROVAL_STEP_STATUS_SKIPPED",
+	}
+	ApprovalStepStatus_value = map[string]int32{
+		"APPROVAL_STEP_STATUS_UNSPECIFIED": 0,
+		"APPROVAL_STEP_STATUS_PENDING":     1,
+		"APPROVAL_STEP_STATUS_APPROVED":    2,
+		"APPROVAL_STEP_STATUS_REJECTED":    3,
+		"APPROVAL_STEP_STATUS_SKIPPED":     4,
+	}
+)
+
+func (x ApprovalStepStatus) Enum() *ApprovalStepStatus {
+	p := new(ApprovalStepStatus)
+	*p = x
+	return p
+}
+
+func (x ApprovalStepStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalStepStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_leave_proto_enumTypes[1].Descriptor()
+}
+
+func (ApprovalStepStatus) Type() protoreflect.EnumType {
+	return &file_leave_proto_enumTypes[1]
+}
+
+func (x ApprovalStepStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalStepStatus.Descriptor instead.
+func (ApprovalStepStatus) EnumDescriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{1}
+}
+
 type LeaveType int32
 
 const (
@@ -32,6 +140,7 @@ const (
 	LeaveType_LEAVE_TYPE_PATERNITY   LeaveType = 4
 	LeaveType_LEAVE_TYPE_EMERGENCY   LeaveType = 5
 	LeaveType_LEAVE_TYPE_PERSONAL    LeaveType = 6
+	LeaveType_LEAVE_TYPE_COMP_OFF    LeaveType = 7
 )
 
 // Enum value maps for LeaveType.
@@ -44,6 +153,7 @@ var (
 		4: "LEAVE_TYPE_PATERNITY",
 		5: "LEAVE_TYPE_EMERGENCY",
 		6: "LEAVE_TYPE_PERSONAL",
+		7: "LEAVE_TYPE_COMP_OFF",
 	}
 	LeaveType_value = map[string]int32{
 		"LEAVE_TYPE_UNSPECIFIED": 0,
@@ -53,6 +163,7 @@ var (
 		"LEAVE_TYPE_PATERNITY":   4,
 		"LEAVE_TYPE_EMERGENCY":   5,
 		"LEAVE_TYPE_PERSONAL":    6,
+		"LEAVE_TYPE_COMP_OFF":    7,
 	}
 )
 
@@ -67,11 +178,11 @@ func (x LeaveType) String() string {
 }
 
 func (LeaveType) Descriptor() protoreflect.EnumDescriptor {
-	return file_leave_proto_enumTypes[0].Descriptor()
+	return file_leave_proto_enumTypes[2].Descriptor()
 }
 
 func (LeaveType) Type() protoreflect.EnumType {
-	return &file_leave_proto_enumTypes[0]
+	return &file_leave_proto_enumTypes[2]
 }
 
 func (x LeaveType) Number() protoreflect.EnumNumber {
@@ -80,7 +191,62 @@ func (x LeaveType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LeaveType.Descriptor instead.
 func (LeaveType) EnumDescriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{0}
+	return file_leave_proto_rawDescGZIP(), []int{2}
+}
+
+type LeaveDuration int32
+
+const (
+	LeaveDuration_LEAVE_DURATION_UNSPECIFIED LeaveDuration = 0
+	LeaveDuration_LEAVE_DURATION_FULL_DAY    LeaveDuration = 1
+	LeaveDuration_LEAVE_DURATION_HALF_DAY_AM LeaveDuration = 2
+	LeaveDuration_LEAVE_DURATION_HALF_DAY_PM LeaveDuration = 3
+	LeaveDuration_LEAVE_DURATION_HOURLY      LeaveDuration = 4
+)
+
+// Enum value maps for LeaveDuration.
+var (
+	LeaveDuration_name = map[int32]string{
+		0: "LEAVE_DURATION_UNSPECIFIED",
+		1: "LEAVE_DURATION_FULL_DAY",
+		2: "LEAVE_DURATION_HALF_DAY_AM",
+		3: "LEAVE_DURATION_HALF_DAY_PM",
+		4: "LEAVE_DURATION_HOURLY",
+	}
+	LeaveDuration_value = map[string]int32{
+		"LEAVE_DURATION_UNSPECIFIED": 0,
+		"LEAVE_DURATION_FULL_DAY":    1,
+		"LEAVE_DURATION_HALF_DAY_AM": 2,
+		"LEAVE_DURATION_HALF_DAY_PM": 3,
+		"LEAVE_DURATION_HOURLY":      4,
+	}
+)
+
+func (x LeaveDuration) Enum() *LeaveDuration {
+	p := new(LeaveDuration)
+	*p = x
+	return p
+}
+
+func (x LeaveDuration) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaveDuration) Descriptor() protoreflect.EnumDescriptor {
+	return file_leave_proto_enumTypes[3].Descriptor()
+}
+
+func (LeaveDuration) Type() protoreflect.EnumType {
+	return &file_leave_proto_enumTypes[3]
+}
+
+func (x LeaveDuration) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaveDuration.Descriptor instead.
+func (LeaveDuration) EnumDescriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{3}
 }
 
 type LeaveStatus int32
@@ -122,11 +288,11 @@ func (x LeaveStatus) String() string {
 }
 
 func (LeaveStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_leave_proto_enumTypes[1].Descriptor()
+	return file_leave_proto_enumTypes[4].Descriptor()
 }
 
 func (LeaveStatus) Type() protoreflect.EnumType {
-	return &file_leave_proto_enumTypes[1]
+	return &file_leave_proto_enumTypes[4]
 }
 
 func (x LeaveStatus) Number() protoreflect.EnumNumber {
@@ -135,28 +301,144 @@ func (x LeaveStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LeaveStatus.Descriptor instead.
 func (LeaveStatus) EnumDescriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{1}
+	return file_leave_proto_rawDescGZIP(), []int{4}
+}
+
+type AccrualMethod int32
+
+const (
+	AccrualMethod_ACCRUAL_METHOD_UNSPECIFIED AccrualMethod = 0
+	AccrualMethod_ACCRUAL_METHOD_ANNUAL      AccrualMethod = 1
+	AccrualMethod_ACCRUAL_METHOD_MONTHLY     AccrualMethod = 2
+)
+
+// Enum value maps for AccrualMethod.
+var (
+	AccrualMethod_name = map[int32]string{
+		0: "ACCRUAL_METHOD_UNSPECIFIED",
+		1: "ACCRUAL_METHOD_ANNUAL",
+		2: "ACCRUAL_METHOD_MONTHLY",
+	}
+	AccrualMethod_value = map[string]int32{
+		"ACCRUAL_METHOD_UNSPECIFIED": 0,
+		"ACCRUAL_METHOD_ANNUAL":      1,
+		"ACCRUAL_METHOD_MONTHLY":     2,
+	}
+)
+
+func (x AccrualMethod) Enum() *AccrualMethod {
+	p := new(AccrualMethod)
+	*p = x
+	return p
+}
+
+func (x AccrualMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccrualMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_leave_proto_enumTypes[5].Descriptor()
+}
+
+func (AccrualMethod) Type() protoreflect.EnumType {
+	return &file_leave_proto_enumTypes[5]
+}
+
+func (x AccrualMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccrualMethod.Descriptor instead.
+func (AccrualMethod) EnumDescriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{5}
+}
+
+type CompOffStatus int32
+
+const (
+	CompOffStatus_COMP_OFF_STATUS_UNSPECIFIED CompOffStatus = 0
+	CompOffStatus_COMP_OFF_STATUS_PENDING     CompOffStatus = 1
+	CompOffStatus_COMP_OFF_STATUS_APPROVED    CompOffStatus = 2
+	CompOffStatus_COMP_OFF_STATUS_REJECTED    CompOffStatus = 3
+)
+
+// Enum value maps for CompOffStatus.
+var (
+	CompOffStatus_name = map[int32]string{
+		0: "COMP_OFF_STATUS_UNSPECIFIED",
+		1: "COMP_OFF_STATUS_PENDING",
+		2: "COMP_OFF_STATUS_APPROVED",
+		3: "COMP_OFF_STATUS_REJECTED",
+	}
+	CompOffStatus_value = map[string]int32{
+		"COMP_OFF_STATUS_UNSPECIFIED": 0,
+		"COMP_OFF_STATUS_PENDING":     1,
+		"COMP_OFF_STATUS_APPROVED":    2,
+		"COMP_OFF_STATUS_REJECTED":    3,
+	}
+)
+
+func (x CompOffStatus) Enum() *CompOffStatus {
+	p := new(CompOffStatus)
+	*p = x
+	return p
+}
+
+func (x CompOffStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompOffStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_leave_proto_enumTypes[6].Descriptor()
+}
+
+func (CompOffStatus) Type() protoreflect.EnumType {
+	return &file_leave_proto_enumTypes[6]
+}
+
+func (x CompOffStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompOffStatus.Descriptor instead.
+func (CompOffStatus) EnumDescriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{6}
 }
 
 type LeaveRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	EmployeeName  string                 `protobuf:"bytes,3,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
-	LeaveType     LeaveType              `protobuf:"varint,4,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	DaysRequested int32                  `protobuf:"varint,7,opt,name=days_requested,json=daysRequested,proto3" json:"days_requested,omitempty"`
-	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
-	LeaveStatus   LeaveStatus            `protobuf:"varint,9,opt,name=leave_status,json=leaveStatus,proto3,enum=hr.leave.v1.LeaveStatus" json:"leave_status,omitempty"`
-	ApproverId    string                 `protobuf:"bytes,10,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
-	ApproverName  string                 `protobuf:"bytes,11,opt,name=approver_name,json=approverName,proto3" json:"approver_name,omitempty"`
-	Comments      string                 `protobuf:"bytes,12,opt,name=comments,proto3" json:"comments,omitempty"`
-	ApprovedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId          string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	EmployeeName        string                 `protobuf:"bytes,3,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	LeaveType           LeaveType              `protobuf:"varint,4,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	StartDate           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	DaysRequested       float64                `protobuf:"fixed64,7,opt,name=days_requested,json=daysRequested,proto3" json:"days_requested,omitempty"`
+	Reason              string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	LeaveStatus         LeaveStatus            `protobuf:"varint,9,opt,name=leave_status,json=leaveStatus,proto3,enum=hr.leave.v1.LeaveStatus" json:"leave_status,omitempty"`
+	ApproverId          string                 `protobuf:"bytes,10,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	ApproverName        string                 `protobuf:"bytes,11,opt,name=approver_name,json=approverName,proto3" json:"approver_name,omitempty"`
+	Comments            string                 `protobuf:"bytes,12,opt,name=comments,proto3" json:"comments,omitempty"`
+	ApprovedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExcludedDates       []*ExcludedDate        `protobuf:"bytes,16,rep,name=excluded_dates,json=excludedDates,proto3" json:"excluded_dates,omitempty"`
+	Duration            LeaveDuration          `protobuf:"varint,17,opt,name=duration,proto3,enum=hr.leave.v1.LeaveDuration" json:"duration,omitempty"`
+	Hours               float64                `protobuf:"fixed64,18,opt,name=hours,proto3" json:"hours,omitempty"`
+	CurrentStep         int32                  `protobuf:"varint,19,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	ApprovalSteps       []*LeaveApprovalStep   `protobuf:"bytes,20,rep,name=approval_steps,json=approvalSteps,proto3" json:"approval_steps,omitempty"`
+	PendingApproverIds  []string               `protobuf:"bytes,21,rep,name=pending_approver_ids,json=pendingApproverIds,proto3" json:"pending_approver_ids,omitempty"`
+	PendingApproverRole string                 `protobuf:"bytes,22,opt,name=pending_approver_role,json=pendingApproverRole,proto3" json:"pending_approver_role,omitempty"`
+	CancelledBy         string                 `protobuf:"bytes,23,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	CancelledAt         *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancellationReason  string                 `protobuf:"bytes,25,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	OriginalEndDate     *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=original_end_date,json=originalEndDate,proto3" json:"original_end_date,omitempty"`
+	WithdrawnDays       float64                `protobuf:"fixed64,27,opt,name=withdrawn_days,json=withdrawnDays,proto3" json:"withdrawn_days,omitempty"`
+	WithdrawnBy         string                 `protobuf:"bytes,28,opt,name=withdrawn_by,json=withdrawnBy,proto3" json:"withdrawn_by,omitempty"`
+	WithdrawnAt         *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=withdrawn_at,json=withdrawnAt,proto3" json:"withdrawn_at,omitempty"`
+	WithdrawalReason    string                 `protobuf:"bytes,30,opt,name=withdrawal_reason,json=withdrawalReason,proto3" json:"withdrawal_reason,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *LeaveRequest) Reset() {
@@ -231,7 +513,7 @@ func (x *LeaveRequest) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *LeaveRequest) GetDaysRequested() int32 {
+func (x *LeaveRequest) GetDaysRequested() float64 {
 	if x != nil {
 		return x.DaysRequested
 	}
@@ -294,116 +576,140 @@ func (x *LeaveRequest) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type LeaveBalance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	LeaveType     LeaveType              `protobuf:"varint,2,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
-	TotalDays     int32                  `protobuf:"varint,3,opt,name=total_days,json=totalDays,proto3" json:"total_days,omitempty"`
-	UsedDays      int32                  `protobuf:"varint,4,opt,name=used_days,json=usedDays,proto3" json:"used_days,omitempty"`
-	RemainingDays int32                  `protobuf:"varint,5,opt,name=remaining_days,json=remainingDays,proto3" json:"remaining_days,omitempty"`
-	Year          int32                  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LeaveRequest) GetExcludedDates() []*ExcludedDate {
+	if x != nil {
+		return x.ExcludedDates
+	}
+	return nil
 }
 
-func (x *LeaveBalance) Reset() {
-	*x = LeaveBalance{}
-	mi := &file_leave_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LeaveRequest) GetDuration() LeaveDuration {
+	if x != nil {
+		return x.Duration
+	}
+	return LeaveDuration_LEAVE_DURATION_UNSPECIFIED
 }
 
-func (x *LeaveBalance) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LeaveRequest) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
 }
 
-func (*LeaveBalance) ProtoMessage() {}
+func (x *LeaveRequest) GetCurrentStep() int32 {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return 0
+}
 
-func (x *LeaveBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[1]
+func (x *LeaveRequest) GetApprovalSteps() []*LeaveApprovalStep {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ApprovalSteps
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LeaveBalance.ProtoReflect.Descriptor instead.
-func (*LeaveBalance) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{1}
+func (x *LeaveRequest) GetPendingApproverIds() []string {
+	if x != nil {
+		return x.PendingApproverIds
+	}
+	return nil
 }
 
-func (x *LeaveBalance) GetEmployeeId() string {
+func (x *LeaveRequest) GetPendingApproverRole() string {
 	if x != nil {
-		return x.EmployeeId
+		return x.PendingApproverRole
 	}
 	return ""
 }
 
-func (x *LeaveBalance) GetLeaveType() LeaveType {
+func (x *LeaveRequest) GetCancelledBy() string {
 	if x != nil {
-		return x.LeaveType
+		return x.CancelledBy
 	}
-	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *LeaveBalance) GetTotalDays() int32 {
+func (x *LeaveRequest) GetCancelledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalDays
+		return x.CancelledAt
 	}
-	return 0
+	return nil
 }
 
-func (x *LeaveBalance) GetUsedDays() int32 {
+func (x *LeaveRequest) GetCancellationReason() string {
 	if x != nil {
-		return x.UsedDays
+		return x.CancellationReason
 	}
-	return 0
+	return ""
 }
 
-func (x *LeaveBalance) GetRemainingDays() int32 {
+func (x *LeaveRequest) GetOriginalEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RemainingDays
+		return x.OriginalEndDate
 	}
-	return 0
+	return nil
 }
 
-func (x *LeaveBalance) GetYear() int32 {
+func (x *LeaveRequest) GetWithdrawnDays() float64 {
 	if x != nil {
-		return x.Year
+		return x.WithdrawnDays
 	}
 	return 0
 }
 
-type CreateLeaveRequestRequest struct {
+func (x *LeaveRequest) GetWithdrawnBy() string {
+	if x != nil {
+		return x.WithdrawnBy
+	}
+	return ""
+}
+
+func (x *LeaveRequest) GetWithdrawnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WithdrawnAt
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetWithdrawalReason() string {
+	if x != nil {
+		return x.WithdrawalReason
+	}
+	return ""
+}
+
+type LeaveApprovalStep struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	LeaveType     LeaveType              `protobuf:"varint,2,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Step          int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	ApproverType  ApproverType           `protobuf:"varint,2,opt,name=approver_type,json=approverType,proto3,enum=hr.leave.v1.ApproverType" json:"approver_type,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,3,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Status        ApprovalStepStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=hr.leave.v1.ApprovalStepStatus" json:"status,omitempty"`
+	DecidedBy     string                 `protobuf:"bytes,5,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	OnBehalfOf    string                 `protobuf:"bytes,6,opt,name=on_behalf_of,json=onBehalfOf,proto3" json:"on_behalf_of,omitempty"`
+	Comments      string                 `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLeaveRequestRequest) Reset() {
-	*x = CreateLeaveRequestRequest{}
-	mi := &file_leave_proto_msgTypes[2]
+func (x *LeaveApprovalStep) Reset() {
+	*x = LeaveApprovalStep{}
+	mi := &file_leave_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLeaveRequestRequest) String() string {
+func (x *LeaveApprovalStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLeaveRequestRequest) ProtoMessage() {}
+func (*LeaveApprovalStep) ProtoMessage() {}
 
-func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[2]
+func (x *LeaveApprovalStep) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,68 +720,91 @@ func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*CreateLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use LeaveApprovalStep.ProtoReflect.Descriptor instead.
+func (*LeaveApprovalStep) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateLeaveRequestRequest) GetEmployeeId() string {
+func (x *LeaveApprovalStep) GetStep() int32 {
 	if x != nil {
-		return x.EmployeeId
+		return x.Step
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateLeaveRequestRequest) GetLeaveType() LeaveType {
+func (x *LeaveApprovalStep) GetApproverType() ApproverType {
 	if x != nil {
-		return x.LeaveType
+		return x.ApproverType
 	}
-	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+	return ApproverType_APPROVER_TYPE_UNSPECIFIED
 }
 
-func (x *CreateLeaveRequestRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *LeaveApprovalStep) GetApproverId() string {
 	if x != nil {
-		return x.StartDate
+		return x.ApproverId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateLeaveRequestRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *LeaveApprovalStep) GetStatus() ApprovalStepStatus {
 	if x != nil {
-		return x.EndDate
+		return x.Status
 	}
-	return nil
+	return ApprovalStepStatus_APPROVAL_STEP_STATUS_UNSPECIFIED
 }
 
-func (x *CreateLeaveRequestRequest) GetReason() string {
+func (x *LeaveApprovalStep) GetDecidedBy() string {
 	if x != nil {
-		return x.Reason
+		return x.DecidedBy
 	}
 	return ""
 }
 
-type CreateLeaveRequestResponse struct {
+func (x *LeaveApprovalStep) GetOnBehalfOf() string {
+	if x != nil {
+		return x.OnBehalfOf
+	}
+	return ""
+}
+
+func (x *LeaveApprovalStep) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *LeaveApprovalStep) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+type ExcludedDate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLeaveRequestResponse) Reset() {
-	*x = CreateLeaveRequestResponse{}
-	mi := &file_leave_proto_msgTypes[3]
+func (x *ExcludedDate) Reset() {
+	*x = ExcludedDate{}
+	mi := &file_leave_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLeaveRequestResponse) String() string {
+func (x *ExcludedDate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLeaveRequestResponse) ProtoMessage() {}
+func (*ExcludedDate) ProtoMessage() {}
 
-func (x *CreateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[3]
+func (x *ExcludedDate) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,40 +815,57 @@ func (x *CreateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*CreateLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ExcludedDate.ProtoReflect.Descriptor instead.
+func (*ExcludedDate) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+func (x *ExcludedDate) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LeaveRequest
+		return x.Date
 	}
 	return nil
 }
 
-type GetLeaveRequestRequest struct {
+func (x *ExcludedDate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExcludedDate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LeaveConflict struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeaveRequestRequest) Reset() {
-	*x = GetLeaveRequestRequest{}
-	mi := &file_leave_proto_msgTypes[4]
+func (x *LeaveConflict) Reset() {
+	*x = LeaveConflict{}
+	mi := &file_leave_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeaveRequestRequest) String() string {
+func (x *LeaveConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaveRequestRequest) ProtoMessage() {}
+func (*LeaveConflict) ProtoMessage() {}
 
-func (x *GetLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[4]
+func (x *LeaveConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,40 +876,71 @@ func (x *GetLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*GetLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LeaveConflict.ProtoReflect.Descriptor instead.
+func (*LeaveConflict) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetLeaveRequestRequest) GetId() string {
+func (x *LeaveConflict) GetType() string {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
 	return ""
 }
 
-type GetLeaveRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LeaveConflict) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
 }
 
-func (x *GetLeaveRequestResponse) Reset() {
-	*x = GetLeaveRequestResponse{}
-	mi := &file_leave_proto_msgTypes[5]
+func (x *LeaveConflict) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LeaveConflict) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type LeaveBalance struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId     string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	LeaveType      LeaveType              `protobuf:"varint,2,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	TotalDays      float64                `protobuf:"fixed64,3,opt,name=total_days,json=totalDays,proto3" json:"total_days,omitempty"`
+	UsedDays       float64                `protobuf:"fixed64,4,opt,name=used_days,json=usedDays,proto3" json:"used_days,omitempty"`
+	RemainingDays  float64                `protobuf:"fixed64,5,opt,name=remaining_days,json=remainingDays,proto3" json:"remaining_days,omitempty"`
+	Year           int32                  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	AccruedDays    float64                `protobuf:"fixed64,7,opt,name=accrued_days,json=accruedDays,proto3" json:"accrued_days,omitempty"`
+	CarriedDays    float64                `protobuf:"fixed64,8,opt,name=carried_days,json=carriedDays,proto3" json:"carried_days,omitempty"`
+	CarryExpiresOn *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=carry_expires_on,json=carryExpiresOn,proto3" json:"carry_expires_on,omitempty"`
+	ExpiredDays    float64                `protobuf:"fixed64,10,opt,name=expired_days,json=expiredDays,proto3" json:"expired_days,omitempty"`
+	EncashedDays   float64                `protobuf:"fixed64,11,opt,name=encashed_days,json=encashedDays,proto3" json:"encashed_days,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaveBalance) Reset() {
+	*x = LeaveBalance{}
+	mi := &file_leave_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeaveRequestResponse) String() string {
+func (x *LeaveBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaveRequestResponse) ProtoMessage() {}
+func (*LeaveBalance) ProtoMessage() {}
 
-func (x *GetLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[5]
+func (x *LeaveBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,44 +951,116 @@ func (x *GetLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*GetLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use LeaveBalance.ProtoReflect.Descriptor instead.
+func (*LeaveBalance) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+func (x *LeaveBalance) GetEmployeeId() string {
 	if x != nil {
-		return x.LeaveRequest
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+func (x *LeaveBalance) GetTotalDays() float64 {
+	if x != nil {
+		return x.TotalDays
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetUsedDays() float64 {
+	if x != nil {
+		return x.UsedDays
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetRemainingDays() float64 {
+	if x != nil {
+		return x.RemainingDays
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetAccruedDays() float64 {
+	if x != nil {
+		return x.AccruedDays
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetCarriedDays() float64 {
+	if x != nil {
+		return x.CarriedDays
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetCarryExpiresOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CarryExpiresOn
 	}
 	return nil
 }
 
-type UpdateLeaveRequestRequest struct {
+func (x *LeaveBalance) GetExpiredDays() float64 {
+	if x != nil {
+		return x.ExpiredDays
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetEncashedDays() float64 {
+	if x != nil {
+		return x.EncashedDays
+	}
+	return 0
+}
+
+type CreateLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
 	LeaveType     LeaveType              `protobuf:"varint,2,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration      LeaveDuration          `protobuf:"varint,6,opt,name=duration,proto3,enum=hr.leave.v1.LeaveDuration" json:"duration,omitempty"`
+	Hours         float64                `protobuf:"fixed64,7,opt,name=hours,proto3" json:"hours,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLeaveRequestRequest) Reset() {
-	*x = UpdateLeaveRequestRequest{}
-	mi := &file_leave_proto_msgTypes[6]
+func (x *CreateLeaveRequestRequest) Reset() {
+	*x = CreateLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLeaveRequestRequest) String() string {
+func (x *CreateLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLeaveRequestRequest) ProtoMessage() {}
+func (*CreateLeaveRequestRequest) ProtoMessage() {}
 
-func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[6]
+func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,68 +1071,3089 @@ func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CreateLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateLeaveRequestRequest) GetId() string {
+func (x *CreateLeaveRequestRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.Id
+		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *UpdateLeaveRequestRequest) GetLeaveType() LeaveType {
+func (x *CreateLeaveRequestRequest) GetLeaveType() LeaveType {
 	if x != nil {
 		return x.LeaveType
 	}
 	return LeaveType_LEAVE_TYPE_UNSPECIFIED
 }
 
-func (x *UpdateLeaveRequestRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *CreateLeaveRequestRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartDate
 	}
 	return nil
 }
 
-func (x *UpdateLeaveRequestRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *CreateLeaveRequestRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndDate
 	}
 	return nil
 }
 
-func (x *UpdateLeaveRequestRequest) GetReason() string {
+func (x *CreateLeaveRequestRequest) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
-type UpdateLeaveRequestResponse struct {
+func (x *CreateLeaveRequestRequest) GetDuration() LeaveDuration {
+	if x != nil {
+		return x.Duration
+	}
+	return LeaveDuration_LEAVE_DURATION_UNSPECIFIED
+}
+
+func (x *CreateLeaveRequestRequest) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type CreateLeaveRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Warnings      []*LeaveConflict       `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLeaveRequestResponse) Reset() {
-	*x = UpdateLeaveRequestResponse{}
-	mi := &file_leave_proto_msgTypes[7]
+func (x *CreateLeaveRequestResponse) Reset() {
+	*x = CreateLeaveRequestResponse{}
+	mi := &file_leave_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeaveRequestResponse) ProtoMessage() {}
+
+func (x *CreateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+func (x *CreateLeaveRequestResponse) GetWarnings() []*LeaveConflict {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type GetLeaveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaveRequestRequest) Reset() {
+	*x = GetLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveRequestRequest) ProtoMessage() {}
+
+func (x *GetLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetLeaveRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaveRequestResponse) Reset() {
+	*x = GetLeaveRequestResponse{}
+	mi := &file_leave_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveRequestResponse) ProtoMessage() {}
+
+func (x *GetLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+type UpdateLeaveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaveType     LeaveType              `protobuf:"varint,2,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration      LeaveDuration          `protobuf:"varint,6,opt,name=duration,proto3,enum=hr.leave.v1.LeaveDuration" json:"duration,omitempty"`
+	Hours         float64                `protobuf:"fixed64,7,opt,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLeaveRequestRequest) Reset() {
+	*x = UpdateLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLeaveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLeaveRequestRequest) ProtoMessage() {}
+
+func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateLeaveRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLeaveRequestRequest) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateLeaveRequestRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdateLeaveRequestRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *UpdateLeaveRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateLeaveRequestRequest) GetDuration() LeaveDuration {
+	if x != nil {
+		return x.Duration
+	}
+	return LeaveDuration_LEAVE_DURATION_UNSPECIFIED
+}
+
+func (x *UpdateLeaveRequestRequest) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type UpdateLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Warnings      []*LeaveConflict       `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLeaveRequestResponse) Reset() {
+	*x = UpdateLeaveRequestResponse{}
+	mi := &file_leave_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLeaveRequestResponse) ProtoMessage() {}
+
+func (x *UpdateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+func (x *UpdateLeaveRequestResponse) GetWarnings() []*LeaveConflict {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type DeleteLeaveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLeaveRequestRequest) Reset() {
+	*x = DeleteLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLeaveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLeaveRequestRequest) ProtoMessage() {}
+
+func (x *DeleteLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteLeaveRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListLeaveRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Status        LeaveStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=hr.leave.v1.LeaveStatus" json:"status,omitempty"`
+	LeaveType     LeaveType              `protobuf:"varint,5,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaveRequestsRequest) Reset() {
+	*x = ListLeaveRequestsRequest{}
+	mi := &file_leave_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaveRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaveRequestsRequest) ProtoMessage() {}
+
+func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaveRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaveRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListLeaveRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLeaveRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLeaveRequestsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListLeaveRequestsRequest) GetStatus() LeaveStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LeaveStatus_LEAVE_STATUS_UNSPECIFIED
+}
+
+func (x *ListLeaveRequestsRequest) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+type ListLeaveRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequests []*LeaveRequest        `protobuf:"bytes,1,rep,name=leave_requests,json=leaveRequests,proto3" json:"leave_requests,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaveRequestsResponse) Reset() {
+	*x = ListLeaveRequestsResponse{}
+	mi := &file_leave_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaveRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaveRequestsResponse) ProtoMessage() {}
+
+func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaveRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaveRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListLeaveRequestsResponse) GetLeaveRequests() []*LeaveRequest {
+	if x != nil {
+		return x.LeaveRequests
+	}
+	return nil
+}
+
+func (x *ListLeaveRequestsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListLeaveRequestsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLeaveRequestsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ApproveLeaveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Comments      string                 `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveLeaveRequestRequest) Reset() {
+	*x = ApproveLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLeaveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLeaveRequestRequest) ProtoMessage() {}
+
+func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApproveLeaveRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveLeaveRequestRequest) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *ApproveLeaveRequestRequest) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+type ApproveLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Warnings      []*LeaveConflict       `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveLeaveRequestResponse) Reset() {
+	*x = ApproveLeaveRequestResponse{}
+	mi := &file_leave_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLeaveRequestResponse) ProtoMessage() {}
+
+func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ApproveLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+func (x *ApproveLeaveRequestResponse) GetWarnings() []*LeaveConflict {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type RejectLeaveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Comments      string                 `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectLeaveRequestRequest) Reset() {
+	*x = RejectLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectLeaveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectLeaveRequestRequest) ProtoMessage() {}
+
+func (x *RejectLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RejectLeaveRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectLeaveRequestRequest) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *RejectLeaveRequestRequest) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+type RejectLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectLeaveRequestResponse) Reset() {
+	*x = RejectLeaveRequestResponse{}
+	mi := &file_leave_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectLeaveRequestResponse) ProtoMessage() {}
+
+func (x *RejectLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RejectLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+type CancelLeaveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelLeaveRequestRequest) Reset() {
+	*x = CancelLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelLeaveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLeaveRequestRequest) ProtoMessage() {}
+
+func (x *CancelLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelLeaveRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelLeaveRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelLeaveRequestResponse) Reset() {
+	*x = CancelLeaveRequestResponse{}
+	mi := &file_leave_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLeaveRequestResponse) ProtoMessage() {}
+
+func (x *CancelLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+type WithdrawLeaveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LastDay       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_day,json=lastDay,proto3" json:"last_day,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawLeaveRequestRequest) Reset() {
+	*x = WithdrawLeaveRequestRequest{}
+	mi := &file_leave_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawLeaveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawLeaveRequestRequest) ProtoMessage() {}
+
+func (x *WithdrawLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WithdrawLeaveRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WithdrawLeaveRequestRequest) GetLastDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDay
+	}
+	return nil
+}
+
+func (x *WithdrawLeaveRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WithdrawLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	RestoredDays  float64                `protobuf:"fixed64,2,opt,name=restored_days,json=restoredDays,proto3" json:"restored_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawLeaveRequestResponse) Reset() {
+	*x = WithdrawLeaveRequestResponse{}
+	mi := &file_leave_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawLeaveRequestResponse) ProtoMessage() {}
+
+func (x *WithdrawLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WithdrawLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+func (x *WithdrawLeaveRequestResponse) GetRestoredDays() float64 {
+	if x != nil {
+		return x.RestoredDays
+	}
+	return 0
+}
+
+type CalendarHoliday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarHoliday) Reset() {
+	*x = CalendarHoliday{}
+	mi := &file_leave_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarHoliday) ProtoMessage() {}
+
+func (x *CalendarHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarHoliday.ProtoReflect.Descriptor instead.
+func (*CalendarHoliday) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CalendarHoliday) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CalendarHoliday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarHoliday) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CalendarHoliday) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type GetTeamCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentId  string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	ManagerId     string                 `protobuf:"bytes,2,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamCalendarRequest) Reset() {
+	*x = GetTeamCalendarRequest{}
+	mi := &file_leave_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamCalendarRequest) ProtoMessage() {}
+
+func (x *GetTeamCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTeamCalendarRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *GetTeamCalendarRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *GetTeamCalendarRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetTeamCalendarRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetTeamCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Absences      []*LeaveRequest        `protobuf:"bytes,1,rep,name=absences,proto3" json:"absences,omitempty"`
+	Holidays      []*CalendarHoliday     `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamCalendarResponse) Reset() {
+	*x = GetTeamCalendarResponse{}
+	mi := &file_leave_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamCalendarResponse) ProtoMessage() {}
+
+func (x *GetTeamCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTeamCalendarResponse) GetAbsences() []*LeaveRequest {
+	if x != nil {
+		return x.Absences
+	}
+	return nil
+}
+
+func (x *GetTeamCalendarResponse) GetHolidays() []*CalendarHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type ExportLeaveCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLeaveCalendarRequest) Reset() {
+	*x = ExportLeaveCalendarRequest{}
+	mi := &file_leave_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLeaveCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLeaveCalendarRequest) ProtoMessage() {}
+
+func (x *ExportLeaveCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLeaveCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ExportLeaveCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportLeaveCalendarRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ExportLeaveCalendarRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ExportLeaveCalendarRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ExportLeaveCalendarRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type ExportLeaveCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLeaveCalendarResponse) Reset() {
+	*x = ExportLeaveCalendarResponse{}
+	mi := &file_leave_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLeaveCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLeaveCalendarResponse) ProtoMessage() {}
+
+func (x *ExportLeaveCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLeaveCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ExportLeaveCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportLeaveCalendarResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportLeaveCalendarResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportLeaveCalendarResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetEmployeeLeaveBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeLeaveBalanceRequest) Reset() {
+	*x = GetEmployeeLeaveBalanceRequest{}
+	mi := &file_leave_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeLeaveBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeLeaveBalanceRequest) ProtoMessage() {}
+
+func (x *GetEmployeeLeaveBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeLeaveBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeLeaveBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetEmployeeLeaveBalanceRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetEmployeeLeaveBalanceRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetEmployeeLeaveBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveBalances []*LeaveBalance        `protobuf:"bytes,1,rep,name=leave_balances,json=leaveBalances,proto3" json:"leave_balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeLeaveBalanceResponse) Reset() {
+	*x = GetEmployeeLeaveBalanceResponse{}
+	mi := &file_leave_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeLeaveBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeLeaveBalanceResponse) ProtoMessage() {}
+
+func (x *GetEmployeeLeaveBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeLeaveBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeLeaveBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetEmployeeLeaveBalanceResponse) GetLeaveBalances() []*LeaveBalance {
+	if x != nil {
+		return x.LeaveBalances
+	}
+	return nil
+}
+
+type LeavePolicy struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	LeaveType                LeaveType              `protobuf:"varint,1,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	AccrualMethod            AccrualMethod          `protobuf:"varint,2,opt,name=accrual_method,json=accrualMethod,proto3,enum=hr.leave.v1.AccrualMethod" json:"accrual_method,omitempty"`
+	DaysPerYear              float64                `protobuf:"fixed64,3,opt,name=days_per_year,json=daysPerYear,proto3" json:"days_per_year,omitempty"`
+	ProbationMonths          int32                  `protobuf:"varint,4,opt,name=probation_months,json=probationMonths,proto3" json:"probation_months,omitempty"`
+	Active                   bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	CarryForwardMaxDays      float64                `protobuf:"fixed64,6,opt,name=carry_forward_max_days,json=carryForwardMaxDays,proto3" json:"carry_forward_max_days,omitempty"`
+	CarryForwardExpiryMonths int32                  `protobuf:"varint,7,opt,name=carry_forward_expiry_months,json=carryForwardExpiryMonths,proto3" json:"carry_forward_expiry_months,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *LeavePolicy) Reset() {
+	*x = LeavePolicy{}
+	mi := &file_leave_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeavePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeavePolicy) ProtoMessage() {}
+
+func (x *LeavePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeavePolicy.ProtoReflect.Descriptor instead.
+func (*LeavePolicy) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LeavePolicy) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+func (x *LeavePolicy) GetAccrualMethod() AccrualMethod {
+	if x != nil {
+		return x.AccrualMethod
+	}
+	return AccrualMethod_ACCRUAL_METHOD_UNSPECIFIED
+}
+
+func (x *LeavePolicy) GetDaysPerYear() float64 {
+	if x != nil {
+		return x.DaysPerYear
+	}
+	return 0
+}
+
+func (x *LeavePolicy) GetProbationMonths() int32 {
+	if x != nil {
+		return x.ProbationMonths
+	}
+	return 0
+}
+
+func (x *LeavePolicy) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *LeavePolicy) GetCarryForwardMaxDays() float64 {
+	if x != nil {
+		return x.CarryForwardMaxDays
+	}
+	return 0
+}
+
+func (x *LeavePolicy) GetCarryForwardExpiryMonths() int32 {
+	if x != nil {
+		return x.CarryForwardExpiryMonths
+	}
+	return 0
+}
+
+type ListLeavePoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeavePoliciesRequest) Reset() {
+	*x = ListLeavePoliciesRequest{}
+	mi := &file_leave_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeavePoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeavePoliciesRequest) ProtoMessage() {}
+
+func (x *ListLeavePoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeavePoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeavePoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{30}
+}
+
+type ListLeavePoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*LeavePolicy         `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeavePoliciesResponse) Reset() {
+	*x = ListLeavePoliciesResponse{}
+	mi := &file_leave_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeavePoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeavePoliciesResponse) ProtoMessage() {}
+
+func (x *ListLeavePoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeavePoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListLeavePoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListLeavePoliciesResponse) GetPolicies() []*LeavePolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SetLeavePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *LeavePolicy           `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLeavePolicyRequest) Reset() {
+	*x = SetLeavePolicyRequest{}
+	mi := &file_leave_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLeavePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLeavePolicyRequest) ProtoMessage() {}
+
+func (x *SetLeavePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLeavePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetLeavePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetLeavePolicyRequest) GetPolicy() *LeavePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetLeavePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *LeavePolicy           `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLeavePolicyResponse) Reset() {
+	*x = SetLeavePolicyResponse{}
+	mi := &file_leave_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLeavePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLeavePolicyResponse) ProtoMessage() {}
+
+func (x *SetLeavePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLeavePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetLeavePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetLeavePolicyResponse) GetPolicy() *LeavePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type LeaveAccrual struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId            string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	LeaveType             LeaveType              `protobuf:"varint,2,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	Year                  int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	EntitledDays          float64                `protobuf:"fixed64,4,opt,name=entitled_days,json=entitledDays,proto3" json:"entitled_days,omitempty"`
+	PreviouslyAccruedDays float64                `protobuf:"fixed64,5,opt,name=previously_accrued_days,json=previouslyAccruedDays,proto3" json:"previously_accrued_days,omitempty"`
+	CreditedDays          float64                `protobuf:"fixed64,6,opt,name=credited_days,json=creditedDays,proto3" json:"credited_days,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LeaveAccrual) Reset() {
+	*x = LeaveAccrual{}
+	mi := &file_leave_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveAccrual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveAccrual) ProtoMessage() {}
+
+func (x *LeaveAccrual) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveAccrual.ProtoReflect.Descriptor instead.
+func (*LeaveAccrual) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LeaveAccrual) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *LeaveAccrual) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+func (x *LeaveAccrual) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LeaveAccrual) GetEntitledDays() float64 {
+	if x != nil {
+		return x.EntitledDays
+	}
+	return 0
+}
+
+func (x *LeaveAccrual) GetPreviouslyAccruedDays() float64 {
+	if x != nil {
+		return x.PreviouslyAccruedDays
+	}
+	return 0
+}
+
+func (x *LeaveAccrual) GetCreditedDays() float64 {
+	if x != nil {
+		return x.CreditedDays
+	}
+	return 0
+}
+
+type RunLeaveAccrualRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunLeaveAccrualRequest) Reset() {
+	*x = RunLeaveAccrualRequest{}
+	mi := &file_leave_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunLeaveAccrualRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunLeaveAccrualRequest) ProtoMessage() {}
+
+func (x *RunLeaveAccrualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunLeaveAccrualRequest.ProtoReflect.Descriptor instead.
+func (*RunLeaveAccrualRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RunLeaveAccrualRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *RunLeaveAccrualRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunLeaveAccrualRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+type RunLeaveAccrualResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Accruals           []*LeaveAccrual        `protobuf:"bytes,1,rep,name=accruals,proto3" json:"accruals,omitempty"`
+	Year               int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	AsOf               *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	DryRun             bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	EmployeesProcessed int32                  `protobuf:"varint,5,opt,name=employees_processed,json=employeesProcessed,proto3" json:"employees_processed,omitempty"`
+	EmployeesFailed    int32                  `protobuf:"varint,6,opt,name=employees_failed,json=employeesFailed,proto3" json:"employees_failed,omitempty"`
+	TotalCreditedDays  float64                `protobuf:"fixed64,7,opt,name=total_credited_days,json=totalCreditedDays,proto3" json:"total_credited_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RunLeaveAccrualResponse) Reset() {
+	*x = RunLeaveAccrualResponse{}
+	mi := &file_leave_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunLeaveAccrualResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunLeaveAccrualResponse) ProtoMessage() {}
+
+func (x *RunLeaveAccrualResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunLeaveAccrualResponse.ProtoReflect.Descriptor instead.
+func (*RunLeaveAccrualResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RunLeaveAccrualResponse) GetAccruals() []*LeaveAccrual {
+	if x != nil {
+		return x.Accruals
+	}
+	return nil
+}
+
+func (x *RunLeaveAccrualResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *RunLeaveAccrualResponse) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *RunLeaveAccrualResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunLeaveAccrualResponse) GetEmployeesProcessed() int32 {
+	if x != nil {
+		return x.EmployeesProcessed
+	}
+	return 0
+}
+
+func (x *RunLeaveAccrualResponse) GetEmployeesFailed() int32 {
+	if x != nil {
+		return x.EmployeesFailed
+	}
+	return 0
+}
+
+func (x *RunLeaveAccrualResponse) GetTotalCreditedDays() float64 {
+	if x != nil {
+		return x.TotalCreditedDays
+	}
+	return 0
+}
+
+type LeaveCarryForward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	LeaveType     LeaveType              `protobuf:"varint,2,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	UnusedDays    float64                `protobuf:"fixed64,4,opt,name=unused_days,json=unusedDays,proto3" json:"unused_days,omitempty"`
+	CarriedDays   float64                `protobuf:"fixed64,5,opt,name=carried_days,json=carriedDays,proto3" json:"carried_days,omitempty"`
+	ForfeitedDays float64                `protobuf:"fixed64,6,opt,name=forfeited_days,json=forfeitedDays,proto3" json:"forfeited_days,omitempty"`
+	ExpiresOn     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_on,json=expiresOn,proto3" json:"expires_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveCarryForward) Reset() {
+	*x = LeaveCarryForward{}
+	mi := &file_leave_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveCarryForward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCarryForward) ProtoMessage() {}
+
+func (x *LeaveCarryForward) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCarryForward.ProtoReflect.Descriptor instead.
+func (*LeaveCarryForward) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LeaveCarryForward) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *LeaveCarryForward) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+func (x *LeaveCarryForward) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LeaveCarryForward) GetUnusedDays() float64 {
+	if x != nil {
+		return x.UnusedDays
+	}
+	return 0
+}
+
+func (x *LeaveCarryForward) GetCarriedDays() float64 {
+	if x != nil {
+		return x.CarriedDays
+	}
+	return 0
+}
+
+func (x *LeaveCarryForward) GetForfeitedDays() float64 {
+	if x != nil {
+		return x.ForfeitedDays
+	}
+	return 0
+}
+
+func (x *LeaveCarryForward) GetExpiresOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return nil
+}
+
+type CloseLeaveYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLeaveYearRequest) Reset() {
+	*x = CloseLeaveYearRequest{}
+	mi := &file_leave_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLeaveYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLeaveYearRequest) ProtoMessage() {}
+
+func (x *CloseLeaveYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLeaveYearRequest.ProtoReflect.Descriptor instead.
+func (*CloseLeaveYearRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CloseLeaveYearRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CloseLeaveYearRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CloseLeaveYearResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CarryForwards      []*LeaveCarryForward   `protobuf:"bytes,1,rep,name=carry_forwards,json=carryForwards,proto3" json:"carry_forwards,omitempty"`
+	Year               int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	DryRun             bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	BalancesClosed     int32                  `protobuf:"varint,4,opt,name=balances_closed,json=balancesClosed,proto3" json:"balances_closed,omitempty"`
+	BalancesFailed     int32                  `protobuf:"varint,5,opt,name=balances_failed,json=balancesFailed,proto3" json:"balances_failed,omitempty"`
+	TotalCarriedDays   float64                `protobuf:"fixed64,6,opt,name=total_carried_days,json=totalCarriedDays,proto3" json:"total_carried_days,omitempty"`
+	TotalForfeitedDays float64                `protobuf:"fixed64,7,opt,name=total_forfeited_days,json=totalForfeitedDays,proto3" json:"total_forfeited_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CloseLeaveYearResponse) Reset() {
+	*x = CloseLeaveYearResponse{}
+	mi := &file_leave_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLeaveYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLeaveYearResponse) ProtoMessage() {}
+
+func (x *CloseLeaveYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLeaveYearResponse.ProtoReflect.Descriptor instead.
+func (*CloseLeaveYearResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CloseLeaveYearResponse) GetCarryForwards() []*LeaveCarryForward {
+	if x != nil {
+		return x.CarryForwards
+	}
+	return nil
+}
+
+func (x *CloseLeaveYearResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CloseLeaveYearResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CloseLeaveYearResponse) GetBalancesClosed() int32 {
+	if x != nil {
+		return x.BalancesClosed
+	}
+	return 0
+}
+
+func (x *CloseLeaveYearResponse) GetBalancesFailed() int32 {
+	if x != nil {
+		return x.BalancesFailed
+	}
+	return 0
+}
+
+func (x *CloseLeaveYearResponse) GetTotalCarriedDays() float64 {
+	if x != nil {
+		return x.TotalCarriedDays
+	}
+	return 0
+}
+
+func (x *CloseLeaveYearResponse) GetTotalForfeitedDays() float64 {
+	if x != nil {
+		return x.TotalForfeitedDays
+	}
+	return 0
+}
+
+type PayrollLineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	ItemType      string                 `protobuf:"bytes,3,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Rate          float64                `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayrollLineItem) Reset() {
+	*x = PayrollLineItem{}
+	mi := &file_leave_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayrollLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollLineItem) ProtoMessage() {}
+
+func (x *PayrollLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollLineItem.ProtoReflect.Descriptor instead.
+func (*PayrollLineItem) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PayrollLineItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PayrollLineItem) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *PayrollLineItem) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *PayrollLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PayrollLineItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PayrollLineItem) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *PayrollLineItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayrollLineItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type EncashLeaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Days          float64                `protobuf:"fixed64,3,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncashLeaveRequest) Reset() {
+	*x = EncashLeaveRequest{}
+	mi := &file_leave_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncashLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncashLeaveRequest) ProtoMessage() {}
+
+func (x *EncashLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncashLeaveRequest.ProtoReflect.Descriptor instead.
+func (*EncashLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *EncashLeaveRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *EncashLeaveRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *EncashLeaveRequest) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type EncashLeaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveBalance  *LeaveBalance          `protobuf:"bytes,1,opt,name=leave_balance,json=leaveBalance,proto3" json:"leave_balance,omitempty"`
+	LineItem      *PayrollLineItem       `protobuf:"bytes,2,opt,name=line_item,json=lineItem,proto3" json:"line_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncashLeaveResponse) Reset() {
+	*x = EncashLeaveResponse{}
+	mi := &file_leave_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncashLeaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncashLeaveResponse) ProtoMessage() {}
+
+func (x *EncashLeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncashLeaveResponse.ProtoReflect.Descriptor instead.
+func (*EncashLeaveResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *EncashLeaveResponse) GetLeaveBalance() *LeaveBalance {
+	if x != nil {
+		return x.LeaveBalance
+	}
+	return nil
+}
+
+func (x *EncashLeaveResponse) GetLineItem() *PayrollLineItem {
+	if x != nil {
+		return x.LineItem
+	}
+	return nil
+}
+
+type ApprovalRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	ApproverType  ApproverType           `protobuf:"varint,2,opt,name=approver_type,json=approverType,proto3,enum=hr.leave.v1.ApproverType" json:"approver_type,omitempty"`
+	MinDays       float64                `protobuf:"fixed64,3,opt,name=min_days,json=minDays,proto3" json:"min_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalRule) Reset() {
+	*x = ApprovalRule{}
+	mi := &file_leave_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalRule) ProtoMessage() {}
+
+func (x *ApprovalRule) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalRule.ProtoReflect.Descriptor instead.
+func (*ApprovalRule) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApprovalRule) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *ApprovalRule) GetApproverType() ApproverType {
+	if x != nil {
+		return x.ApproverType
+	}
+	return ApproverType_APPROVER_TYPE_UNSPECIFIED
+}
+
+func (x *ApprovalRule) GetMinDays() float64 {
+	if x != nil {
+		return x.MinDays
+	}
+	return 0
+}
+
+type ListApprovalRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveType     LeaveType              `protobuf:"varint,1,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalRulesRequest) Reset() {
+	*x = ListApprovalRulesRequest{}
+	mi := &file_leave_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalRulesRequest) ProtoMessage() {}
+
+func (x *ListApprovalRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalRulesRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListApprovalRulesRequest) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+type ListApprovalRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ApprovalRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalRulesResponse) Reset() {
+	*x = ListApprovalRulesResponse{}
+	mi := &file_leave_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalRulesResponse) ProtoMessage() {}
+
+func (x *ListApprovalRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalRulesResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListApprovalRulesResponse) GetRules() []*ApprovalRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetApprovalRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveType     LeaveType              `protobuf:"varint,1,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	Rules         []*ApprovalRule        `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetApprovalRulesRequest) Reset() {
+	*x = SetApprovalRulesRequest{}
+	mi := &file_leave_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetApprovalRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApprovalRulesRequest) ProtoMessage() {}
+
+func (x *SetApprovalRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApprovalRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetApprovalRulesRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetApprovalRulesRequest) GetLeaveType() LeaveType {
+	if x != nil {
+		return x.LeaveType
+	}
+	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+}
+
+func (x *SetApprovalRulesRequest) GetRules() []*ApprovalRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetApprovalRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ApprovalRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetApprovalRulesResponse) Reset() {
+	*x = SetApprovalRulesResponse{}
+	mi := &file_leave_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetApprovalRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApprovalRulesResponse) ProtoMessage() {}
+
+func (x *SetApprovalRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApprovalRulesResponse.ProtoReflect.Descriptor instead.
+func (*SetApprovalRulesResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetApprovalRulesResponse) GetRules() []*ApprovalRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ApprovalDelegation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	DelegateId    string                 `protobuf:"bytes,3,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalDelegation) Reset() {
+	*x = ApprovalDelegation{}
+	mi := &file_leave_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalDelegation) ProtoMessage() {}
+
+func (x *ApprovalDelegation) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalDelegation.ProtoReflect.Descriptor instead.
+func (*ApprovalDelegation) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ApprovalDelegation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ApprovalDelegation) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ApprovalDelegation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateApprovalDelegationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	DelegateId    string                 `protobuf:"bytes,2,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApprovalDelegationRequest) Reset() {
+	*x = CreateApprovalDelegationRequest{}
+	mi := &file_leave_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApprovalDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApprovalDelegationRequest) ProtoMessage() {}
+
+func (x *CreateApprovalDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApprovalDelegationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApprovalDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateApprovalDelegationRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *CreateApprovalDelegationRequest) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *CreateApprovalDelegationRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateApprovalDelegationRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateApprovalDelegationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateApprovalDelegationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delegation    *ApprovalDelegation    `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApprovalDelegationResponse) Reset() {
+	*x = CreateApprovalDelegationResponse{}
+	mi := &file_leave_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApprovalDelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApprovalDelegationResponse) ProtoMessage() {}
+
+func (x *CreateApprovalDelegationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApprovalDelegationResponse.ProtoReflect.Descriptor instead.
+func (*CreateApprovalDelegationResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateApprovalDelegationResponse) GetDelegation() *ApprovalDelegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+type ListApprovalDelegationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalDelegationsRequest) Reset() {
+	*x = ListApprovalDelegationsRequest{}
+	mi := &file_leave_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalDelegationsRequest) ProtoMessage() {}
+
+func (x *ListApprovalDelegationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListApprovalDelegationsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListApprovalDelegationsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListApprovalDelegationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delegations   []*ApprovalDelegation  `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalDelegationsResponse) Reset() {
+	*x = ListApprovalDelegationsResponse{}
+	mi := &file_leave_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalDelegationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalDelegationsResponse) ProtoMessage() {}
+
+func (x *ListApprovalDelegationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListApprovalDelegationsResponse) GetDelegations() []*ApprovalDelegation {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+type DeleteApprovalDelegationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApprovalDelegationRequest) Reset() {
+	*x = DeleteApprovalDelegationRequest{}
+	mi := &file_leave_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApprovalDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApprovalDelegationRequest) ProtoMessage() {}
+
+func (x *DeleteApprovalDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApprovalDelegationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApprovalDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteApprovalDelegationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompOffCredit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	EmployeeName  string                 `protobuf:"bytes,3,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	WorkDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	Days          float64                `protobuf:"fixed64,5,opt,name=days,proto3" json:"days,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        CompOffStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=hr.leave.v1.CompOffStatus" json:"status,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,9,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Comments      string                 `protobuf:"bytes,10,opt,name=comments,proto3" json:"comments,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	ExpiresOn     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_on,json=expiresOn,proto3" json:"expires_on,omitempty"`
+	ExpiredDays   float64                `protobuf:"fixed64,13,opt,name=expired_days,json=expiredDays,proto3" json:"expired_days,omitempty"`
+	ExpiredAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompOffCredit) Reset() {
+	*x = CompOffCredit{}
+	mi := &file_leave_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompOffCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompOffCredit) ProtoMessage() {}
+
+func (x *CompOffCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompOffCredit.ProtoReflect.Descriptor instead.
+func (*CompOffCredit) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CompOffCredit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompOffCredit) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *CompOffCredit) GetEmployeeName() string {
+	if x != nil {
+		return x.EmployeeName
+	}
+	return ""
+}
+
+func (x *CompOffCredit) GetWorkDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WorkDate
+	}
+	return nil
+}
+
+func (x *CompOffCredit) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *CompOffCredit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CompOffCredit) GetStatus() CompOffStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompOffStatus_COMP_OFF_STATUS_UNSPECIFIED
+}
+
+func (x *CompOffCredit) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CompOffCredit) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *CompOffCredit) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *CompOffCredit) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *CompOffCredit) GetExpiresOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return nil
+}
+
+func (x *CompOffCredit) GetExpiredDays() float64 {
+	if x != nil {
+		return x.ExpiredDays
+	}
+	return 0
+}
+
+func (x *CompOffCredit) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return nil
+}
+
+func (x *CompOffCredit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RequestCompOffCreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	WorkDate      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	Days          float64                `protobuf:"fixed64,3,opt,name=days,proto3" json:"days,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCompOffCreditRequest) Reset() {
+	*x = RequestCompOffCreditRequest{}
+	mi := &file_leave_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLeaveRequestResponse) String() string {
+func (x *RequestCompOffCreditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLeaveRequestResponse) ProtoMessage() {}
+func (*RequestCompOffCreditRequest) ProtoMessage() {}
 
-func (x *UpdateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[7]
+func (x *RequestCompOffCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,40 +4164,61 @@ func (x *UpdateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RequestCompOffCreditRequest.ProtoReflect.Descriptor instead.
+func (*RequestCompOffCreditRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdateLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+func (x *RequestCompOffCreditRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.LeaveRequest
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *RequestCompOffCreditRequest) GetWorkDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WorkDate
 	}
 	return nil
 }
 
-type DeleteLeaveRequestRequest struct {
+func (x *RequestCompOffCreditRequest) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *RequestCompOffCreditRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestCompOffCreditResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Credit        *CompOffCredit         `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLeaveRequestRequest) Reset() {
-	*x = DeleteLeaveRequestRequest{}
-	mi := &file_leave_proto_msgTypes[8]
+func (x *RequestCompOffCreditResponse) Reset() {
+	*x = RequestCompOffCreditResponse{}
+	mi := &file_leave_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLeaveRequestRequest) String() string {
+func (x *RequestCompOffCreditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLeaveRequestRequest) ProtoMessage() {}
+func (*RequestCompOffCreditResponse) ProtoMessage() {}
 
-func (x *DeleteLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[8]
+func (x *RequestCompOffCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,44 +4229,43 @@ func (x *DeleteLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RequestCompOffCreditResponse.ProtoReflect.Descriptor instead.
+func (*RequestCompOffCreditResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *DeleteLeaveRequestRequest) GetId() string {
+func (x *RequestCompOffCreditResponse) GetCredit() *CompOffCredit {
 	if x != nil {
-		return x.Id
+		return x.Credit
 	}
-	return ""
+	return nil
 }
 
-type ListLeaveRequestsRequest struct {
+type ListCompOffCreditsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	EmployeeId    string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	Status        LeaveStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=hr.leave.v1.LeaveStatus" json:"status,omitempty"`
-	LeaveType     LeaveType              `protobuf:"varint,5,opt,name=leave_type,json=leaveType,proto3,enum=hr.leave.v1.LeaveType" json:"leave_type,omitempty"`
+	Status        CompOffStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=hr.leave.v1.CompOffStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLeaveRequestsRequest) Reset() {
-	*x = ListLeaveRequestsRequest{}
-	mi := &file_leave_proto_msgTypes[9]
+func (x *ListCompOffCreditsRequest) Reset() {
+	*x = ListCompOffCreditsRequest{}
+	mi := &file_leave_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLeaveRequestsRequest) String() string {
+func (x *ListCompOffCreditsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLeaveRequestsRequest) ProtoMessage() {}
+func (*ListCompOffCreditsRequest) ProtoMessage() {}
 
-func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[9]
+func (x *ListCompOffCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,49 +4276,42 @@ func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLeaveRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListLeaveRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListCompOffCreditsRequest.ProtoReflect.Descriptor instead.
+func (*ListCompOffCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListLeaveRequestsRequest) GetPage() int32 {
+func (x *ListCompOffCreditsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListLeaveRequestsRequest) GetPageSize() int32 {
+func (x *ListCompOffCreditsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListLeaveRequestsRequest) GetEmployeeId() string {
+func (x *ListCompOffCreditsRequest) GetEmployeeId() string {
 	if x != nil {
 		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *ListLeaveRequestsRequest) GetStatus() LeaveStatus {
+func (x *ListCompOffCreditsRequest) GetStatus() CompOffStatus {
 	if x != nil {
 		return x.Status
 	}
-	return LeaveStatus_LEAVE_STATUS_UNSPECIFIED
-}
-
-func (x *ListLeaveRequestsRequest) GetLeaveType() LeaveType {
-	if x != nil {
-		return x.LeaveType
-	}
-	return LeaveType_LEAVE_TYPE_UNSPECIFIED
+	return CompOffStatus_COMP_OFF_STATUS_UNSPECIFIED
 }
 
-type ListLeaveRequestsResponse struct {
+type ListCompOffCreditsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequests []*LeaveRequest        `protobuf:"bytes,1,rep,name=leave_requests,json=leaveRequests,proto3" json:"leave_requests,omitempty"`
+	Credits       []*CompOffCredit       `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
 	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -836,21 +4319,21 @@ type ListLeaveRequestsResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLeaveRequestsResponse) Reset() {
-	*x = ListLeaveRequestsResponse{}
-	mi := &file_leave_proto_msgTypes[10]
+func (x *ListCompOffCreditsResponse) Reset() {
+	*x = ListCompOffCreditsResponse{}
+	mi := &file_leave_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLeaveRequestsResponse) String() string {
+func (x *ListCompOffCreditsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLeaveRequestsResponse) ProtoMessage() {}
+func (*ListCompOffCreditsResponse) ProtoMessage() {}
 
-func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[10]
+func (x *ListCompOffCreditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,63 +4344,62 @@ func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLeaveRequestsResponse.ProtoReflect.Descriptor instead.
-func (*ListLeaveRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListCompOffCreditsResponse.ProtoReflect.Descriptor instead.
+func (*ListCompOffCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListLeaveRequestsResponse) GetLeaveRequests() []*LeaveRequest {
+func (x *ListCompOffCreditsResponse) GetCredits() []*CompOffCredit {
 	if x != nil {
-		return x.LeaveRequests
+		return x.Credits
 	}
 	return nil
 }
 
-func (x *ListLeaveRequestsResponse) GetTotalCount() int32 {
+func (x *ListCompOffCreditsResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *ListLeaveRequestsResponse) GetPage() int32 {
+func (x *ListCompOffCreditsResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListLeaveRequestsResponse) GetPageSize() int32 {
+func (x *ListCompOffCreditsResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-type ApproveLeaveRequestRequest struct {
+type ApproveCompOffCreditRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
-	Comments      string                 `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	Comments      string                 `protobuf:"bytes,2,opt,name=comments,proto3" json:"comments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveLeaveRequestRequest) Reset() {
-	*x = ApproveLeaveRequestRequest{}
-	mi := &file_leave_proto_msgTypes[11]
+func (x *ApproveCompOffCreditRequest) Reset() {
+	*x = ApproveCompOffCreditRequest{}
+	mi := &file_leave_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveLeaveRequestRequest) String() string {
+func (x *ApproveCompOffCreditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveLeaveRequestRequest) ProtoMessage() {}
+func (*ApproveCompOffCreditRequest) ProtoMessage() {}
 
-func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[11]
+func (x *ApproveCompOffCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,54 +4410,48 @@ func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*ApproveLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ApproveCompOffCreditRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCompOffCreditRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ApproveLeaveRequestRequest) GetId() string {
+func (x *ApproveCompOffCreditRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ApproveLeaveRequestRequest) GetApproverId() string {
-	if x != nil {
-		return x.ApproverId
-	}
-	return ""
-}
-
-func (x *ApproveLeaveRequestRequest) GetComments() string {
+func (x *ApproveCompOffCreditRequest) GetComments() string {
 	if x != nil {
 		return x.Comments
 	}
 	return ""
 }
 
-type ApproveLeaveRequestResponse struct {
+type ApproveCompOffCreditResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Credit        *CompOffCredit         `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
+	LeaveBalance  *LeaveBalance          `protobuf:"bytes,2,opt,name=leave_balance,json=leaveBalance,proto3" json:"leave_balance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveLeaveRequestResponse) Reset() {
-	*x = ApproveLeaveRequestResponse{}
-	mi := &file_leave_proto_msgTypes[12]
+func (x *ApproveCompOffCreditResponse) Reset() {
+	*x = ApproveCompOffCreditResponse{}
+	mi := &file_leave_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveLeaveRequestResponse) String() string {
+func (x *ApproveCompOffCreditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveLeaveRequestResponse) ProtoMessage() {}
+func (*ApproveCompOffCreditResponse) ProtoMessage() {}
 
-func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[12]
+func (x *ApproveCompOffCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,145 +4462,48 @@ func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*ApproveLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ApproveCompOffCreditResponse.ProtoReflect.Descriptor instead.
+func (*ApproveCompOffCreditResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ApproveLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+func (x *ApproveCompOffCreditResponse) GetCredit() *CompOffCredit {
 	if x != nil {
-		return x.LeaveRequest
+		return x.Credit
 	}
 	return nil
 }
 
-type RejectLeaveRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
-	Comments      string                 `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RejectLeaveRequestRequest) Reset() {
-	*x = RejectLeaveRequestRequest{}
-	mi := &file_leave_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RejectLeaveRequestRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RejectLeaveRequestRequest) ProtoMessage() {}
-
-func (x *RejectLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[13]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RejectLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*RejectLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *RejectLeaveRequestRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *RejectLeaveRequestRequest) GetApproverId() string {
-	if x != nil {
-		return x.ApproverId
-	}
-	return ""
-}
-
-func (x *RejectLeaveRequestRequest) GetComments() string {
-	if x != nil {
-		return x.Comments
-	}
-	return ""
-}
-
-type RejectLeaveRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RejectLeaveRequestResponse) Reset() {
-	*x = RejectLeaveRequestResponse{}
-	mi := &file_leave_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RejectLeaveRequestResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RejectLeaveRequestResponse) ProtoMessage() {}
-
-func (x *RejectLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RejectLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*RejectLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *RejectLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+func (x *ApproveCompOffCreditResponse) GetLeaveBalance() *LeaveBalance {
 	if x != nil {
-		return x.LeaveRequest
+		return x.LeaveBalance
 	}
 	return nil
 }
 
-type GetEmployeeLeaveBalanceRequest struct {
+type RejectCompOffCreditRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comments      string                 `protobuf:"bytes,2,opt,name=comments,proto3" json:"comments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeLeaveBalanceRequest) Reset() {
-	*x = GetEmployeeLeaveBalanceRequest{}
-	mi := &file_leave_proto_msgTypes[15]
+func (x *RejectCompOffCreditRequest) Reset() {
+	*x = RejectCompOffCreditRequest{}
+	mi := &file_leave_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeLeaveBalanceRequest) String() string {
+func (x *RejectCompOffCreditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeLeaveBalanceRequest) ProtoMessage() {}
+func (*RejectCompOffCreditRequest) ProtoMessage() {}
 
-func (x *GetEmployeeLeaveBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[15]
+func (x *RejectCompOffCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,47 +4514,47 @@ func (x *GetEmployeeLeaveBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeLeaveBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeeLeaveBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RejectCompOffCreditRequest.ProtoReflect.Descriptor instead.
+func (*RejectCompOffCreditRequest) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetEmployeeLeaveBalanceRequest) GetEmployeeId() string {
+func (x *RejectCompOffCreditRequest) GetId() string {
 	if x != nil {
-		return x.EmployeeId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetEmployeeLeaveBalanceRequest) GetYear() int32 {
+func (x *RejectCompOffCreditRequest) GetComments() string {
 	if x != nil {
-		return x.Year
+		return x.Comments
 	}
-	return 0
+	return ""
 }
 
-type GetEmployeeLeaveBalanceResponse struct {
+type RejectCompOffCreditResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveBalances []*LeaveBalance        `protobuf:"bytes,1,rep,name=leave_balances,json=leaveBalances,proto3" json:"leave_balances,omitempty"`
+	Credit        *CompOffCredit         `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeLeaveBalanceResponse) Reset() {
-	*x = GetEmployeeLeaveBalanceResponse{}
-	mi := &file_leave_proto_msgTypes[16]
+func (x *RejectCompOffCreditResponse) Reset() {
+	*x = RejectCompOffCreditResponse{}
+	mi := &file_leave_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeLeaveBalanceResponse) String() string {
+func (x *RejectCompOffCreditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeLeaveBalanceResponse) ProtoMessage() {}
+func (*RejectCompOffCreditResponse) ProtoMessage() {}
 
-func (x *GetEmployeeLeaveBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leave_proto_msgTypes[16]
+func (x *RejectCompOffCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leave_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,358 +4565,694 @@ func (x *GetEmployeeLeaveBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeLeaveBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetEmployeeLeaveBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_leave_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RejectCompOffCreditResponse.ProtoReflect.Descriptor instead.
+func (*RejectCompOffCreditResponse) Descriptor() ([]byte, []int) {
+	return file_leave_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetEmployeeLeaveBalanceResponse) GetLeaveBalances() []*LeaveBalance {
+func (x *RejectCompOffCreditResponse) GetCredit() *CompOffCredit {
 	if x != nil {
-		return x.LeaveBalances
+		return x.Credit
 	}
 	return nil
 }
 
 var File_leave_proto protoreflect.FileDescriptor
 
-var file_leave_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x68,
-	0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x05, 0x0a, 0x0c, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x35, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x61,
-	0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x73,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x0c, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x79, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x61, 0x79,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x44, 0x61, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0xfd, 0x01, 0x0a, 0x19, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x68,
-	0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x1a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xec, 0x01, 0x0a,
-	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x65,
-	0x61, 0x76, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
-	0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x1a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x65, 0x61,
-	0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x6c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x68, 0x72,
-	0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xaf,
-	0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0d, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x22, 0x69, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x1b, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x65,
-	0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x6c, 0x65,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x19, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x5c, 0x0a, 0x1a, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x72, 0x2e, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x55, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x63, 0x0a, 0x1f, 0x47, 0x65, 0x74,
-	0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x0d, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2a, 0xba,
-	0x01, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16,
-	0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x41, 0x56,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12,
-	0x13, 0x0a, 0x0f, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49,
-	0x43, 0x4b, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x4e, 0x49, 0x54, 0x59, 0x10, 0x03, 0x12, 0x18,
-	0x0a, 0x14, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x54,
-	0x45, 0x52, 0x4e, 0x49, 0x54, 0x59, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x45, 0x41, 0x56,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59,
-	0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x06, 0x2a, 0x97, 0x01, 0x0a, 0x0b,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x4c,
-	0x45, 0x41, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x45, 0x41,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19,
-	0x0a, 0x15, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
-	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x45, 0x41,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
-	0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbb, 0x06, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x68, 0x72, 0x2e, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x68, 0x72, 0x2e,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x62, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
-	0x25, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68,
-	0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x68, 0x72,
-	0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12,
-	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x72, 0x2e,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x68, 0x72, 0x2e,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65,
-	0x61, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
-	0x65, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x72, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x22, 0x5a, 0x20, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x3b,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_leave_proto_rawDesc = "" +
+	"\n" +
+	"\vleave.proto\x12\vhr.leave.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x8f\v\n" +
+	"\fLeaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12#\n" +
+	"\remployee_name\x18\x03 \x01(\tR\femployeeName\x125\n" +
+	"\n" +
+	"leave_type\x18\x04 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12%\n" +
+	"\x0edays_requested\x18\a \x01(\x01R\rdaysRequested\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12;\n" +
+	"\fleave_status\x18\t \x01(\x0e2\x18.hr.leave.v1.LeaveStatusR\vleaveStatus\x12\x1f\n" +
+	"\vapprover_id\x18\n" +
+	" \x01(\tR\n" +
+	"approverId\x12#\n" +
+	"\rapprover_name\x18\v \x01(\tR\fapproverName\x12\x1a\n" +
+	"\bcomments\x18\f \x01(\tR\bcomments\x12;\n" +
+	"\vapproved_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\x0eexcluded_dates\x18\x10 \x03(\v2\x19.hr.leave.v1.ExcludedDateR\rexcludedDates\x126\n" +
+	"\bduration\x18\x11 \x01(\x0e2\x1a.hr.leave.v1.LeaveDurationR\bduration\x12\x14\n" +
+	"\x05hours\x18\x12 \x01(\x01R\x05hours\x12!\n" +
+	"\fcurrent_step\x18\x13 \x01(\x05R\vcurrentStep\x12E\n" +
+	"\x0eapproval_steps\x18\x14 \x03(\v2\x1e.hr.leave.v1.LeaveApprovalStepR\rapprovalSteps\x120\n" +
+	"\x14pending_approver_ids\x18\x15 \x03(\tR\x12pendingApproverIds\x122\n" +
+	"\x15pending_approver_role\x18\x16 \x01(\tR\x13pendingApproverRole\x12!\n" +
+	"\fcancelled_by\x18\x17 \x01(\tR\vcancelledBy\x12=\n" +
+	"\fcancelled_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12/\n" +
+	"\x13cancellation_reason\x18\x19 \x01(\tR\x12cancellationReason\x12F\n" +
+	"\x11original_end_date\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\x0foriginalEndDate\x12%\n" +
+	"\x0ewithdrawn_days\x18\x1b \x01(\x01R\rwithdrawnDays\x12!\n" +
+	"\fwithdrawn_by\x18\x1c \x01(\tR\vwithdrawnBy\x12=\n" +
+	"\fwithdrawn_at\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\vwithdrawnAt\x12+\n" +
+	"\x11withdrawal_reason\x18\x1e \x01(\tR\x10withdrawalReason\"\xd9\x02\n" +
+	"\x11LeaveApprovalStep\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x12>\n" +
+	"\rapprover_type\x18\x02 \x01(\x0e2\x19.hr.leave.v1.ApproverTypeR\fapproverType\x12\x1f\n" +
+	"\vapprover_id\x18\x03 \x01(\tR\n" +
+	"approverId\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.hr.leave.v1.ApprovalStepStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\x05 \x01(\tR\tdecidedBy\x12 \n" +
+	"\fon_behalf_of\x18\x06 \x01(\tR\n" +
+	"onBehalfOf\x12\x1a\n" +
+	"\bcomments\x18\a \x01(\tR\bcomments\x129\n" +
+	"\n" +
+	"decided_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"j\n" +
+	"\fExcludedDate\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x8f\x01\n" +
+	"\rLeaveConflict\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xb1\x03\n" +
+	"\fLeaveBalance\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x125\n" +
+	"\n" +
+	"leave_type\x18\x02 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x12\x1d\n" +
+	"\n" +
+	"total_days\x18\x03 \x01(\x01R\ttotalDays\x12\x1b\n" +
+	"\tused_days\x18\x04 \x01(\x01R\busedDays\x12%\n" +
+	"\x0eremaining_days\x18\x05 \x01(\x01R\rremainingDays\x12\x12\n" +
+	"\x04year\x18\x06 \x01(\x05R\x04year\x12!\n" +
+	"\faccrued_days\x18\a \x01(\x01R\vaccruedDays\x12!\n" +
+	"\fcarried_days\x18\b \x01(\x01R\vcarriedDays\x12D\n" +
+	"\x10carry_expires_on\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0ecarryExpiresOn\x12!\n" +
+	"\fexpired_days\x18\n" +
+	" \x01(\x01R\vexpiredDays\x12#\n" +
+	"\rencashed_days\x18\v \x01(\x01R\fencashedDays\"\xcb\x02\n" +
+	"\x19CreateLeaveRequestRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x125\n" +
+	"\n" +
+	"leave_type\x18\x02 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x126\n" +
+	"\bduration\x18\x06 \x01(\x0e2\x1a.hr.leave.v1.LeaveDurationR\bduration\x12\x14\n" +
+	"\x05hours\x18\a \x01(\x01R\x05hours\"\x94\x01\n" +
+	"\x1aCreateLeaveRequestResponse\x12>\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveRequestR\fleaveRequest\x126\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x1a.hr.leave.v1.LeaveConflictR\bwarnings\"(\n" +
+	"\x16GetLeaveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
+	"\x17GetLeaveRequestResponse\x12>\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveRequestR\fleaveRequest\"\xba\x02\n" +
+	"\x19UpdateLeaveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\n" +
+	"leave_type\x18\x02 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x126\n" +
+	"\bduration\x18\x06 \x01(\x0e2\x1a.hr.leave.v1.LeaveDurationR\bduration\x12\x14\n" +
+	"\x05hours\x18\a \x01(\x01R\x05hours\"\x94\x01\n" +
+	"\x1aUpdateLeaveRequestResponse\x12>\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveRequestR\fleaveRequest\x126\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x1a.hr.leave.v1.LeaveConflictR\bwarnings\"+\n" +
+	"\x19DeleteLeaveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd5\x01\n" +
+	"\x18ListLeaveRequestsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.hr.leave.v1.LeaveStatusR\x06status\x125\n" +
+	"\n" +
+	"leave_type\x18\x05 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\"\xaf\x01\n" +
+	"\x19ListLeaveRequestsResponse\x12@\n" +
+	"\x0eleave_requests\x18\x01 \x03(\v2\x19.hr.leave.v1.LeaveRequestR\rleaveRequests\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"i\n" +
+	"\x1aApproveLeaveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vapprover_id\x18\x02 \x01(\tR\n" +
+	"approverId\x12\x1a\n" +
+	"\bcomments\x18\x03 \x01(\tR\bcomments\"\x95\x01\n" +
+	"\x1bApproveLeaveRequestResponse\x12>\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveRequestR\fleaveRequest\x126\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x1a.hr.leave.v1.LeaveConflictR\bwarnings\"h\n" +
+	"\x19RejectLeaveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vapprover_id\x18\x02 \x01(\tR\n" +
+	"approverId\x12\x1a\n" +
+	"\bcomments\x18\x03 \x01(\tR\bcomments\"\\\n" +
+	"\x1aRejectLeaveRequestResponse\x12>\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveRequestR\fleaveRequest\"C\n" +
+	"\x19CancelLeaveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\\\n" +
+	"\x1aCancelLeaveRequestResponse\x12>\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveRequestR\fleaveRequest\"|\n" +
+	"\x1bWithdrawLeaveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\blast_day\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\alastDay\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x83\x01\n" +
+	"\x1cWithdrawLeaveRequestResponse\x12>\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveRequestR\fleaveRequest\x12#\n" +
+	"\rrestored_days\x18\x02 \x01(\x01R\frestoredDays\"\x8b\x01\n" +
+	"\x0fCalendarHoliday\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\"\xce\x01\n" +
+	"\x16GetTeamCalendarRequest\x12#\n" +
+	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x02 \x01(\tR\tmanagerId\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x8a\x01\n" +
+	"\x17GetTeamCalendarResponse\x125\n" +
+	"\babsences\x18\x01 \x03(\v2\x19.hr.leave.v1.LeaveRequestR\babsences\x128\n" +
+	"\bholidays\x18\x02 \x03(\v2\x1c.hr.leave.v1.CalendarHolidayR\bholidays\"\xd4\x01\n" +
+	"\x1aExportLeaveCalendarRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12#\n" +
+	"\rdepartment_id\x18\x02 \x01(\tR\fdepartmentId\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"v\n" +
+	"\x1bExportLeaveCalendarResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"U\n" +
+	"\x1eGetEmployeeLeaveBalanceRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"c\n" +
+	"\x1fGetEmployeeLeaveBalanceResponse\x12@\n" +
+	"\x0eleave_balances\x18\x01 \x03(\v2\x19.hr.leave.v1.LeaveBalanceR\rleaveBalances\"\xe2\x02\n" +
+	"\vLeavePolicy\x125\n" +
+	"\n" +
+	"leave_type\x18\x01 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x12A\n" +
+	"\x0eaccrual_method\x18\x02 \x01(\x0e2\x1a.hr.leave.v1.AccrualMethodR\raccrualMethod\x12\"\n" +
+	"\rdays_per_year\x18\x03 \x01(\x01R\vdaysPerYear\x12)\n" +
+	"\x10probation_months\x18\x04 \x01(\x05R\x0fprobationMonths\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x123\n" +
+	"\x16carry_forward_max_days\x18\x06 \x01(\x01R\x13carryForwardMaxDays\x12=\n" +
+	"\x1bcarry_forward_expiry_months\x18\a \x01(\x05R\x18carryForwardExpiryMonths\"\x1a\n" +
+	"\x18ListLeavePoliciesRequest\"Q\n" +
+	"\x19ListLeavePoliciesResponse\x124\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x18.hr.leave.v1.LeavePolicyR\bpolicies\"I\n" +
+	"\x15SetLeavePolicyRequest\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.hr.leave.v1.LeavePolicyR\x06policy\"J\n" +
+	"\x16SetLeavePolicyResponse\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.hr.leave.v1.LeavePolicyR\x06policy\"\xfc\x01\n" +
+	"\fLeaveAccrual\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x125\n" +
+	"\n" +
+	"leave_type\x18\x02 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12#\n" +
+	"\rentitled_days\x18\x04 \x01(\x01R\fentitledDays\x126\n" +
+	"\x17previously_accrued_days\x18\x05 \x01(\x01R\x15previouslyAccruedDays\x12#\n" +
+	"\rcredited_days\x18\x06 \x01(\x01R\fcreditedDays\"f\n" +
+	"\x16RunLeaveAccrualRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\"\xba\x02\n" +
+	"\x17RunLeaveAccrualResponse\x125\n" +
+	"\baccruals\x18\x01 \x03(\v2\x19.hr.leave.v1.LeaveAccrualR\baccruals\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12/\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12/\n" +
+	"\x13employees_processed\x18\x05 \x01(\x05R\x12employeesProcessed\x12)\n" +
+	"\x10employees_failed\x18\x06 \x01(\x05R\x0femployeesFailed\x12.\n" +
+	"\x13total_credited_days\x18\a \x01(\x01R\x11totalCreditedDays\"\xa5\x02\n" +
+	"\x11LeaveCarryForward\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x125\n" +
+	"\n" +
+	"leave_type\x18\x02 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12\x1f\n" +
+	"\vunused_days\x18\x04 \x01(\x01R\n" +
+	"unusedDays\x12!\n" +
+	"\fcarried_days\x18\x05 \x01(\x01R\vcarriedDays\x12%\n" +
+	"\x0eforfeited_days\x18\x06 \x01(\x01R\rforfeitedDays\x129\n" +
+	"\n" +
+	"expires_on\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresOn\"D\n" +
+	"\x15CloseLeaveYearRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xbe\x02\n" +
+	"\x16CloseLeaveYearResponse\x12E\n" +
+	"\x0ecarry_forwards\x18\x01 \x03(\v2\x1e.hr.leave.v1.LeaveCarryForwardR\rcarryForwards\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12'\n" +
+	"\x0fbalances_closed\x18\x04 \x01(\x05R\x0ebalancesClosed\x12'\n" +
+	"\x0fbalances_failed\x18\x05 \x01(\x05R\x0ebalancesFailed\x12,\n" +
+	"\x12total_carried_days\x18\x06 \x01(\x01R\x10totalCarriedDays\x120\n" +
+	"\x14total_forfeited_days\x18\a \x01(\x01R\x12totalForfeitedDays\"\x84\x02\n" +
+	"\x0fPayrollLineItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\titem_type\x18\x03 \x01(\tR\bitemType\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\x01R\x04rate\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"]\n" +
+	"\x12EncashLeaveRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x01R\x04days\"\x90\x01\n" +
+	"\x13EncashLeaveResponse\x12>\n" +
+	"\rleave_balance\x18\x01 \x01(\v2\x19.hr.leave.v1.LeaveBalanceR\fleaveBalance\x129\n" +
+	"\tline_item\x18\x02 \x01(\v2\x1c.hr.leave.v1.PayrollLineItemR\blineItem\"}\n" +
+	"\fApprovalRule\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x12>\n" +
+	"\rapprover_type\x18\x02 \x01(\x0e2\x19.hr.leave.v1.ApproverTypeR\fapproverType\x12\x19\n" +
+	"\bmin_days\x18\x03 \x01(\x01R\aminDays\"Q\n" +
+	"\x18ListApprovalRulesRequest\x125\n" +
+	"\n" +
+	"leave_type\x18\x01 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\"L\n" +
+	"\x19ListApprovalRulesResponse\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.hr.leave.v1.ApprovalRuleR\x05rules\"\x81\x01\n" +
+	"\x17SetApprovalRulesRequest\x125\n" +
+	"\n" +
+	"leave_type\x18\x01 \x01(\x0e2\x16.hr.leave.v1.LeaveTypeR\tleaveType\x12/\n" +
+	"\x05rules\x18\x02 \x03(\v2\x19.hr.leave.v1.ApprovalRuleR\x05rules\"K\n" +
+	"\x18SetApprovalRulesResponse\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.hr.leave.v1.ApprovalRuleR\x05rules\"\xab\x02\n" +
+	"\x12ApprovalDelegation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1f\n" +
+	"\vdelegate_id\x18\x03 \x01(\tR\n" +
+	"delegateId\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xed\x01\n" +
+	"\x1fCreateApprovalDelegationRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x1f\n" +
+	"\vdelegate_id\x18\x02 \x01(\tR\n" +
+	"delegateId\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"c\n" +
+	" CreateApprovalDelegationResponse\x12?\n" +
+	"\n" +
+	"delegation\x18\x01 \x01(\v2\x1f.hr.leave.v1.ApprovalDelegationR\n" +
+	"delegation\"b\n" +
+	"\x1eListApprovalDelegationsRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"d\n" +
+	"\x1fListApprovalDelegationsResponse\x12A\n" +
+	"\vdelegations\x18\x01 \x03(\v2\x1f.hr.leave.v1.ApprovalDelegationR\vdelegations\"1\n" +
+	"\x1fDeleteApprovalDelegationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xed\x04\n" +
+	"\rCompOffCredit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12#\n" +
+	"\remployee_name\x18\x03 \x01(\tR\femployeeName\x127\n" +
+	"\twork_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bworkDate\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x01R\x04days\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x122\n" +
+	"\x06status\x18\a \x01(\x0e2\x1a.hr.leave.v1.CompOffStatusR\x06status\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12\x1f\n" +
+	"\vapprover_id\x18\t \x01(\tR\n" +
+	"approverId\x12\x1a\n" +
+	"\bcomments\x18\n" +
+	" \x01(\tR\bcomments\x129\n" +
+	"\n" +
+	"decided_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x129\n" +
+	"\n" +
+	"expires_on\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresOn\x12!\n" +
+	"\fexpired_days\x18\r \x01(\x01R\vexpiredDays\x129\n" +
+	"\n" +
+	"expired_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa3\x01\n" +
+	"\x1bRequestCompOffCreditRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x127\n" +
+	"\twork_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bworkDate\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x01R\x04days\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"R\n" +
+	"\x1cRequestCompOffCreditResponse\x122\n" +
+	"\x06credit\x18\x01 \x01(\v2\x1a.hr.leave.v1.CompOffCreditR\x06credit\"\xa1\x01\n" +
+	"\x19ListCompOffCreditsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x122\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1a.hr.leave.v1.CompOffStatusR\x06status\"\xa4\x01\n" +
+	"\x1aListCompOffCreditsResponse\x124\n" +
+	"\acredits\x18\x01 \x03(\v2\x1a.hr.leave.v1.CompOffCreditR\acredits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"I\n" +
+	"\x1bApproveCompOffCreditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcomments\x18\x02 \x01(\tR\bcomments\"\x92\x01\n" +
+	"\x1cApproveCompOffCreditResponse\x122\n" +
+	"\x06credit\x18\x01 \x01(\v2\x1a.hr.leave.v1.CompOffCreditR\x06credit\x12>\n" +
+	"\rleave_balance\x18\x02 \x01(\v2\x19.hr.leave.v1.LeaveBalanceR\fleaveBalance\"H\n" +
+	"\x1aRejectCompOffCreditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcomments\x18\x02 \x01(\tR\bcomments\"Q\n" +
+	"\x1bRejectCompOffCreditResponse\x122\n" +
+	"\x06credit\x18\x01 \x01(\v2\x1a.hr.leave.v1.CompOffCreditR\x06credit*\x84\x01\n" +
+	"\fApproverType\x12\x1d\n" +
+	"\x19APPROVER_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15APPROVER_TYPE_MANAGER\x10\x01\x12$\n" +
+	" APPROVER_TYPE_DEPARTMENT_MANAGER\x10\x02\x12\x14\n" +
+	"\x10APPROVER_TYPE_HR\x10\x03*\xc4\x01\n" +
+	"\x12ApprovalStepStatus\x12$\n" +
+	" APPROVAL_STEP_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cAPPROVAL_STEP_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dAPPROVAL_STEP_STATUS_APPROVED\x10\x02\x12!\n" +
+	"\x1dAPPROVAL_STEP_STATUS_REJECTED\x10\x03\x12 \n" +
+	"\x1cAPPROVAL_STEP_STATUS_SKIPPED\x10\x04*\xd3\x01\n" +
+	"\tLeaveType\x12\x1a\n" +
+	"\x16LEAVE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LEAVE_TYPE_ANNUAL\x10\x01\x12\x13\n" +
+	"\x0fLEAVE_TYPE_SICK\x10\x02\x12\x18\n" +
+	"\x14LEAVE_TYPE_MATERNITY\x10\x03\x12\x18\n" +
+	"\x14LEAVE_TYPE_PATERNITY\x10\x04\x12\x18\n" +
+	"\x14LEAVE_TYPE_EMERGENCY\x10\x05\x12\x17\n" +
+	"\x13LEAVE_TYPE_PERSONAL\x10\x06\x12\x17\n" +
+	"\x13LEAVE_TYPE_COMP_OFF\x10\a*\xa7\x01\n" +
+	"\rLeaveDuration\x12\x1e\n" +
+	"\x1aLEAVE_DURATION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17LEAVE_DURATION_FULL_DAY\x10\x01\x12\x1e\n" +
+	"\x1aLEAVE_DURATION_HALF_DAY_AM\x10\x02\x12\x1e\n" +
+	"\x1aLEAVE_DURATION_HALF_DAY_PM\x10\x03\x12\x19\n" +
+	"\x15LEAVE_DURATION_HOURLY\x10\x04*\x97\x01\n" +
+	"\vLeaveStatus\x12\x1c\n" +
+	"\x18LEAVE_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14LEAVE_STATUS_PENDING\x10\x01\x12\x19\n" +
+	"\x15LEAVE_STATUS_APPROVED\x10\x02\x12\x19\n" +
+	"\x15LEAVE_STATUS_REJECTED\x10\x03\x12\x1a\n" +
+	"\x16LEAVE_STATUS_CANCELLED\x10\x04*f\n" +
+	"\rAccrualMethod\x12\x1e\n" +
+	"\x1aACCRUAL_METHOD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ACCRUAL_METHOD_ANNUAL\x10\x01\x12\x1a\n" +
+	"\x16ACCRUAL_METHOD_MONTHLY\x10\x02*\x89\x01\n" +
+	"\rCompOffStatus\x12\x1f\n" +
+	"\x1bCOMP_OFF_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17COMP_OFF_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18COMP_OFF_STATUS_APPROVED\x10\x02\x12\x1c\n" +
+	"\x18COMP_OFF_STATUS_REJECTED\x10\x032\xe2\x14\n" +
+	"\fLeaveService\x12\\\n" +
+	"\x0fGetLeaveRequest\x12#.hr.leave.v1.GetLeaveRequestRequest\x1a$.hr.leave.v1.GetLeaveRequestResponse\x12T\n" +
+	"\x12DeleteLeaveRequest\x12&.hr.leave.v1.DeleteLeaveRequestRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x11ListLeaveRequests\x12%.hr.leave.v1.ListLeaveRequestsRequest\x1a&.hr.leave.v1.ListLeaveRequestsResponse\x12e\n" +
+	"\x12CreateLeaveRequest\x12&.hr.leave.v1.CreateLeaveRequestRequest\x1a'.hr.leave.v1.CreateLeaveRequestResponse\x12e\n" +
+	"\x12UpdateLeaveRequest\x12&.hr.leave.v1.UpdateLeaveRequestRequest\x1a'.hr.leave.v1.UpdateLeaveRequestResponse\x12e\n" +
+	"\x12RejectLeaveRequest\x12&.hr.leave.v1.RejectLeaveRequestRequest\x1a'.hr.leave.v1.RejectLeaveRequestResponse\x12h\n" +
+	"\x13ApproveLeaveRequest\x12'.hr.leave.v1.ApproveLeaveRequestRequest\x1a(.hr.leave.v1.ApproveLeaveRequestResponse\x12t\n" +
+	"\x17GetEmployeeLeaveBalance\x12+.hr.leave.v1.GetEmployeeLeaveBalanceRequest\x1a,.hr.leave.v1.GetEmployeeLeaveBalanceResponse\x12b\n" +
+	"\x11ListLeavePolicies\x12%.hr.leave.v1.ListLeavePoliciesRequest\x1a&.hr.leave.v1.ListLeavePoliciesResponse\x12Y\n" +
+	"\x0eSetLeavePolicy\x12\".hr.leave.v1.SetLeavePolicyRequest\x1a#.hr.leave.v1.SetLeavePolicyResponse\x12\\\n" +
+	"\x0fRunLeaveAccrual\x12#.hr.leave.v1.RunLeaveAccrualRequest\x1a$.hr.leave.v1.RunLeaveAccrualResponse\x12Y\n" +
+	"\x0eCloseLeaveYear\x12\".hr.leave.v1.CloseLeaveYearRequest\x1a#.hr.leave.v1.CloseLeaveYearResponse\x12P\n" +
+	"\vEncashLeave\x12\x1f.hr.leave.v1.EncashLeaveRequest\x1a .hr.leave.v1.EncashLeaveResponse\x12b\n" +
+	"\x11ListApprovalRules\x12%.hr.leave.v1.ListApprovalRulesRequest\x1a&.hr.leave.v1.ListApprovalRulesResponse\x12_\n" +
+	"\x10SetApprovalRules\x12$.hr.leave.v1.SetApprovalRulesRequest\x1a%.hr.leave.v1.SetApprovalRulesResponse\x12w\n" +
+	"\x18CreateApprovalDelegation\x12,.hr.leave.v1.CreateApprovalDelegationRequest\x1a-.hr.leave.v1.CreateApprovalDelegationResponse\x12t\n" +
+	"\x17ListApprovalDelegations\x12+.hr.leave.v1.ListApprovalDelegationsRequest\x1a,.hr.leave.v1.ListApprovalDelegationsResponse\x12`\n" +
+	"\x18DeleteApprovalDelegation\x12,.hr.leave.v1.DeleteApprovalDelegationRequest\x1a\x16.google.protobuf.Empty\x12e\n" +
+	"\x12CancelLeaveRequest\x12&.hr.leave.v1.CancelLeaveRequestRequest\x1a'.hr.leave.v1.CancelLeaveRequestResponse\x12k\n" +
+	"\x14WithdrawLeaveRequest\x12(.hr.leave.v1.WithdrawLeaveRequestRequest\x1a).hr.leave.v1.WithdrawLeaveRequestResponse\x12\\\n" +
+	"\x0fGetTeamCalendar\x12#.hr.leave.v1.GetTeamCalendarRequest\x1a$.hr.leave.v1.GetTeamCalendarResponse\x12h\n" +
+	"\x13ExportLeaveCalendar\x12'.hr.leave.v1.ExportLeaveCalendarRequest\x1a(.hr.leave.v1.ExportLeaveCalendarResponse\x12k\n" +
+	"\x14RequestCompOffCredit\x12(.hr.leave.v1.RequestCompOffCreditRequest\x1a).hr.leave.v1.RequestCompOffCreditResponse\x12e\n" +
+	"\x12ListCompOffCredits\x12&.hr.leave.v1.ListCompOffCreditsRequest\x1a'.hr.leave.v1.ListCompOffCreditsResponse\x12k\n" +
+	"\x14ApproveCompOffCredit\x12(.hr.leave.v1.ApproveCompOffCreditRequest\x1a).hr.leave.v1.ApproveCompOffCreditResponse\x12h\n" +
+	"\x13RejectCompOffCredit\x12'.hr.leave.v1.RejectCompOffCreditRequest\x1a(.hr.leave.v1.RejectCompOffCreditResponseB\"Z ./api/proto/v1/gen/leave;leavev1b\x06proto3"
 
 var (
 	file_leave_proto_rawDescOnce sync.Once
-	file_leave_proto_rawDescData = file_leave_proto_rawDesc
+	file_leave_proto_rawDescData []byte
 )
 
 func file_leave_proto_rawDescGZIP() []byte {
 	file_leave_proto_rawDescOnce.Do(func() {
-		file_leave_proto_rawDescData = protoimpl.X.CompressGZIP(file_leave_proto_rawDescData)
+		file_leave_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_leave_proto_rawDesc), len(file_leave_proto_rawDesc)))
 	})
 	return file_leave_proto_rawDescData
 }
 
-var file_leave_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_leave_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_leave_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_leave_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_leave_proto_goTypes = []any{
-	(LeaveType)(0),                          // 0: hr.leave.v1.LeaveType
-	(LeaveStatus)(0),                        // 1: hr.leave.v1.LeaveStatus
-	(*LeaveRequest)(nil),                    // 2: hr.leave.v1.LeaveRequest
-	(*LeaveBalance)(nil),                    // 3: hr.leave.v1.LeaveBalance
-	(*CreateLeaveRequestRequest)(nil),       // 4: hr.leave.v1.CreateLeaveRequestRequest
-	(*CreateLeaveRequestResponse)(nil),      // 5: hr.leave.v1.CreateLeaveRequestResponse
-	(*GetLeaveRequestRequest)(nil),          // 6: hr.leave.v1.GetLeaveRequestRequest
-	(*GetLeaveRequestResponse)(nil),         // 7: hr.leave.v1.GetLeaveRequestResponse
-	(*UpdateLeaveRequestRequest)(nil),       // 8: hr.leave.v1.UpdateLeaveRequestRequest
-	(*UpdateLeaveRequestResponse)(nil),      // 9: hr.leave.v1.UpdateLeaveRequestResponse
-	(*DeleteLeaveRequestRequest)(nil),       // 10: hr.leave.v1.DeleteLeaveRequestRequest
-	(*ListLeaveRequestsRequest)(nil),        // 11: hr.leave.v1.ListLeaveRequestsRequest
-	(*ListLeaveRequestsResponse)(nil),       // 12: hr.leave.v1.ListLeaveRequestsResponse
-	(*ApproveLeaveRequestRequest)(nil),      // 13: hr.leave.v1.ApproveLeaveRequestRequest
-	(*ApproveLeaveRequestResponse)(nil),     // 14: hr.leave.v1.ApproveLeaveRequestResponse
-	(*RejectLeaveRequestRequest)(nil),       // 15: hr.leave.v1.RejectLeaveRequestRequest
-	(*RejectLeaveRequestResponse)(nil),      // 16: hr.leave.v1.RejectLeaveRequestResponse
-	(*GetEmployeeLeaveBalanceRequest)(nil),  // 17: hr.leave.v1.GetEmployeeLeaveBalanceRequest
-	(*GetEmployeeLeaveBalanceResponse)(nil), // 18: hr.leave.v1.GetEmployeeLeaveBalanceResponse
-	(*timestamppb.Timestamp)(nil),           // 19: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 20: google.protobuf.Empty
+	(ApproverType)(0),                        // 0: hr.leave.v1.ApproverType
+	(ApprovalStepStatus)(0),                  // 1: hr.leave.v1.ApprovalStepStatus
+	(LeaveType)(0),                           // 2: hr.leave.v1.LeaveType
+	(LeaveDuration)(0),                       // 3: hr.leave.v1.LeaveDuration
+	(LeaveStatus)(0),                         // 4: hr.leave.v1.LeaveStatus
+	(AccrualMethod)(0),                       // 5: hr.leave.v1.AccrualMethod
+	(CompOffStatus)(0),                       // 6: hr.leave.v1.CompOffStatus
+	(*LeaveRequest)(nil),                     // 7: hr.leave.v1.LeaveRequest
+	(*LeaveApprovalStep)(nil),                // 8: hr.leave.v1.LeaveApprovalStep
+	(*ExcludedDate)(nil),                     // 9: hr.leave.v1.ExcludedDate
+	(*LeaveConflict)(nil),                    // 10: hr.leave.v1.LeaveConflict
+	(*LeaveBalance)(nil),                     // 11: hr.leave.v1.LeaveBalance
+	(*CreateLeaveRequestRequest)(nil),        // 12: hr.leave.v1.CreateLeaveRequestRequest
+	(*CreateLeaveRequestResponse)(nil),       // 13: hr.leave.v1.CreateLeaveRequestResponse
+	(*GetLeaveRequestRequest)(nil),           // 14: hr.leave.v1.GetLeaveRequestRequest
+	(*GetLeaveRequestResponse)(nil),          // 15: hr.leave.v1.GetLeaveRequestResponse
+	(*UpdateLeaveRequestRequest)(nil),        // 16: hr.leave.v1.UpdateLeaveRequestRequest
+	(*UpdateLeaveRequestResponse)(nil),       // 17: hr.leave.v1.UpdateLeaveRequestResponse
+	(*DeleteLeaveRequestRequest)(nil),        // 18: hr.leave.v1.DeleteLeaveRequestRequest
+	(*ListLeaveRequestsRequest)(nil),         // 19: hr.leave.v1.ListLeaveRequestsRequest
+	(*ListLeaveRequestsResponse)(nil),        // 20: hr.leave.v1.ListLeaveRequestsResponse
+	(*ApproveLeaveRequestRequest)(nil),       // 21: hr.leave.v1.ApproveLeaveRequestRequest
+	(*ApproveLeaveRequestResponse)(nil),      // 22: hr.leave.v1.ApproveLeaveRequestResponse
+	(*RejectLeaveRequestRequest)(nil),        // 23: hr.leave.v1.RejectLeaveRequestRequest
+	(*RejectLeaveRequestResponse)(nil),       // 24: hr.leave.v1.RejectLeaveRequestResponse
+	(*CancelLeaveRequestRequest)(nil),        // 25: hr.leave.v1.CancelLeaveRequestRequest
+	(*CancelLeaveRequestResponse)(nil),       // 26: hr.leave.v1.CancelLeaveRequestResponse
+	(*WithdrawLeaveRequestRequest)(nil),      // 27: hr.leave.v1.WithdrawLeaveRequestRequest
+	(*WithdrawLeaveRequestResponse)(nil),     // 28: hr.leave.v1.WithdrawLeaveRequestResponse
+	(*CalendarHoliday)(nil),                  // 29: hr.leave.v1.CalendarHoliday
+	(*GetTeamCalendarRequest)(nil),           // 30: hr.leave.v1.GetTeamCalendarRequest
+	(*GetTeamCalendarResponse)(nil),          // 31: hr.leave.v1.GetTeamCalendarResponse
+	(*ExportLeaveCalendarRequest)(nil),       // 32: hr.leave.v1.ExportLeaveCalendarRequest
+	(*ExportLeaveCalendarResponse)(nil),      // 33: hr.leave.v1.ExportLeaveCalendarResponse
+	(*GetEmployeeLeaveBalanceRequest)(nil),   // 34: hr.leave.v1.GetEmployeeLeaveBalanceRequest
+	(*GetEmployeeLeaveBalanceResponse)(nil),  // 35: hr.leave.v1.GetEmployeeLeaveBalanceResponse
+	(*LeavePolicy)(nil),                      // 36: hr.leave.v1.LeavePolicy
+	(*ListLeavePoliciesRequest)(nil),         // 37: hr.leave.v1.ListLeavePoliciesRequest
+	(*ListLeavePoliciesResponse)(nil),        // 38: hr.leave.v1.ListLeavePoliciesResponse
+	(*SetLeavePolicyRequest)(nil),            // 39: hr.leave.v1.SetLeavePolicyRequest
+	(*SetLeavePolicyResponse)(nil),           // 40: hr.leave.v1.SetLeavePolicyResponse
+	(*LeaveAccrual)(nil),                     // 41: hr.leave.v1.LeaveAccrual
+	(*RunLeaveAccrualRequest)(nil),           // 42: hr.leave.v1.RunLeaveAccrualRequest
+	(*RunLeaveAccrualResponse)(nil),          // 43: hr.leave.v1.RunLeaveAccrualResponse
+	(*LeaveCarryForward)(nil),                // 44: hr.leave.v1.LeaveCarryForward
+	(*CloseLeaveYearRequest)(nil),            // 45: hr.leave.v1.CloseLeaveYearRequest
+	(*CloseLeaveYearResponse)(nil),           // 46: hr.leave.v1.CloseLeaveYearResponse
+	(*PayrollLineItem)(nil),                  // 47: hr.leave.v1.PayrollLineItem
+	(*EncashLeaveRequest)(nil),               // 48: hr.leave.v1.EncashLeaveRequest
+	(*EncashLeaveResponse)(nil),              // 49: hr.leave.v1.EncashLeaveResponse
+	(*ApprovalRule)(nil),                     // 50: hr.leave.v1.ApprovalRule
+	(*ListApprovalRulesRequest)(nil),         // 51: hr.leave.v1.ListApprovalRulesRequest
+	(*ListApprovalRulesResponse)(nil),        // 52: hr.leave.v1.ListApprovalRulesResponse
+	(*SetApprovalRulesRequest)(nil),          // 53: hr.leave.v1.SetApprovalRulesRequest
+	(*SetApprovalRulesResponse)(nil),         // 54: hr.leave.v1.SetApprovalRulesResponse
+	(*ApprovalDelegation)(nil),               // 55: hr.leave.v1.ApprovalDelegation
+	(*CreateApprovalDelegationRequest)(nil),  // 56: hr.leave.v1.CreateApprovalDelegationRequest
+	(*CreateApprovalDelegationResponse)(nil), // 57: hr.leave.v1.CreateApprovalDelegationResponse
+	(*ListApprovalDelegationsRequest)(nil),   // 58: hr.leave.v1.ListApprovalDelegationsRequest
+	(*ListApprovalDelegationsResponse)(nil),  // 59: hr.leave.v1.ListApprovalDelegationsResponse
+	(*DeleteApprovalDelegationRequest)(nil),  // 60: hr.leave.v1.DeleteApprovalDelegationRequest
+	(*CompOffCredit)(nil),                    // 61: hr.leave.v1.CompOffCredit
+	(*RequestCompOffCreditRequest)(nil),      // 62: hr.leave.v1.RequestCompOffCreditRequest
+	(*RequestCompOffCreditResponse)(nil),     // 63: hr.leave.v1.RequestCompOffCreditResponse
+	(*ListCompOffCreditsRequest)(nil),        // 64: hr.leave.v1.ListCompOffCreditsRequest
+	(*ListCompOffCreditsResponse)(nil),       // 65: hr.leave.v1.ListCompOffCreditsResponse
+	(*ApproveCompOffCreditRequest)(nil),      // 66: hr.leave.v1.ApproveCompOffCreditRequest
+	(*ApproveCompOffCreditResponse)(nil),     // 67: hr.leave.v1.ApproveCompOffCreditResponse
+	(*RejectCompOffCreditRequest)(nil),       // 68: hr.leave.v1.RejectCompOffCreditRequest
+	(*RejectCompOffCreditResponse)(nil),      // 69: hr.leave.v1.RejectCompOffCreditResponse
+	(*timestamppb.Timestamp)(nil),            // 70: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 71: google.protobuf.Empty
 }
 var file_leave_proto_depIdxs = []int32{
-	0,  // 0: hr.leave.v1.LeaveRequest.leave_type:type_name -> hr.leave.v1.LeaveType
-	19, // 1: hr.leave.v1.LeaveRequest.start_date:type_name -> google.protobuf.Timestamp
-	19, // 2: hr.leave.v1.LeaveRequest.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 3: hr.leave.v1.LeaveRequest.leave_status:type_name -> hr.leave.v1.LeaveStatus
-	19, // 4: hr.leave.v1.LeaveRequest.approved_at:type_name -> google.protobuf.Timestamp
-	19, // 5: hr.leave.v1.LeaveRequest.created_at:type_name -> google.protobuf.Timestamp
-	19, // 6: hr.leave.v1.LeaveRequest.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: hr.leave.v1.LeaveBalance.leave_type:type_name -> hr.leave.v1.LeaveType
-	0,  // 8: hr.leave.v1.CreateLeaveRequestRequest.leave_type:type_name -> hr.leave.v1.LeaveType
-	19, // 9: hr.leave.v1.CreateLeaveRequestRequest.start_date:type_name -> google.protobuf.Timestamp
-	19, // 10: hr.leave.v1.CreateLeaveRequestRequest.end_date:type_name -> google.protobuf.Timestamp
-	2,  // 11: hr.leave.v1.CreateLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
-	2,  // 12: hr.leave.v1.GetLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
-	0,  // 13: hr.leave.v1.UpdateLeaveRequestRequest.leave_type:type_name -> hr.leave.v1.LeaveType
-	19, // 14: hr.leave.v1.UpdateLeaveRequestRequest.start_date:type_name -> google.protobuf.Timestamp
-	19, // 15: hr.leave.v1.UpdateLeaveRequestRequest.end_date:type_name -> google.protobuf.Timestamp
-	2,  // 16: hr.leave.v1.UpdateLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
-	1,  // 17: hr.leave.v1.ListLeaveRequestsRequest.status:type_name -> hr.leave.v1.LeaveStatus
-	0,  // 18: hr.leave.v1.ListLeaveRequestsRequest.leave_type:type_name -> hr.leave.v1.LeaveType
-	2,  // 19: hr.leave.v1.ListLeaveRequestsResponse.leave_requests:type_name -> hr.leave.v1.LeaveRequest
-	2,  // 20: hr.leave.v1.ApproveLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
-	2,  // 21: hr.leave.v1.RejectLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
-	3,  // 22: hr.leave.v1.GetEmployeeLeaveBalanceResponse.leave_balances:type_name -> hr.leave.v1.LeaveBalance
-	6,  // 23: hr.leave.v1.LeaveService.GetLeaveRequest:input_type -> hr.leave.v1.GetLeaveRequestRequest
-	10, // 24: hr.leave.v1.LeaveService.DeleteLeaveRequest:input_type -> hr.leave.v1.DeleteLeaveRequestRequest
-	11, // 25: hr.leave.v1.LeaveService.ListLeaveRequests:input_type -> hr.leave.v1.ListLeaveRequestsRequest
-	4,  // 26: hr.leave.v1.LeaveService.CreateLeaveRequest:input_type -> hr.leave.v1.CreateLeaveRequestRequest
-	8,  // 27: hr.leave.v1.LeaveService.UpdateLeaveRequest:input_type -> hr.leave.v1.UpdateLeaveRequestRequest
-	15, // 28: hr.leave.v1.LeaveService.RejectLeaveRequest:input_type -> hr.leave.v1.RejectLeaveRequestRequest
-	13, // 29: hr.leave.v1.LeaveService.ApproveLeaveRequest:input_type -> hr.leave.v1.ApproveLeaveRequestRequest
-	17, // 30: hr.leave.v1.LeaveService.GetEmployeeLeaveBalance:input_type -> hr.leave.v1.GetEmployeeLeaveBalanceRequest
-	7,  // 31: hr.leave.v1.LeaveService.GetLeaveRequest:output_type -> hr.leave.v1.GetLeaveRequestResponse
-	20, // 32: hr.leave.v1.LeaveService.DeleteLeaveRequest:output_type -> google.protobuf.Empty
-	12, // 33: hr.leave.v1.LeaveService.ListLeaveRequests:output_type -> hr.leave.v1.ListLeaveRequestsResponse
-	5,  // 34: hr.leave.v1.LeaveService.CreateLeaveRequest:output_type -> hr.leave.v1.CreateLeaveRequestResponse
-	9,  // 35: hr.leave.v1.LeaveService.UpdateLeaveRequest:output_type -> hr.leave.v1.UpdateLeaveRequestResponse
-	16, // 36: hr.leave.v1.LeaveService.RejectLeaveRequest:output_type -> hr.leave.v1.RejectLeaveRequestResponse
-	14, // 37: hr.leave.v1.LeaveService.ApproveLeaveRequest:output_type -> hr.leave.v1.ApproveLeaveRequestResponse
-	18, // 38: hr.leave.v1.LeaveService.GetEmployeeLeaveBalance:output_type -> hr.leave.v1.GetEmployeeLeaveBalanceResponse
-	31, // [31:39] is the sub-list for method output_type
-	23, // [23:31] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	2,   // 0: hr.leave.v1.LeaveRequest.leave_type:type_name -> hr.leave.v1.LeaveType
+	70,  // 1: hr.leave.v1.LeaveRequest.start_date:type_name -> google.protobuf.Timestamp
+	70,  // 2: hr.leave.v1.LeaveRequest.end_date:type_name -> google.protobuf.Timestamp
+	4,   // 3: hr.leave.v1.LeaveRequest.leave_status:type_name -> hr.leave.v1.LeaveStatus
+	70,  // 4: hr.leave.v1.LeaveRequest.approved_at:type_name -> google.protobuf.Timestamp
+	70,  // 5: hr.leave.v1.LeaveRequest.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 6: hr.leave.v1.LeaveRequest.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 7: hr.leave.v1.LeaveRequest.excluded_dates:type_name -> hr.leave.v1.ExcludedDate
+	3,   // 8: hr.leave.v1.LeaveRequest.duration:type_name -> hr.leave.v1.LeaveDuration
+	8,   // 9: hr.leave.v1.LeaveRequest.approval_steps:type_name -> hr.leave.v1.LeaveApprovalStep
+	70,  // 10: hr.leave.v1.LeaveRequest.cancelled_at:type_name -> google.protobuf.Timestamp
+	70,  // 11: hr.leave.v1.LeaveRequest.original_end_date:type_name -> google.protobuf.Timestamp
+	70,  // 12: hr.leave.v1.LeaveRequest.withdrawn_at:type_name -> google.protobuf.Timestamp
+	0,   // 13: hr.leave.v1.LeaveApprovalStep.approver_type:type_name -> hr.leave.v1.ApproverType
+	1,   // 14: hr.leave.v1.LeaveApprovalStep.status:type_name -> hr.leave.v1.ApprovalStepStatus
+	70,  // 15: hr.leave.v1.LeaveApprovalStep.decided_at:type_name -> google.protobuf.Timestamp
+	70,  // 16: hr.leave.v1.ExcludedDate.date:type_name -> google.protobuf.Timestamp
+	70,  // 17: hr.leave.v1.LeaveConflict.date:type_name -> google.protobuf.Timestamp
+	2,   // 18: hr.leave.v1.LeaveBalance.leave_type:type_name -> hr.leave.v1.LeaveType
+	70,  // 19: hr.leave.v1.LeaveBalance.carry_expires_on:type_name -> google.protobuf.Timestamp
+	2,   // 20: hr.leave.v1.CreateLeaveRequestRequest.leave_type:type_name -> hr.leave.v1.LeaveType
+	70,  // 21: hr.leave.v1.CreateLeaveRequestRequest.start_date:type_name -> google.protobuf.Timestamp
+	70,  // 22: hr.leave.v1.CreateLeaveRequestRequest.end_date:type_name -> google.protobuf.Timestamp
+	3,   // 23: hr.leave.v1.CreateLeaveRequestRequest.duration:type_name -> hr.leave.v1.LeaveDuration
+	7,   // 24: hr.leave.v1.CreateLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
+	10,  // 25: hr.leave.v1.CreateLeaveRequestResponse.warnings:type_name -> hr.leave.v1.LeaveConflict
+	7,   // 26: hr.leave.v1.GetLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
+	2,   // 27: hr.leave.v1.UpdateLeaveRequestRequest.leave_type:type_name -> hr.leave.v1.LeaveType
+	70,  // 28: hr.leave.v1.UpdateLeaveRequestRequest.start_date:type_name -> google.protobuf.Timestamp
+	70,  // 29: hr.leave.v1.UpdateLeaveRequestRequest.end_date:type_name -> google.protobuf.Timestamp
+	3,   // 30: hr.leave.v1.UpdateLeaveRequestRequest.duration:type_name -> hr.leave.v1.LeaveDuration
+	7,   // 31: hr.leave.v1.UpdateLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
+	10,  // 32: hr.leave.v1.UpdateLeaveRequestResponse.warnings:type_name -> hr.leave.v1.LeaveConflict
+	4,   // 33: hr.leave.v1.ListLeaveRequestsRequest.status:type_name -> hr.leave.v1.LeaveStatus
+	2,   // 34: hr.leave.v1.ListLeaveRequestsRequest.leave_type:type_name -> hr.leave.v1.LeaveType
+	7,   // 35: hr.leave.v1.ListLeaveRequestsResponse.leave_requests:type_name -> hr.leave.v1.LeaveRequest
+	7,   // 36: hr.leave.v1.ApproveLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
+	10,  // 37: hr.leave.v1.ApproveLeaveRequestResponse.warnings:type_name -> hr.leave.v1.LeaveConflict
+	7,   // 38: hr.leave.v1.RejectLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
+	7,   // 39: hr.leave.v1.CancelLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
+	70,  // 40: hr.leave.v1.WithdrawLeaveRequestRequest.last_day:type_name -> google.protobuf.Timestamp
+	7,   // 41: hr.leave.v1.WithdrawLeaveRequestResponse.leave_request:type_name -> hr.leave.v1.LeaveRequest
+	70,  // 42: hr.leave.v1.CalendarHoliday.date:type_name -> google.protobuf.Timestamp
+	70,  // 43: hr.leave.v1.GetTeamCalendarRequest.start_date:type_name -> google.protobuf.Timestamp
+	70,  // 44: hr.leave.v1.GetTeamCalendarRequest.end_date:type_name -> google.protobuf.Timestamp
+	7,   // 45: hr.leave.v1.GetTeamCalendarResponse.absences:type_name -> hr.leave.v1.LeaveRequest
+	29,  // 46: hr.leave.v1.GetTeamCalendarResponse.holidays:type_name -> hr.leave.v1.CalendarHoliday
+	70,  // 47: hr.leave.v1.ExportLeaveCalendarRequest.start_date:type_name -> google.protobuf.Timestamp
+	70,  // 48: hr.leave.v1.ExportLeaveCalendarRequest.end_date:type_name -> google.protobuf.Timestamp
+	11,  // 49: hr.leave.v1.GetEmployeeLeaveBalanceResponse.leave_balances:type_name -> hr.leave.v1.LeaveBalance
+	2,   // 50: hr.leave.v1.LeavePolicy.leave_type:type_name -> hr.leave.v1.LeaveType
+	5,   // 51: hr.leave.v1.LeavePolicy.accrual_method:type_name -> hr.leave.v1.AccrualMethod
+	36,  // 52: hr.leave.v1.ListLeavePoliciesResponse.policies:type_name -> hr.leave.v1.LeavePolicy
+	36,  // 53: hr.leave.v1.SetLeavePolicyRequest.policy:type_name -> hr.leave.v1.LeavePolicy
+	36,  // 54: hr.leave.v1.SetLeavePolicyResponse.policy:type_name -> hr.leave.v1.LeavePolicy
+	2,   // 55: hr.leave.v1.LeaveAccrual.leave_type:type_name -> hr.leave.v1.LeaveType
+	41,  // 56: hr.leave.v1.RunLeaveAccrualResponse.accruals:type_name -> hr.leave.v1.LeaveAccrual
+	70,  // 57: hr.leave.v1.RunLeaveAccrualResponse.as_of:type_name -> google.protobuf.Timestamp
+	2,   // 58: hr.leave.v1.LeaveCarryForward.leave_type:type_name -> hr.leave.v1.LeaveType
+	70,  // 59: hr.leave.v1.LeaveCarryForward.expires_on:type_name -> google.protobuf.Timestamp
+	44,  // 60: hr.leave.v1.CloseLeaveYearResponse.carry_forwards:type_name -> hr.leave.v1.LeaveCarryForward
+	70,  // 61: hr.leave.v1.PayrollLineItem.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 62: hr.leave.v1.EncashLeaveResponse.leave_balance:type_name -> hr.leave.v1.LeaveBalance
+	47,  // 63: hr.leave.v1.EncashLeaveResponse.line_item:type_name -> hr.leave.v1.PayrollLineItem
+	0,   // 64: hr.leave.v1.ApprovalRule.approver_type:type_name -> hr.leave.v1.ApproverType
+	2,   // 65: hr.leave.v1.ListApprovalRulesRequest.leave_type:type_name -> hr.leave.v1.LeaveType
+	50,  // 66: hr.leave.v1.ListApprovalRulesResponse.rules:type_name -> hr.leave.v1.ApprovalRule
+	2,   // 67: hr.leave.v1.SetApprovalRulesRequest.leave_type:type_name -> hr.leave.v1.LeaveType
+	50,  // 68: hr.leave.v1.SetApprovalRulesRequest.rules:type_name -> hr.leave.v1.ApprovalRule
+	50,  // 69: hr.leave.v1.SetApprovalRulesResponse.rules:type_name -> hr.leave.v1.ApprovalRule
+	70,  // 70: hr.leave.v1.ApprovalDelegation.start_date:type_name -> google.protobuf.Timestamp
+	70,  // 71: hr.leave.v1.ApprovalDelegation.end_date:type_name -> google.protobuf.Timestamp
+	70,  // 72: hr.leave.v1.ApprovalDelegation.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 73: hr.leave.v1.CreateApprovalDelegationRequest.start_date:type_name -> google.protobuf.Timestamp
+	70,  // 74: hr.leave.v1.CreateApprovalDelegationRequest.end_date:type_name -> google.protobuf.Timestamp
+	55,  // 75: hr.leave.v1.CreateApprovalDelegationResponse.delegation:type_name -> hr.leave.v1.ApprovalDelegation
+	55,  // 76: hr.leave.v1.ListApprovalDelegationsResponse.delegations:type_name -> hr.leave.v1.ApprovalDelegation
+	70,  // 77: hr.leave.v1.CompOffCredit.work_date:type_name -> google.protobuf.Timestamp
+	6,   // 78: hr.leave.v1.CompOffCredit.status:type_name -> hr.leave.v1.CompOffStatus
+	70,  // 79: hr.leave.v1.CompOffCredit.decided_at:type_name -> google.protobuf.Timestamp
+	70,  // 80: hr.leave.v1.CompOffCredit.expires_on:type_name -> google.protobuf.Timestamp
+	70,  // 81: hr.leave.v1.CompOffCredit.expired_at:type_name -> google.protobuf.Timestamp
+	70,  // 82: hr.leave.v1.CompOffCredit.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 83: hr.leave.v1.RequestCompOffCreditRequest.work_date:type_name -> google.protobuf.Timestamp
+	61,  // 84: hr.leave.v1.RequestCompOffCreditResponse.credit:type_name -> hr.leave.v1.CompOffCredit
+	6,   // 85: hr.leave.v1.ListCompOffCreditsRequest.status:type_name -> hr.leave.v1.CompOffStatus
+	61,  // 86: hr.leave.v1.ListCompOffCreditsResponse.credits:type_name -> hr.leave.v1.CompOffCredit
+	61,  // 87: hr.leave.v1.ApproveCompOffCreditResponse.credit:type_name -> hr.leave.v1.CompOffCredit
+	11,  // 88: hr.leave.v1.ApproveCompOffCreditResponse.leave_balance:type_name -> hr.leave.v1.LeaveBalance
+	61,  // 89: hr.leave.v1.RejectCompOffCreditResponse.credit:type_name -> hr.leave.v1.CompOffCredit
+	14,  // 90: hr.leave.v1.LeaveService.GetLeaveRequest:input_type -> hr.leave.v1.GetLeaveRequestRequest
+	18,  // 91: hr.leave.v1.LeaveService.DeleteLeaveRequest:input_type -> hr.leave.v1.DeleteLeaveRequestRequest
+	19,  // 92: hr.leave.v1.LeaveService.ListLeaveRequests:input_type -> hr.leave.v1.ListLeaveRequestsRequest
+	12,  // 93: hr.leave.v1.LeaveService.CreateLeaveRequest:input_type -> hr.leave.v1.CreateLeaveRequestRequest
+	16,  // 94: hr.leave.v1.LeaveService.UpdateLeaveRequest:input_type -> hr.leave.v1.UpdateLeaveRequestRequest
+	23,  // 95: hr.leave.v1.LeaveService.RejectLeaveRequest:input_type -> hr.leave.v1.RejectLeaveRequestRequest
+	21,  // 96: hr.leave.v1.LeaveService.ApproveLeaveRequest:input_type -> hr.leave.v1.ApproveLeaveRequestRequest
+	34,  // 97: hr.leave.v1.LeaveService.GetEmployeeLeaveBalance:input_type -> hr.leave.v1.GetEmployeeLeaveBalanceRequest
+	37,  // 98: hr.leave.v1.LeaveService.ListLeavePolicies:input_type -> hr.leave.v1.ListLeavePoliciesRequest
+	39,  // 99: hr.leave.v1.LeaveService.SetLeavePolicy:input_type -> hr.leave.v1.SetLeavePolicyRequest
+	42,  // 100: hr.leave.v1.LeaveService.RunLeaveAccrual:input_type -> hr.leave.v1.RunLeaveAccrualRequest
+	45,  // 101: hr.leave.v1.LeaveService.CloseLeaveYear:input_type -> hr.leave.v1.CloseLeaveYearRequest
+	48,  // 102: hr.leave.v1.LeaveService.EncashLeave:input_type -> hr.leave.v1.EncashLeaveRequest
+	51,  // 103: hr.leave.v1.LeaveService.ListApprovalRules:input_type -> hr.leave.v1.ListApprovalRulesRequest
+	53,  // 104: hr.leave.v1.LeaveService.SetApprovalRules:input_type -> hr.leave.v1.SetApprovalRulesRequest
+	56,  // 105: hr.leave.v1.LeaveService.CreateApprovalDelegation:input_type -> hr.leave.v1.CreateApprovalDelegationRequest
+	58,  // 106: hr.leave.v1.LeaveService.ListApprovalDelegations:input_type -> hr.leave.v1.ListApprovalDelegationsRequest
+	60,  // 107: hr.leave.v1.LeaveService.DeleteApprovalDelegation:input_type -> hr.leave.v1.DeleteApprovalDelegationRequest
+	25,  // 108: hr.leave.v1.LeaveService.CancelLeaveRequest:input_type -> hr.leave.v1.CancelLeaveRequestRequest
+	27,  // 109: hr.leave.v1.LeaveService.WithdrawLeaveRequest:input_type -> hr.leave.v1.WithdrawLeaveRequestRequest
+	30,  // 110: hr.leave.v1.LeaveService.GetTeamCalendar:input_type -> hr.leave.v1.GetTeamCalendarRequest
+	32,  // 111: hr.leave.v1.LeaveService.ExportLeaveCalendar:input_type -> hr.leave.v1.ExportLeaveCalendarRequest
+	62,  // 112: hr.leave.v1.LeaveService.RequestCompOffCredit:input_type -> hr.leave.v1.RequestCompOffCreditRequest
+	64,  // 113: hr.leave.v1.LeaveService.ListCompOffCredits:input_type -> hr.leave.v1.ListCompOffCreditsRequest
+	66,  // 114: hr.leave.v1.LeaveService.ApproveCompOffCredit:input_type -> hr.leave.v1.ApproveCompOffCreditRequest
+	68,  // 115: hr.leave.v1.LeaveService.RejectCompOffCredit:input_type -> hr.leave.v1.RejectCompOffCreditRequest
+	15,  // 116: hr.leave.v1.LeaveService.GetLeaveRequest:output_type -> hr.leave.v1.GetLeaveRequestResponse
+	71,  // 117: hr.leave.v1.LeaveService.DeleteLeaveRequest:output_type -> google.protobuf.Empty
+	20,  // 118: hr.leave.v1.LeaveService.ListLeaveRequests:output_type -> hr.leave.v1.ListLeaveRequestsResponse
+	13,  // 119: hr.leave.v1.LeaveService.CreateLeaveRequest:output_type -> hr.leave.v1.CreateLeaveRequestResponse
+	17,  // 120: hr.leave.v1.LeaveService.UpdateLeaveRequest:output_type -> hr.leave.v1.UpdateLeaveRequestResponse
+	24,  // 121: hr.leave.v1.LeaveService.RejectLeaveRequest:output_type -> hr.leave.v1.RejectLeaveRequestResponse
+	22,  // 122: hr.leave.v1.LeaveService.ApproveLeaveRequest:output_type -> hr.leave.v1.ApproveLeaveRequestResponse
+	35,  // 123: hr.leave.v1.LeaveService.GetEmployeeLeaveBalance:output_type -> hr.leave.v1.GetEmployeeLeaveBalanceResponse
+	38,  // 124: hr.leave.v1.LeaveService.ListLeavePolicies:output_type -> hr.leave.v1.ListLeavePoliciesResponse
+	40,  // 125: hr.leave.v1.LeaveService.SetLeavePolicy:output_type -> hr.leave.v1.SetLeavePolicyResponse
+	43,  // 126: hr.leave.v1.LeaveService.RunLeaveAccrual:output_type -> hr.leave.v1.RunLeaveAccrualResponse
+	46,  // 127: hr.leave.v1.LeaveService.CloseLeaveYear:output_type -> hr.leave.v1.CloseLeaveYearResponse
+	49,  // 128: hr.leave.v1.LeaveService.EncashLeave:output_type -> hr.leave.v1.EncashLeaveResponse
+	52,  // 129: hr.leave.v1.LeaveService.ListApprovalRules:output_type -> hr.leave.v1.ListApprovalRulesResponse
+	54,  // 130: hr.leave.v1.LeaveService.SetApprovalRules:output_type -> hr.leave.v1.SetApprovalRulesResponse
+	57,  // 131: hr.leave.v1.LeaveService.CreateApprovalDelegation:output_type -> hr.leave.v1.CreateApprovalDelegationResponse
+	59,  // 132: hr.leave.v1.LeaveService.ListApprovalDelegations:output_type -> hr.leave.v1.ListApprovalDelegationsResponse
+	71,  // 133: hr.leave.v1.LeaveService.DeleteApprovalDelegation:output_type -> google.protobuf.Empty
+	26,  // 134: hr.leave.v1.LeaveService.CancelLeaveRequest:output_type -> hr.leave.v1.CancelLeaveRequestResponse
+	28,  // 135: hr.leave.v1.LeaveService.WithdrawLeaveRequest:output_type -> hr.leave.v1.WithdrawLeaveRequestResponse
+	31,  // 136: hr.leave.v1.LeaveService.GetTeamCalendar:output_type -> hr.leave.v1.GetTeamCalendarResponse
+	33,  // 137: hr.leave.v1.LeaveService.ExportLeaveCalendar:output_type -> hr.leave.v1.ExportLeaveCalendarResponse
+	63,  // 138: hr.leave.v1.LeaveService.RequestCompOffCredit:output_type -> hr.leave.v1.RequestCompOffCreditResponse
+	65,  // 139: hr.leave.v1.LeaveService.ListCompOffCredits:output_type -> hr.leave.v1.ListCompOffCreditsResponse
+	67,  // 140: hr.leave.v1.LeaveService.ApproveCompOffCredit:output_type -> hr.leave.v1.ApproveCompOffCreditResponse
+	69,  // 141: hr.leave.v1.LeaveService.RejectCompOffCredit:output_type -> hr.leave.v1.RejectCompOffCreditResponse
+	116, // [116:142] is the sub-list for method output_type
+	90,  // [90:116] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_leave_proto_init() }
@@ -1549,9 +5264,9 @@ func file_leave_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_leave_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   17,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_leave_proto_rawDesc), len(file_leave_proto_rawDesc)),
+			NumEnums:      7,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1561,7 +5276,6 @@ func file_leave_proto_init() {
 		MessageInfos:      file_leave_proto_msgTypes,
 	}.Build()
 	File_leave_proto = out.File
-	file_leave_proto_rawDesc = nil
 	file_leave_proto_goTypes = nil
 	file_leave_proto_depIdxs = nil
 }
@@ -20,14 +20,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LeaveService_GetLeaveRequest_FullMethodName         = "/hr.leave.v1.LeaveService/GetLeaveRequest"
-	LeaveService_DeleteLeaveRequest_FullMethodName      = "/hr.leave.v1.LeaveService/DeleteLeaveRequest"
-	LeaveService_ListLeaveRequests_FullMethodName       = "/hr.leave.v1.LeaveService/ListLeaveRequests"
-	LeaveService_CreateLeaveRequest_FullMethodName      = "/hr.leave.v1.LeaveService/CreateLeaveRequest"
-	LeaveService_UpdateLeaveRequest_FullMethodName      = "/hr.leave.v1.LeaveService/UpdateLeaveRequest"
-	LeaveService_RejectLeaveRequest_FullMethodName      = "/hr.leave.v1.LeaveService/RejectLeaveRequest"
-	LeaveService_ApproveLeaveRequest_FullMethodName     = "/hr.leave.v1.LeaveService/ApproveLeaveRequest"
-	LeaveService_GetEmployeeLeaveBalance_FullMethodName = "/hr.leave.v1.LeaveService/GetEmployeeLeaveBalance"
+	LeaveService_GetLeaveRequest_FullMethodName          = "/hr.leave.v1.LeaveService/GetLeaveRequest"
+	LeaveService_DeleteLeaveRequest_FullMethodName       = "/hr.leave.v1.LeaveService/DeleteLeaveRequest"
+	LeaveService_ListLeaveRequests_FullMethodName        = "/hr.leave.v1.LeaveService/ListLeaveRequests"
+	LeaveService_CreateLeaveRequest_FullMethodName       = "/hr.leave.v1.LeaveService/CreateLeaveRequest"
+	LeaveService_UpdateLeaveRequest_FullMethodName       = "/hr.leave.v1.LeaveService/UpdateLeaveRequest"
+	LeaveService_RejectLeaveRequest_FullMethodName       = "/hr.leave.v1.LeaveService/RejectLeaveRequest"
+	LeaveService_ApproveLeaveRequest_FullMethodName      = "/hr.leave.v1.LeaveService/ApproveLeaveRequest"
+	LeaveService_GetEmployeeLeaveBalance_FullMethodName  = "/hr.leave.v1.LeaveService/GetEmployeeLeaveBalance"
+	LeaveService_ListLeavePolicies_FullMethodName        = "/hr.leave.v1.LeaveService/ListLeavePolicies"
+	LeaveService_SetLeavePolicy_FullMethodName           = "/hr.leave.v1.LeaveService/SetLeavePolicy"
+	LeaveService_RunLeaveAccrual_FullMethodName          = "/hr.leave.v1.LeaveService/RunLeaveAccrual"
+	LeaveService_CloseLeaveYear_FullMethodName           = "/hr.leave.v1.LeaveService/CloseLeaveYear"
+	LeaveService_EncashLeave_FullMethodName              = "/hr.leave.v1.LeaveService/EncashLeave"
+	LeaveService_ListApprovalRules_FullMethodName        = "/hr.leave.v1.LeaveService/ListApprovalRules"
+	LeaveService_SetApprovalRules_FullMethodName         = "/hr.leave.v1.LeaveService/SetApprovalRules"
+	LeaveService_CreateApprovalDelegation_FullMethodName = "/hr.leave.v1.LeaveService/CreateApprovalDelegation"
+	LeaveService_ListApprovalDelegations_FullMethodName  = "/hr.leave.v1.LeaveService/ListApprovalDelegations"
+	LeaveService_DeleteApprovalDelegation_FullMethodName = "/hr.leave.v1.LeaveService/DeleteApprovalDelegation"
+	LeaveService_CancelLeaveRequest_FullMethodName       = "/hr.leave.v1.LeaveService/CancelLeaveRequest"
+	LeaveService_WithdrawLeaveRequest_FullMethodName     = "/hr.leave.v1.LeaveService/WithdrawLeaveRequest"
+	LeaveService_GetTeamCalendar_FullMethodName          = "/hr.leave.v1.LeaveService/GetTeamCalendar"
+	LeaveService_ExportLeaveCalendar_FullMethodName      = "/hr.leave.v1.LeaveService/ExportLeaveCalendar"
+	LeaveService_RequestCompOffCredit_FullMethodName     = "/hr.leave.v1.LeaveService/RequestCompOffCredit"
+	LeaveService_ListCompOffCredits_FullMethodName       = "/hr.leave.v1.LeaveService/ListCompOffCredits"
+	LeaveService_ApproveCompOffCredit_FullMethodName     = "/hr.leave.v1.LeaveService/ApproveCompOffCredit"
+	LeaveService_RejectCompOffCredit_FullMethodName      = "/hr.leave.v1.LeaveService/RejectCompOffCredit"
 )
 
 // LeaveServiceClient is the client API for LeaveService service.
@@ -42,6 +60,24 @@ type LeaveServiceClient interface {
 	RejectLeaveRequest(ctx context.Context, in *RejectLeaveRequestRequest, opts ...grpc.CallOption) (*RejectLeaveRequestResponse, error)
 	ApproveLeaveRequest(ctx context.Context, in *ApproveLeaveRequestRequest, opts ...grpc.CallOption) (*ApproveLeaveRequestResponse, error)
 	GetEmployeeLeaveBalance(ctx context.Context, in *GetEmployeeLeaveBalanceRequest, opts ...grpc.CallOption) (*GetEmployeeLeaveBalanceResponse, error)
+	ListLeavePolicies(ctx context.Context, in *ListLeavePoliciesRequest, opts ...grpc.CallOption) (*ListLeavePoliciesResponse, error)
+	SetLeavePolicy(ctx context.Context, in *SetLeavePolicyRequest, opts ...grpc.CallOption) (*SetLeavePolicyResponse, error)
+	RunLeaveAccrual(ctx context.Context, in *RunLeaveAccrualRequest, opts ...grpc.CallOption) (*RunLeaveAccrualResponse, error)
+	CloseLeaveYear(ctx context.Context, in *CloseLeaveYearRequest, opts ...grpc.CallOption) (*CloseLeaveYearResponse, error)
+	EncashLeave(ctx context.Context, in *EncashLeaveRequest, opts ...grpc.CallOption) (*EncashLeaveResponse, error)
+	ListApprovalRules(ctx context.Context, in *ListApprovalRulesRequest, opts ...grpc.CallOption) (*ListApprovalRulesResponse, error)
+	SetApprovalRules(ctx context.Context, in *SetApprovalRulesRequest, opts ...grpc.CallOption) (*SetApprovalRulesResponse, error)
+	CreateApprovalDelegation(ctx context.Context, in *CreateApprovalDelegationRequest, opts ...grpc.CallOption) (*CreateApprovalDelegationResponse, error)
+	ListApprovalDelegations(ctx context.Context, in *ListApprovalDelegationsRequest, opts ...grpc.CallOption) (*ListApprovalDelegationsResponse, error)
+	DeleteApprovalDelegation(ctx context.Context, in *DeleteApprovalDelegationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelLeaveRequest(ctx context.Context, in *CancelLeaveRequestRequest, opts ...grpc.CallOption) (*CancelLeaveRequestResponse, error)
+	WithdrawLeaveRequest(ctx context.Context, in *WithdrawLeaveRequestRequest, opts ...grpc.CallOption) (*WithdrawLeaveRequestResponse, error)
+	GetTeamCalendar(ctx context.Context, in *GetTeamCalendarRequest, opts ...grpc.CallOption) (*GetTeamCalendarResponse, error)
+	ExportLeaveCalendar(ctx context.Context, in *ExportLeaveCalendarRequest, opts ...grpc.CallOption) (*ExportLeaveCalendarResponse, error)
+	RequestCompOffCredit(ctx context.Context, in *RequestCompOffCreditRequest, opts ...grpc.CallOption) (*RequestCompOffCreditResponse, error)
+	ListCompOffCredits(ctx context.Context, in *ListCompOffCreditsRequest, opts ...grpc.CallOption) (*ListCompOffCreditsResponse, error)
+	ApproveCompOffCredit(ctx context.Context, in *ApproveCompOffCreditRequest, opts ...grpc.CallOption) (*ApproveCompOffCreditResponse, error)
+	RejectCompOffCredit(ctx context.Context, in *RejectCompOffCreditRequest, opts ...grpc.CallOption) (*RejectCompOffCreditResponse, error)
 }
 
 type leaveServiceClient struct {
@@ -132,6 +168,186 @@ func (c *leaveServiceClient) GetEmployeeLeaveBalance(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *leaveServiceClient) ListLeavePolicies(ctx context.Context, in *ListLeavePoliciesRequest, opts ...grpc.CallOption) (*ListLeavePoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeavePoliciesResponse)
+	err := c.cc.Invoke(ctx, LeaveService_ListLeavePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) SetLeavePolicy(ctx context.Context, in *SetLeavePolicyRequest, opts ...grpc.CallOption) (*SetLeavePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLeavePolicyResponse)
+	err := c.cc.Invoke(ctx, LeaveService_SetLeavePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) RunLeaveAccrual(ctx context.Context, in *RunLeaveAccrualRequest, opts ...grpc.CallOption) (*RunLeaveAccrualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunLeaveAccrualResponse)
+	err := c.cc.Invoke(ctx, LeaveService_RunLeaveAccrual_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) CloseLeaveYear(ctx context.Context, in *CloseLeaveYearRequest, opts ...grpc.CallOption) (*CloseLeaveYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseLeaveYearResponse)
+	err := c.cc.Invoke(ctx, LeaveService_CloseLeaveYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) EncashLeave(ctx context.Context, in *EncashLeaveRequest, opts ...grpc.CallOption) (*EncashLeaveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncashLeaveResponse)
+	err := c.cc.Invoke(ctx, LeaveService_EncashLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) ListApprovalRules(ctx context.Context, in *ListApprovalRulesRequest, opts ...grpc.CallOption) (*ListApprovalRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalRulesResponse)
+	err := c.cc.Invoke(ctx, LeaveService_ListApprovalRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) SetApprovalRules(ctx context.Context, in *SetApprovalRulesRequest, opts ...grpc.CallOption) (*SetApprovalRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetApprovalRulesResponse)
+	err := c.cc.Invoke(ctx, LeaveService_SetApprovalRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) CreateApprovalDelegation(ctx context.Context, in *CreateApprovalDelegationRequest, opts ...grpc.CallOption) (*CreateApprovalDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApprovalDelegationResponse)
+	err := c.cc.Invoke(ctx, LeaveService_CreateApprovalDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) ListApprovalDelegations(ctx context.Context, in *ListApprovalDelegationsRequest, opts ...grpc.CallOption) (*ListApprovalDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalDelegationsResponse)
+	err := c.cc.Invoke(ctx, LeaveService_ListApprovalDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) DeleteApprovalDelegation(ctx context.Context, in *DeleteApprovalDelegationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LeaveService_DeleteApprovalDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) CancelLeaveRequest(ctx context.Context, in *CancelLeaveRequestRequest, opts ...grpc.CallOption) (*CancelLeaveRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelLeaveRequestResponse)
+	err := c.cc.Invoke(ctx, LeaveService_CancelLeaveRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) WithdrawLeaveRequest(ctx context.Context, in *WithdrawLeaveRequestRequest, opts ...grpc.CallOption) (*WithdrawLeaveRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawLeaveRequestResponse)
+	err := c.cc.Invoke(ctx, LeaveService_WithdrawLeaveRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) GetTeamCalendar(ctx context.Context, in *GetTeamCalendarRequest, opts ...grpc.CallOption) (*GetTeamCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamCalendarResponse)
+	err := c.cc.Invoke(ctx, LeaveService_GetTeamCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) ExportLeaveCalendar(ctx context.Context, in *ExportLeaveCalendarRequest, opts ...grpc.CallOption) (*ExportLeaveCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportLeaveCalendarResponse)
+	err := c.cc.Invoke(ctx, LeaveService_ExportLeaveCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) RequestCompOffCredit(ctx context.Context, in *RequestCompOffCreditRequest, opts ...grpc.CallOption) (*RequestCompOffCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestCompOffCreditResponse)
+	err := c.cc.Invoke(ctx, LeaveService_RequestCompOffCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) ListCompOffCredits(ctx context.Context, in *ListCompOffCreditsRequest, opts ...grpc.CallOption) (*ListCompOffCreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompOffCreditsResponse)
+	err := c.cc.Invoke(ctx, LeaveService_ListCompOffCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) ApproveCompOffCredit(ctx context.Context, in *ApproveCompOffCreditRequest, opts ...grpc.CallOption) (*ApproveCompOffCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveCompOffCreditResponse)
+	err := c.cc.Invoke(ctx, LeaveService_ApproveCompOffCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveServiceClient) RejectCompOffCredit(ctx context.Context, in *RejectCompOffCreditRequest, opts ...grpc.CallOption) (*RejectCompOffCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectCompOffCreditResponse)
+	err := c.cc.Invoke(ctx, LeaveService_RejectCompOffCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaveServiceServer is the server API for LeaveService service.
 // All implementations must embed UnimplementedLeaveServiceServer
 // for forward compatibility.
@@ -144,6 +360,24 @@ type LeaveServiceServer interface {
 	RejectLeaveRequest(context.Context, *RejectLeaveRequestRequest) (*RejectLeaveRequestResponse, error)
 	ApproveLeaveRequest(context.Context, *ApproveLeaveRequestRequest) (*ApproveLeaveRequestResponse, error)
 	GetEmployeeLeaveBalance(context.Context, *GetEmployeeLeaveBalanceRequest) (*GetEmployeeLeaveBalanceResponse, error)
+	ListLeavePolicies(context.Context, *ListLeavePoliciesRequest) (*ListLeavePoliciesResponse, error)
+	SetLeavePolicy(context.Context, *SetLeavePolicyRequest) (*SetLeavePolicyResponse, error)
+	RunLeaveAccrual(context.Context, *RunLeaveAccrualRequest) (*RunLeaveAccrualResponse, error)
+	CloseLeaveYear(context.Context, *CloseLeaveYearRequest) (*CloseLeaveYearResponse, error)
+	EncashLeave(context.Context, *EncashLeaveRequest) (*EncashLeaveResponse, error)
+	ListApprovalRules(context.Context, *ListApprovalRulesRequest) (*ListApprovalRulesResponse, error)
+	SetApprovalRules(context.Context, *SetApprovalRulesRequest) (*SetApprovalRulesResponse, error)
+	CreateApprovalDelegation(context.Context, *CreateApprovalDelegationRequest) (*CreateApprovalDelegationResponse, error)
+	ListApprovalDelegations(context.Context, *ListApprovalDelegationsRequest) (*ListApprovalDelegationsResponse, error)
+	DeleteApprovalDelegation(context.Context, *DeleteApprovalDelegationRequest) (*emptypb.Empty, error)
+	CancelLeaveRequest(context.Context, *CancelLeaveRequestRequest) (*CancelLeaveRequestResponse, error)
+	WithdrawLeaveRequest(context.Context, *WithdrawLeaveRequestRequest) (*WithdrawLeaveRequestResponse, error)
+	GetTeamCalendar(context.Context, *GetTeamCalendarRequest) (*GetTeamCalendarResponse, error)
+	ExportLeaveCalendar(context.Context, *ExportLeaveCalendarRequest) (*ExportLeaveCalendarResponse, error)
+	RequestCompOffCredit(context.Context, *RequestCompOffCreditRequest) (*RequestCompOffCreditResponse, error)
+	ListCompOffCredits(context.Context, *ListCompOffCreditsRequest) (*ListCompOffCreditsResponse, error)
+	ApproveCompOffCredit(context.Context, *ApproveCompOffCreditRequest) (*ApproveCompOffCreditResponse, error)
+	RejectCompOffCredit(context.Context, *RejectCompOffCreditRequest) (*RejectCompOffCreditResponse, error)
 	mustEmbedUnimplementedLeaveServiceServer()
 }
 
@@ -178,6 +412,60 @@ func (UnimplementedLeaveServiceServer) ApproveLeaveRequest(context.Context, *App
 func (UnimplementedLeaveServiceServer) GetEmployeeLeaveBalance(context.Context, *GetEmployeeLeaveBalanceRequest) (*GetEmployeeLeaveBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmployeeLeaveBalance not implemented")
 }
+func (UnimplementedLeaveServiceServer) ListLeavePolicies(context.Context, *ListLeavePoliciesRequest) (*ListLeavePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeavePolicies not implemented")
+}
+func (UnimplementedLeaveServiceServer) SetLeavePolicy(context.Context, *SetLeavePolicyRequest) (*SetLeavePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeavePolicy not implemented")
+}
+func (UnimplementedLeaveServiceServer) RunLeaveAccrual(context.Context, *RunLeaveAccrualRequest) (*RunLeaveAccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLeaveAccrual not implemented")
+}
+func (UnimplementedLeaveServiceServer) CloseLeaveYear(context.Context, *CloseLeaveYearRequest) (*CloseLeaveYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLeaveYear not implemented")
+}
+func (UnimplementedLeaveServiceServer) EncashLeave(context.Context, *EncashLeaveRequest) (*EncashLeaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncashLeave not implemented")
+}
+func (UnimplementedLeaveServiceServer) ListApprovalRules(context.Context, *ListApprovalRulesRequest) (*ListApprovalRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovalRules not implemented")
+}
+func (UnimplementedLeaveServiceServer) SetApprovalRules(context.Context, *SetApprovalRulesRequest) (*SetApprovalRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApprovalRules not implemented")
+}
+func (UnimplementedLeaveServiceServer) CreateApprovalDelegation(context.Context, *CreateApprovalDelegationRequest) (*CreateApprovalDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApprovalDelegation not implemented")
+}
+func (UnimplementedLeaveServiceServer) ListApprovalDelegations(context.Context, *ListApprovalDelegationsRequest) (*ListApprovalDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovalDelegations not implemented")
+}
+func (UnimplementedLeaveServiceServer) DeleteApprovalDelegation(context.Context, *DeleteApprovalDelegationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApprovalDelegation not implemented")
+}
+func (UnimplementedLeaveServiceServer) CancelLeaveRequest(context.Context, *CancelLeaveRequestRequest) (*CancelLeaveRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLeaveRequest not implemented")
+}
+func (UnimplementedLeaveServiceServer) WithdrawLeaveRequest(context.Context, *WithdrawLeaveRequestRequest) (*WithdrawLeaveRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawLeaveRequest not implemented")
+}
+func (UnimplementedLeaveServiceServer) GetTeamCalendar(context.Context, *GetTeamCalendarRequest) (*GetTeamCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamCalendar not implemented")
+}
+func (UnimplementedLeaveServiceServer) ExportLeaveCalendar(context.Context, *ExportLeaveCalendarRequest) (*ExportLeaveCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportLeaveCalendar not implemented")
+}
+func (UnimplementedLeaveServiceServer) RequestCompOffCredit(context.Context, *RequestCompOffCreditRequest) (*RequestCompOffCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCompOffCredit not implemented")
+}
+func (UnimplementedLeaveServiceServer) ListCompOffCredits(context.Context, *ListCompOffCreditsRequest) (*ListCompOffCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompOffCredits not implemented")
+}
+func (UnimplementedLeaveServiceServer) ApproveCompOffCredit(context.Context, *ApproveCompOffCreditRequest) (*ApproveCompOffCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCompOffCredit not implemented")
+}
+func (UnimplementedLeaveServiceServer) RejectCompOffCredit(context.Context, *RejectCompOffCreditRequest) (*RejectCompOffCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCompOffCredit not implemented")
+}
 func (UnimplementedLeaveServiceServer) mustEmbedUnimplementedLeaveServiceServer() {}
 func (UnimplementedLeaveServiceServer) testEmbeddedByValue()                      {}
 
@@ -343,6 +631,330 @@ func _LeaveService_GetEmployeeLeaveBalance_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaveService_ListLeavePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeavePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).ListLeavePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_ListLeavePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).ListLeavePolicies(ctx, req.(*ListLeavePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_SetLeavePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLeavePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).SetLeavePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_SetLeavePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).SetLeavePolicy(ctx, req.(*SetLeavePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_RunLeaveAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunLeaveAccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).RunLeaveAccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_RunLeaveAccrual_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).RunLeaveAccrual(ctx, req.(*RunLeaveAccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_CloseLeaveYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseLeaveYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).CloseLeaveYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_CloseLeaveYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).CloseLeaveYear(ctx, req.(*CloseLeaveYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_EncashLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncashLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).EncashLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_EncashLeave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).EncashLeave(ctx, req.(*EncashLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_ListApprovalRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).ListApprovalRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_ListApprovalRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).ListApprovalRules(ctx, req.(*ListApprovalRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_SetApprovalRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApprovalRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).SetApprovalRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_SetApprovalRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).SetApprovalRules(ctx, req.(*SetApprovalRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_CreateApprovalDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApprovalDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).CreateApprovalDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_CreateApprovalDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).CreateApprovalDelegation(ctx, req.(*CreateApprovalDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_ListApprovalDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).ListApprovalDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_ListApprovalDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).ListApprovalDelegations(ctx, req.(*ListApprovalDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_DeleteApprovalDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApprovalDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).DeleteApprovalDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_DeleteApprovalDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).DeleteApprovalDelegation(ctx, req.(*DeleteApprovalDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_CancelLeaveRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).CancelLeaveRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_CancelLeaveRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).CancelLeaveRequest(ctx, req.(*CancelLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_WithdrawLeaveRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).WithdrawLeaveRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_WithdrawLeaveRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).WithdrawLeaveRequest(ctx, req.(*WithdrawLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_GetTeamCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).GetTeamCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_GetTeamCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).GetTeamCalendar(ctx, req.(*GetTeamCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_ExportLeaveCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLeaveCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).ExportLeaveCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_ExportLeaveCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).ExportLeaveCalendar(ctx, req.(*ExportLeaveCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_RequestCompOffCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCompOffCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).RequestCompOffCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_RequestCompOffCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).RequestCompOffCredit(ctx, req.(*RequestCompOffCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_ListCompOffCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompOffCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).ListCompOffCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_ListCompOffCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).ListCompOffCredits(ctx, req.(*ListCompOffCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_ApproveCompOffCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCompOffCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).ApproveCompOffCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_ApproveCompOffCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).ApproveCompOffCredit(ctx, req.(*ApproveCompOffCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveService_RejectCompOffCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectCompOffCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveServiceServer).RejectCompOffCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveService_RejectCompOffCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveServiceServer).RejectCompOffCredit(ctx, req.(*RejectCompOffCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeaveService_ServiceDesc is the grpc.ServiceDesc for LeaveService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -382,6 +994,78 @@ var LeaveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEmployeeLeaveBalance",
 			Handler:    _LeaveService_GetEmployeeLeaveBalance_Handler,
 		},
+		{
+			MethodName: "ListLeavePolicies",
+			Handler:    _LeaveService_ListLeavePolicies_Handler,
+		},
+		{
+			MethodName: "SetLeavePolicy",
+			Handler:    _LeaveService_SetLeavePolicy_Handler,
+		},
+		{
+			MethodName: "RunLeaveAccrual",
+			Handler:    _LeaveService_RunLeaveAccrual_Handler,
+		},
+		{
+			MethodName: "CloseLeaveYear",
+			Handler:    _LeaveService_CloseLeaveYear_Handler,
+		},
+		{
+			MethodName: "EncashLeave",
+			Handler:    _LeaveService_EncashLeave_Handler,
+		},
+		{
+			MethodName: "ListApprovalRules",
+			Handler:    _LeaveService_ListApprovalRules_Handler,
+		},
+		{
+			MethodName: "SetApprovalRules",
+			Handler:    _LeaveService_SetApprovalRules_Handler,
+		},
+		{
+			MethodName: "CreateApprovalDelegation",
+			Handler:    _LeaveService_CreateApprovalDelegation_Handler,
+		},
+		{
+			MethodName: "ListApprovalDelegations",
+			Handler:    _LeaveService_ListApprovalDelegations_Handler,
+		},
+		{
+			MethodName: "DeleteApprovalDelegation",
+			Handler:    _LeaveService_DeleteApprovalDelegation_Handler,
+		},
+		{
+			MethodName: "CancelLeaveRequest",
+			Handler:    _LeaveService_CancelLeaveRequest_Handler,
+		},
+		{
+			MethodName: "WithdrawLeaveRequest",
+			Handler:    _LeaveService_WithdrawLeaveRequest_Handler,
+		},
+		{
+			MethodName: "GetTeamCalendar",
+			Handler:    _LeaveService_GetTeamCalendar_Handler,
+		},
+		{
+			MethodName: "ExportLeaveCalendar",
+			Handler:    _LeaveService_ExportLeaveCalendar_Handler,
+		},
+		{
+			MethodName: "RequestCompOffCredit",
+			Handler:    _LeaveService_RequestCompOffCredit_Handler,
+		},
+		{
+			MethodName: "ListCompOffCredits",
+			Handler:    _LeaveService_ListCompOffCredits_Handler,
+		},
+		{
+			MethodName: "ApproveCompOffCredit",
+			Handler:    _LeaveService_ApproveCompOffCredit_Handler,
+		},
+		{
+			MethodName: "RejectCompOffCredit",
+			Handler:    _LeaveService_RejectCompOffCredit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "leave.proto",
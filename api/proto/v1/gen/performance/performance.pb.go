@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.0
+// 	protoc-gen-go v1.36.10
 // 	protoc        v5.28.3
 // source: performance.proto
 
@@ -13,6 +13,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -353,7 +354,7 @@ type Goal struct {
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	TargetValue   float64                `protobuf:"fixed64,4,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
 	AchievedValue float64                `protobuf:"fixed64,5,opt,name=achieved_value,json=achievedValue,proto3" json:"achieved_value,omitempty"`
-	Uint          string                 `protobuf:"bytes,6,opt,name=uint,proto3" json:"uint,omitempty"`
+	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
 	Status        GoalStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=hr.performance.v1.GoalStatus" json:"status,omitempty"`
 	Weight        float64                `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"`
 	Comments      string                 `protobuf:"bytes,9,opt,name=comments,proto3" json:"comments,omitempty"`
@@ -426,9 +427,9 @@ func (x *Goal) GetAchievedValue() float64 {
 	return 0
 }
 
-func (x *Goal) GetUint() string {
+func (x *Goal) GetUnit() string {
 	if x != nil {
-		return x.Uint
+		return x.Unit
 	}
 	return ""
 }
@@ -1166,6 +1167,182 @@ func (x *SubmitPerformanceReviewResponse) GetPerformanceReview() *PerformanceRev
 	return nil
 }
 
+type CompletePerformanceReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletePerformanceReviewRequest) Reset() {
+	*x = CompletePerformanceReviewRequest{}
+	mi := &file_performance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePerformanceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePerformanceReviewRequest) ProtoMessage() {}
+
+func (x *CompletePerformanceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_performance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePerformanceReviewRequest.ProtoReflect.Descriptor instead.
+func (*CompletePerformanceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_performance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompletePerformanceReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompletePerformanceReviewResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PerformanceReview *PerformanceReview     `protobuf:"bytes,1,opt,name=performance_review,json=performanceReview,proto3" json:"performance_review,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CompletePerformanceReviewResponse) Reset() {
+	*x = CompletePerformanceReviewResponse{}
+	mi := &file_performance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePerformanceReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePerformanceReviewResponse) ProtoMessage() {}
+
+func (x *CompletePerformanceReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_performance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePerformanceReviewResponse.ProtoReflect.Descriptor instead.
+func (*CompletePerformanceReviewResponse) Descriptor() ([]byte, []int) {
+	return file_performance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompletePerformanceReviewResponse) GetPerformanceReview() *PerformanceReview {
+	if x != nil {
+		return x.PerformanceReview
+	}
+	return nil
+}
+
+type ArchivePerformanceReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePerformanceReviewRequest) Reset() {
+	*x = ArchivePerformanceReviewRequest{}
+	mi := &file_performance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePerformanceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePerformanceReviewRequest) ProtoMessage() {}
+
+func (x *ArchivePerformanceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_performance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePerformanceReviewRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePerformanceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_performance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ArchivePerformanceReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ArchivePerformanceReviewResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PerformanceReview *PerformanceReview     `protobuf:"bytes,1,opt,name=performance_review,json=performanceReview,proto3" json:"performance_review,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ArchivePerformanceReviewResponse) Reset() {
+	*x = ArchivePerformanceReviewResponse{}
+	mi := &file_performance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePerformanceReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePerformanceReviewResponse) ProtoMessage() {}
+
+func (x *ArchivePerformanceReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_performance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePerformanceReviewResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePerformanceReviewResponse) Descriptor() ([]byte, []int) {
+	return file_performance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ArchivePerformanceReviewResponse) GetPerformanceReview() *PerformanceReview {
+	if x != nil {
+		return x.PerformanceReview
+	}
+	return nil
+}
+
 type GetEmployeePerformanceHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
@@ -1177,7 +1354,7 @@ type GetEmployeePerformanceHistoryRequest struct {
 
 func (x *GetEmployeePerformanceHistoryRequest) Reset() {
 	*x = GetEmployeePerformanceHistoryRequest{}
-	mi := &file_performance_proto_msgTypes[14]
+	mi := &file_performance_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1189,7 +1366,7 @@ func (x *GetEmployeePerformanceHistoryRequest) String() string {
 func (*GetEmployeePerformanceHistoryRequest) ProtoMessage() {}
 
 func (x *GetEmployeePerformanceHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_performance_proto_msgTypes[14]
+	mi := &file_performance_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,7 +1379,7 @@ func (x *GetEmployeePerformanceHistoryRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetEmployeePerformanceHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetEmployeePerformanceHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_performance_proto_rawDescGZIP(), []int{14}
+	return file_performance_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetEmployeePerformanceHistoryRequest) GetEmployeeId() string {
@@ -1238,7 +1415,7 @@ type GetEmployeePerformanceHistoryResponse struct {
 
 func (x *GetEmployeePerformanceHistoryResponse) Reset() {
 	*x = GetEmployeePerformanceHistoryResponse{}
-	mi := &file_performance_proto_msgTypes[15]
+	mi := &file_performance_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1250,7 +1427,7 @@ func (x *GetEmployeePerformanceHistoryResponse) String() string {
 func (*GetEmployeePerformanceHistoryResponse) ProtoMessage() {}
 
 func (x *GetEmployeePerformanceHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_performance_proto_msgTypes[15]
+	mi := &file_performance_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,7 +1440,7 @@ func (x *GetEmployeePerformanceHistoryResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use GetEmployeePerformanceHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetEmployeePerformanceHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_performance_proto_rawDescGZIP(), []int{15}
+	return file_performance_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetEmployeePerformanceHistoryResponse) GetPerformanceReviews() []*PerformanceReview {
@@ -1296,325 +1473,160 @@ func (x *GetEmployeePerformanceHistoryResponse) GetPageSize() int32 {
 
 var File_performance_proto protoreflect.FileDescriptor
 
-var file_performance_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x11, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x05, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
-	0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0c,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x37, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x68,
-	0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x05, 0x67,
-	0x6f, 0x61, 0x6c, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x72, 0x2e,
-	0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65,
-	0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x61,
-	0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72,
-	0x61, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x97, 0x02, 0x0a, 0x04,
-	0x47, 0x6f, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
-	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x72, 0x2e,
-	0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x6f, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c,
-	0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1f, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a,
-	0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68,
-	0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x41, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63,
-	0x79, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22,
-	0x76, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x11, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x11, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0xf4, 0x01, 0x0a, 0x1e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d,
-	0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x41, 0x0a,
-	0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e,
-	0x63, 0x79, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x12, 0x29, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72,
-	0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f,
-	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x22, 0x76, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x11, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x91, 0x02, 0x0a,
-	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1f, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x0d, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1f, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x22, 0xc9, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x12, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x30, 0x0a, 0x1e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x76,
-	0x0a, 0x1f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x11, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x78, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x22, 0xd0, 0x01, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x70, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x12, 0x70,
-	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x2a, 0xa0, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x50,
-	0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x50, 0x45,
-	0x52, 0x49, 0x4f, 0x44, 0x5f, 0x51, 0x55, 0x41, 0x52, 0x54, 0x45, 0x52, 0x4c, 0x59, 0x10, 0x01,
-	0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
-	0x44, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0x02, 0x12,
-	0x18, 0x0a, 0x14, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
-	0x5f, 0x41, 0x4e, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x56,
-	0x49, 0x45, 0x57, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x9c, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x56, 0x49, 0x45,
-	0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12,
-	0x1b, 0x0a, 0x17, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
-	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x56,
-	0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49,
-	0x56, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xb6, 0x01, 0x0a, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b,
-	0x0a, 0x17, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e,
-	0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x47,
-	0x4f, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
-	0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04,
-	0x12, 0x1c, 0x0a, 0x18, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x48, 0x49, 0x45, 0x56, 0x45, 0x44, 0x10, 0x05, 0x32, 0x90,
-	0x07, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x12, 0x31, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x77, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x12, 0x2e, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x30,
-	0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x31, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x31, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x17, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x31, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a,
-	0x1d, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37,
-	0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x72, 0x2e, 0x70, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x3b, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_performance_proto_rawDesc = "" +
+	"\n" +
+	"\x11performance.proto\x12\x11hr.performance.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe4\x05\n" +
+	"\x11PerformanceReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12#\n" +
+	"\remployee_name\x18\x03 \x01(\tR\femployeeName\x12\x1f\n" +
+	"\vreviewer_id\x18\x04 \x01(\tR\n" +
+	"reviewerId\x12#\n" +
+	"\rreviewer_name\x18\x05 \x01(\tR\freviewerName\x12D\n" +
+	"\rreview_period\x18\x06 \x01(\x0e2\x1f.hr.performance.v1.ReviewPeriodR\freviewPeriod\x127\n" +
+	"\x06status\x18\a \x01(\x0e2\x1f.hr.performance.v1.ReviewStatusR\x06status\x12-\n" +
+	"\x05goals\x18\b \x03(\v2\x17.hr.performance.v1.GoalR\x05goals\x12A\n" +
+	"\fcompetencies\x18\t \x03(\v2\x1d.hr.performance.v1.CompetencyR\fcompetencies\x12)\n" +
+	"\x10overall_comments\x18\n" +
+	" \x01(\tR\x0foverallComments\x12%\n" +
+	"\x0eoverall_rating\x18\v \x01(\x01R\roverallRating\x12;\n" +
+	"\vreview_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewDate\x12=\n" +
+	"\fsubmitted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x97\x02\n" +
+	"\x04Goal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\ftarget_value\x18\x04 \x01(\x01R\vtargetValue\x12%\n" +
+	"\x0eachieved_value\x18\x05 \x01(\x01R\rachievedValue\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x125\n" +
+	"\x06status\x18\a \x01(\x0e2\x1d.hr.performance.v1.GoalStatusR\x06status\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x01R\x06weight\x12\x1a\n" +
+	"\bcomments\x18\t \x01(\tR\bcomments\"\xbd\x01\n" +
+	"\n" +
+	"Competency\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x01R\x06rating\x12\x1d\n" +
+	"\n" +
+	"max_rating\x18\x05 \x01(\x01R\tmaxRating\x12\x1a\n" +
+	"\bcomments\x18\x06 \x01(\tR\bcomments\x12\x16\n" +
+	"\x06weight\x18\a \x01(\x01R\x06weight\"\xd7\x02\n" +
+	"\x1eCreatePerformanceReviewRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12D\n" +
+	"\rreview_period\x18\x03 \x01(\x0e2\x1f.hr.performance.v1.ReviewPeriodR\freviewPeriod\x12;\n" +
+	"\vreview_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewDate\x12-\n" +
+	"\x05goals\x18\x05 \x03(\v2\x17.hr.performance.v1.GoalR\x05goals\x12A\n" +
+	"\fcompetencies\x18\x06 \x03(\v2\x1d.hr.performance.v1.CompetencyR\fcompetencies\"v\n" +
+	"\x1fCreatePerformanceReviewResponse\x12S\n" +
+	"\x12performance_review\x18\x01 \x01(\v2$.hr.performance.v1.PerformanceReviewR\x11performanceReview\"-\n" +
+	"\x1bGetPerformanceReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x1cGetPerformanceReviewResponse\x12S\n" +
+	"\x12performance_review\x18\x01 \x01(\v2$.hr.performance.v1.PerformanceReviewR\x11performanceReview\"\xf4\x01\n" +
+	"\x1eUpdatePerformanceReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x05goals\x18\x02 \x03(\v2\x17.hr.performance.v1.GoalR\x05goals\x12A\n" +
+	"\fcompetencies\x18\x03 \x03(\v2\x1d.hr.performance.v1.CompetencyR\fcompetencies\x12)\n" +
+	"\x10overall_comments\x18\x04 \x01(\tR\x0foverallComments\x12%\n" +
+	"\x0eoverall_rating\x18\x05 \x01(\x01R\roverallRating\"v\n" +
+	"\x1fUpdatePerformanceReviewResponse\x12S\n" +
+	"\x12performance_review\x18\x01 \x01(\v2$.hr.performance.v1.PerformanceReviewR\x11performanceReview\"0\n" +
+	"\x1eDeletePerformanceReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x91\x02\n" +
+	"\x1dListPerformanceReviewsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x12\x1f\n" +
+	"\vreviewer_id\x18\x04 \x01(\tR\n" +
+	"reviewerId\x127\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1f.hr.performance.v1.ReviewStatusR\x06status\x12D\n" +
+	"\rreview_period\x18\x06 \x01(\x0e2\x1f.hr.performance.v1.ReviewPeriodR\freviewPeriod\"\xc9\x01\n" +
+	"\x1eListPerformanceReviewsResponse\x12U\n" +
+	"\x13performance_reviews\x18\x01 \x03(\v2$.hr.performance.v1.PerformanceReviewR\x12performanceReviews\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"0\n" +
+	"\x1eSubmitPerformanceReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"v\n" +
+	"\x1fSubmitPerformanceReviewResponse\x12S\n" +
+	"\x12performance_review\x18\x01 \x01(\v2$.hr.performance.v1.PerformanceReviewR\x11performanceReview\"2\n" +
+	" CompletePerformanceReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"x\n" +
+	"!CompletePerformanceReviewResponse\x12S\n" +
+	"\x12performance_review\x18\x01 \x01(\v2$.hr.performance.v1.PerformanceReviewR\x11performanceReview\"1\n" +
+	"\x1fArchivePerformanceReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"w\n" +
+	" ArchivePerformanceReviewResponse\x12S\n" +
+	"\x12performance_review\x18\x01 \x01(\v2$.hr.performance.v1.PerformanceReviewR\x11performanceReview\"x\n" +
+	"$GetEmployeePerformanceHistoryRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xd0\x01\n" +
+	"%GetEmployeePerformanceHistoryResponse\x12U\n" +
+	"\x13performance_reviews\x18\x01 \x03(\v2$.hr.performance.v1.PerformanceReviewR\x12performanceReviews\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*\xa0\x01\n" +
+	"\fReviewPeriod\x12\x1d\n" +
+	"\x19REVIEW_PERIOD_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REVIEW_PERIOD_QUARTERLY\x10\x01\x12\x1d\n" +
+	"\x19REVIEW_PERIOD_HALF_YEARLY\x10\x02\x12\x18\n" +
+	"\x14REVIEW_PERIOD_ANNUAL\x10\x03\x12\x1b\n" +
+	"\x17REVIEW_PERIOD_PROBATION\x10\x04*\x9c\x01\n" +
+	"\fReviewStatus\x12\x1d\n" +
+	"\x19REVIEW_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13REVIEW_STATUS_DRAFT\x10\x01\x12\x1b\n" +
+	"\x17REVIEW_STATUS_SUBMITTED\x10\x02\x12\x1b\n" +
+	"\x17REVIEW_STATUS_COMPLETED\x10\x03\x12\x1a\n" +
+	"\x16REVIEW_STATUS_ARCHIVED\x10\x04*\xb6\x01\n" +
+	"\n" +
+	"GoalStatus\x12\x1b\n" +
+	"\x17GOAL_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17GOAL_STATUS_NOT_STARTED\x10\x01\x12\x1b\n" +
+	"\x17GOAL_STATUS_IN_PROGRESS\x10\x02\x12\x19\n" +
+	"\x15GOAL_STATUS_COMPLETED\x10\x03\x12\x18\n" +
+	"\x14GOAL_STATUS_EXCEEDED\x10\x04\x12\x1c\n" +
+	"\x18GOAL_STATUS_NOT_ACHIEVED\x10\x052\x9f\t\n" +
+	"\x12PerformanceService\x12d\n" +
+	"\x17DeletePerformanceReview\x121.hr.performance.v1.DeletePerformanceReviewRequest\x1a\x16.google.protobuf.Empty\x12w\n" +
+	"\x14GetPerformanceReview\x12..hr.performance.v1.GetPerformanceReviewRequest\x1a/.hr.performance.v1.GetPerformanceReviewResponse\x12}\n" +
+	"\x16ListPerformanceReviews\x120.hr.performance.v1.ListPerformanceReviewsRequest\x1a1.hr.performance.v1.ListPerformanceReviewsResponse\x12\x80\x01\n" +
+	"\x17UpdatePerformanceReview\x121.hr.performance.v1.UpdatePerformanceReviewRequest\x1a2.hr.performance.v1.UpdatePerformanceReviewResponse\x12\x80\x01\n" +
+	"\x17SubmitPerformanceReview\x121.hr.performance.v1.SubmitPerformanceReviewRequest\x1a2.hr.performance.v1.SubmitPerformanceReviewResponse\x12\x80\x01\n" +
+	"\x17CreatePerformanceReview\x121.hr.performance.v1.CreatePerformanceReviewRequest\x1a2.hr.performance.v1.CreatePerformanceReviewResponse\x12\x92\x01\n" +
+	"\x1dGetEmployeePerformanceHistory\x127.hr.performance.v1.GetEmployeePerformanceHistoryRequest\x1a8.hr.performance.v1.GetEmployeePerformanceHistoryResponse\x12\x86\x01\n" +
+	"\x19CompletePerformanceReview\x123.hr.performance.v1.CompletePerformanceReviewRequest\x1a4.hr.performance.v1.CompletePerformanceReviewResponse\x12\x83\x01\n" +
+	"\x18ArchivePerformanceReview\x122.hr.performance.v1.ArchivePerformanceReviewRequest\x1a3.hr.performance.v1.ArchivePerformanceReviewResponseB.Z,./api/proto/v1/gen/performance;performancev1b\x06proto3"
 
 var (
 	file_performance_proto_rawDescOnce sync.Once
-	file_performance_proto_rawDescData = file_performance_proto_rawDesc
+	file_performance_proto_rawDescData []byte
 )
 
 func file_performance_proto_rawDescGZIP() []byte {
 	file_performance_proto_rawDescOnce.Do(func() {
-		file_performance_proto_rawDescData = protoimpl.X.CompressGZIP(file_performance_proto_rawDescData)
+		file_performance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_performance_proto_rawDesc), len(file_performance_proto_rawDesc)))
 	})
 	return file_performance_proto_rawDescData
 }
 
 var file_performance_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_performance_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_performance_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_performance_proto_goTypes = []any{
 	(ReviewPeriod)(0),                             // 0: hr.performance.v1.ReviewPeriod
 	(ReviewStatus)(0),                             // 1: hr.performance.v1.ReviewStatus
@@ -1633,23 +1645,27 @@ var file_performance_proto_goTypes = []any{
 	(*ListPerformanceReviewsResponse)(nil),        // 14: hr.performance.v1.ListPerformanceReviewsResponse
 	(*SubmitPerformanceReviewRequest)(nil),        // 15: hr.performance.v1.SubmitPerformanceReviewRequest
 	(*SubmitPerformanceReviewResponse)(nil),       // 16: hr.performance.v1.SubmitPerformanceReviewResponse
-	(*GetEmployeePerformanceHistoryRequest)(nil),  // 17: hr.performance.v1.GetEmployeePerformanceHistoryRequest
-	(*GetEmployeePerformanceHistoryResponse)(nil), // 18: hr.performance.v1.GetEmployeePerformanceHistoryResponse
-	(*timestamppb.Timestamp)(nil),                 // 19: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                         // 20: google.protobuf.Empty
+	(*CompletePerformanceReviewRequest)(nil),      // 17: hr.performance.v1.CompletePerformanceReviewRequest
+	(*CompletePerformanceReviewResponse)(nil),     // 18: hr.performance.v1.CompletePerformanceReviewResponse
+	(*ArchivePerformanceReviewRequest)(nil),       // 19: hr.performance.v1.ArchivePerformanceReviewRequest
+	(*ArchivePerformanceReviewResponse)(nil),      // 20: hr.performance.v1.ArchivePerformanceReviewResponse
+	(*GetEmployeePerformanceHistoryRequest)(nil),  // 21: hr.performance.v1.GetEmployeePerformanceHistoryRequest
+	(*GetEmployeePerformanceHistoryResponse)(nil), // 22: hr.performance.v1.GetEmployeePerformanceHistoryResponse
+	(*timestamppb.Timestamp)(nil),                 // 23: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                         // 24: google.protobuf.Empty
 }
 var file_performance_proto_depIdxs = []int32{
 	0,  // 0: hr.performance.v1.PerformanceReview.review_period:type_name -> hr.performance.v1.ReviewPeriod
 	1,  // 1: hr.performance.v1.PerformanceReview.status:type_name -> hr.performance.v1.ReviewStatus
 	4,  // 2: hr.performance.v1.PerformanceReview.goals:type_name -> hr.performance.v1.Goal
 	5,  // 3: hr.performance.v1.PerformanceReview.competencies:type_name -> hr.performance.v1.Competency
-	19, // 4: hr.performance.v1.PerformanceReview.review_date:type_name -> google.protobuf.Timestamp
-	19, // 5: hr.performance.v1.PerformanceReview.submitted_at:type_name -> google.protobuf.Timestamp
-	19, // 6: hr.performance.v1.PerformanceReview.created_at:type_name -> google.protobuf.Timestamp
-	19, // 7: hr.performance.v1.PerformanceReview.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 4: hr.performance.v1.PerformanceReview.review_date:type_name -> google.protobuf.Timestamp
+	23, // 5: hr.performance.v1.PerformanceReview.submitted_at:type_name -> google.protobuf.Timestamp
+	23, // 6: hr.performance.v1.PerformanceReview.created_at:type_name -> google.protobuf.Timestamp
+	23, // 7: hr.performance.v1.PerformanceReview.updated_at:type_name -> google.protobuf.Timestamp
 	2,  // 8: hr.performance.v1.Goal.status:type_name -> hr.performance.v1.GoalStatus
 	0,  // 9: hr.performance.v1.CreatePerformanceReviewRequest.review_period:type_name -> hr.performance.v1.ReviewPeriod
-	19, // 10: hr.performance.v1.CreatePerformanceReviewRequest.review_date:type_name -> google.protobuf.Timestamp
+	23, // 10: hr.performance.v1.CreatePerformanceReviewRequest.review_date:type_name -> google.protobuf.Timestamp
 	4,  // 11: hr.performance.v1.CreatePerformanceReviewRequest.goals:type_name -> hr.performance.v1.Goal
 	5,  // 12: hr.performance.v1.CreatePerformanceReviewRequest.competencies:type_name -> hr.performance.v1.Competency
 	3,  // 13: hr.performance.v1.CreatePerformanceReviewResponse.performance_review:type_name -> hr.performance.v1.PerformanceReview
@@ -1661,26 +1677,32 @@ var file_performance_proto_depIdxs = []int32{
 	0,  // 19: hr.performance.v1.ListPerformanceReviewsRequest.review_period:type_name -> hr.performance.v1.ReviewPeriod
 	3,  // 20: hr.performance.v1.ListPerformanceReviewsResponse.performance_reviews:type_name -> hr.performance.v1.PerformanceReview
 	3,  // 21: hr.performance.v1.SubmitPerformanceReviewResponse.performance_review:type_name -> hr.performance.v1.PerformanceReview
-	3,  // 22: hr.performance.v1.GetEmployeePerformanceHistoryResponse.performance_reviews:type_name -> hr.performance.v1.PerformanceReview
-	12, // 23: hr.performance.v1.PerformanceService.DeletePerformanceReview:input_type -> hr.performance.v1.DeletePerformanceReviewRequest
-	8,  // 24: hr.performance.v1.PerformanceService.GetPerformanceReview:input_type -> hr.performance.v1.GetPerformanceReviewRequest
-	13, // 25: hr.performance.v1.PerformanceService.ListPerformanceReviews:input_type -> hr.performance.v1.ListPerformanceReviewsRequest
-	10, // 26: hr.performance.v1.PerformanceService.UpdatePerformanceReview:input_type -> hr.performance.v1.UpdatePerformanceReviewRequest
-	15, // 27: hr.performance.v1.PerformanceService.SubmitPerformanceReview:input_type -> hr.performance.v1.SubmitPerformanceReviewRequest
-	6,  // 28: hr.performance.v1.PerformanceService.CreatePerformanceReview:input_type -> hr.performance.v1.CreatePerformanceReviewRequest
-	17, // 29: hr.performance.v1.PerformanceService.GetEmployeePerformanceHistory:input_type -> hr.performance.v1.GetEmployeePerformanceHistoryRequest
-	20, // 30: hr.performance.v1.PerformanceService.DeletePerformanceReview:output_type -> google.protobuf.Empty
-	9,  // 31: hr.performance.v1.PerformanceService.GetPerformanceReview:output_type -> hr.performance.v1.GetPerformanceReviewResponse
-	14, // 32: hr.performance.v1.PerformanceService.ListPerformanceReviews:output_type -> hr.performance.v1.ListPerformanceReviewsResponse
-	11, // 33: hr.performance.v1.PerformanceService.UpdatePerformanceReview:output_type -> hr.performance.v1.UpdatePerformanceReviewResponse
-	16, // 34: hr.performance.v1.PerformanceService.SubmitPerformanceReview:output_type -> hr.performance.v1.SubmitPerformanceReviewResponse
-	7,  // 35: hr.performance.v1.PerformanceService.CreatePerformanceReview:output_type -> hr.performance.v1.CreatePerformanceReviewResponse
-	18, // 36: hr.performance.v1.PerformanceService.GetEmployeePerformanceHistory:output_type -> hr.performance.v1.GetEmployeePerformanceHistoryResponse
-	30, // [30:37] is the sub-list for method output_type
-	23, // [23:30] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	3,  // 22: hr.performance.v1.CompletePerformanceReviewResponse.performance_review:type_name -> hr.performance.v1.PerformanceReview
+	3,  // 23: hr.performance.v1.ArchivePerformanceReviewResponse.performance_review:type_name -> hr.performance.v1.PerformanceReview
+	3,  // 24: hr.performance.v1.GetEmployeePerformanceHistoryResponse.performance_reviews:type_name -> hr.performance.v1.PerformanceReview
+	12, // 25: hr.performance.v1.PerformanceService.DeletePerformanceReview:input_type -> hr.performance.v1.DeletePerformanceReviewRequest
+	8,  // 26: hr.performance.v1.PerformanceService.GetPerformanceReview:input_type -> hr.performance.v1.GetPerformanceReviewRequest
+	13, // 27: hr.performance.v1.PerformanceService.ListPerformanceReviews:input_type -> hr.performance.v1.ListPerformanceReviewsRequest
+	10, // 28: hr.performance.v1.PerformanceService.UpdatePerformanceReview:input_type -> hr.performance.v1.UpdatePerformanceReviewRequest
+	15, // 29: hr.performance.v1.PerformanceService.SubmitPerformanceReview:input_type -> hr.performance.v1.SubmitPerformanceReviewRequest
+	6,  // 30: hr.performance.v1.PerformanceService.CreatePerformanceReview:input_type -> hr.performance.v1.CreatePerformanceReviewRequest
+	21, // 31: hr.performance.v1.PerformanceService.GetEmployeePerformanceHistory:input_type -> hr.performance.v1.GetEmployeePerformanceHistoryRequest
+	17, // 32: hr.performance.v1.PerformanceService.CompletePerformanceReview:input_type -> hr.performance.v1.CompletePerformanceReviewRequest
+	19, // 33: hr.performance.v1.PerformanceService.ArchivePerformanceReview:input_type -> hr.performance.v1.ArchivePerformanceReviewRequest
+	24, // 34: hr.performance.v1.PerformanceService.DeletePerformanceReview:output_type -> google.protobuf.Empty
+	9,  // 35: hr.performance.v1.PerformanceService.GetPerformanceReview:output_type -> hr.performance.v1.GetPerformanceReviewResponse
+	14, // 36: hr.performance.v1.PerformanceService.ListPerformanceReviews:output_type -> hr.performance.v1.ListPerformanceReviewsResponse
+	11, // 37: hr.performance.v1.PerformanceService.UpdatePerformanceReview:output_type -> hr.performance.v1.UpdatePerformanceReviewResponse
+	16, // 38: hr.performance.v1.PerformanceService.SubmitPerformanceReview:output_type -> hr.performance.v1.SubmitPerformanceReviewResponse
+	7,  // 39: hr.performance.v1.PerformanceService.CreatePerformanceReview:output_type -> hr.performance.v1.CreatePerformanceReviewResponse
+	22, // 40: hr.performance.v1.PerformanceService.GetEmployeePerformanceHistory:output_type -> hr.performance.v1.GetEmployeePerformanceHistoryResponse
+	18, // 41: hr.performance.v1.PerformanceService.CompletePerformanceReview:output_type -> hr.performance.v1.CompletePerformanceReviewResponse
+	20, // 42: hr.performance.v1.PerformanceService.ArchivePerformanceReview:output_type -> hr.performance.v1.ArchivePerformanceReviewResponse
+	34, // [34:43] is the sub-list for method output_type
+	25, // [25:34] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_performance_proto_init() }
@@ -1692,9 +1714,9 @@ func file_performance_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_performance_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_performance_proto_rawDesc), len(file_performance_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1704,7 +1726,6 @@ func file_performance_proto_init() {
 		MessageInfos:      file_performance_proto_msgTypes,
 	}.Build()
 	File_performance_proto = out.File
-	file_performance_proto_rawDesc = nil
 	file_performance_proto_goTypes = nil
 	file_performance_proto_depIdxs = nil
 }
@@ -27,6 +27,8 @@ const (
 	PerformanceService_SubmitPerformanceReview_FullMethodName       = "/hr.performance.v1.PerformanceService/SubmitPerformanceReview"
 	PerformanceService_CreatePerformanceReview_FullMethodName       = "/hr.performance.v1.PerformanceService/CreatePerformanceReview"
 	PerformanceService_GetEmployeePerformanceHistory_FullMethodName = "/hr.performance.v1.PerformanceService/GetEmployeePerformanceHistory"
+	PerformanceService_CompletePerformanceReview_FullMethodName     = "/hr.performance.v1.PerformanceService/CompletePerformanceReview"
+	PerformanceService_ArchivePerformanceReview_FullMethodName      = "/hr.performance.v1.PerformanceService/ArchivePerformanceReview"
 )
 
 // PerformanceServiceClient is the client API for PerformanceService service.
@@ -40,6 +42,8 @@ type PerformanceServiceClient interface {
 	SubmitPerformanceReview(ctx context.Context, in *SubmitPerformanceReviewRequest, opts ...grpc.CallOption) (*SubmitPerformanceReviewResponse, error)
 	CreatePerformanceReview(ctx context.Context, in *CreatePerformanceReviewRequest, opts ...grpc.CallOption) (*CreatePerformanceReviewResponse, error)
 	GetEmployeePerformanceHistory(ctx context.Context, in *GetEmployeePerformanceHistoryRequest, opts ...grpc.CallOption) (*GetEmployeePerformanceHistoryResponse, error)
+	CompletePerformanceReview(ctx context.Context, in *CompletePerformanceReviewRequest, opts ...grpc.CallOption) (*CompletePerformanceReviewResponse, error)
+	ArchivePerformanceReview(ctx context.Context, in *ArchivePerformanceReviewRequest, opts ...grpc.CallOption) (*ArchivePerformanceReviewResponse, error)
 }
 
 type performanceServiceClient struct {
@@ -120,6 +124,26 @@ func (c *performanceServiceClient) GetEmployeePerformanceHistory(ctx context.Con
 	return out, nil
 }
 
+func (c *performanceServiceClient) CompletePerformanceReview(ctx context.Context, in *CompletePerformanceReviewRequest, opts ...grpc.CallOption) (*CompletePerformanceReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompletePerformanceReviewResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_CompletePerformanceReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *performanceServiceClient) ArchivePerformanceReview(ctx context.Context, in *ArchivePerformanceReviewRequest, opts ...grpc.CallOption) (*ArchivePerformanceReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePerformanceReviewResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_ArchivePerformanceReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PerformanceServiceServer is the server API for PerformanceService service.
 // All implementations must embed UnimplementedPerformanceServiceServer
 // for forward compatibility.
@@ -131,6 +155,8 @@ type PerformanceServiceServer interface {
 	SubmitPerformanceReview(context.Context, *SubmitPerformanceReviewRequest) (*SubmitPerformanceReviewResponse, error)
 	CreatePerformanceReview(context.Context, *CreatePerformanceReviewRequest) (*CreatePerformanceReviewResponse, error)
 	GetEmployeePerformanceHistory(context.Context, *GetEmployeePerformanceHistoryRequest) (*GetEmployeePerformanceHistoryResponse, error)
+	CompletePerformanceReview(context.Context, *CompletePerformanceReviewRequest) (*CompletePerformanceReviewResponse, error)
+	ArchivePerformanceReview(context.Context, *ArchivePerformanceReviewRequest) (*ArchivePerformanceReviewResponse, error)
 	mustEmbedUnimplementedPerformanceServiceServer()
 }
 
@@ -162,6 +188,12 @@ func (UnimplementedPerformanceServiceServer) CreatePerformanceReview(context.Con
 func (UnimplementedPerformanceServiceServer) GetEmployeePerformanceHistory(context.Context, *GetEmployeePerformanceHistoryRequest) (*GetEmployeePerformanceHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmployeePerformanceHistory not implemented")
 }
+func (UnimplementedPerformanceServiceServer) CompletePerformanceReview(context.Context, *CompletePerformanceReviewRequest) (*CompletePerformanceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePerformanceReview not implemented")
+}
+func (UnimplementedPerformanceServiceServer) ArchivePerformanceReview(context.Context, *ArchivePerformanceReviewRequest) (*ArchivePerformanceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePerformanceReview not implemented")
+}
 func (UnimplementedPerformanceServiceServer) mustEmbedUnimplementedPerformanceServiceServer() {}
 func (UnimplementedPerformanceServiceServer) testEmbeddedByValue()                            {}
 
@@ -309,6 +341,42 @@ func _PerformanceService_GetEmployeePerformanceHistory_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PerformanceService_CompletePerformanceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletePerformanceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PerformanceServiceServer).CompletePerformanceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PerformanceService_CompletePerformanceReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PerformanceServiceServer).CompletePerformanceReview(ctx, req.(*CompletePerformanceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PerformanceService_ArchivePerformanceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePerformanceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PerformanceServiceServer).ArchivePerformanceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PerformanceService_ArchivePerformanceReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PerformanceServiceServer).ArchivePerformanceReview(ctx, req.(*ArchivePerformanceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PerformanceService_ServiceDesc is the grpc.ServiceDesc for PerformanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,6 +412,14 @@ var PerformanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEmployeePerformanceHistory",
 			Handler:    _PerformanceService_GetEmployeePerformanceHistory_Handler,
 		},
+		{
+			MethodName: "CompletePerformanceReview",
+			Handler:    _PerformanceService_CompletePerformanceReview_Handler,
+		},
+		{
+			MethodName: "ArchivePerformanceReview",
+			Handler:    _PerformanceService_ArchivePerformanceReview_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "performance.proto",
@@ -2,24 +2,39 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/dmehra2102/hr-management-system/internal/audit"
+	"github.com/dmehra2102/hr-management-system/internal/auth"
 	"github.com/dmehra2102/hr-management-system/internal/config"
 	"github.com/dmehra2102/hr-management-system/internal/database"
 	"github.com/dmehra2102/hr-management-system/internal/department"
 	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"github.com/dmehra2102/hr-management-system/internal/leave"
 	"github.com/dmehra2102/hr-management-system/internal/middleware"
+	"github.com/dmehra2102/hr-management-system/internal/mtls"
+	"github.com/dmehra2102/hr-management-system/internal/notify"
+	"github.com/dmehra2102/hr-management-system/internal/password"
+	"github.com/dmehra2102/hr-management-system/internal/performance"
 	"github.com/dmehra2102/hr-management-system/pkg/logger"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
+	authpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/auth"
 	departmentpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/department"
 	employeepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/employee"
+	holidaypb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/holiday"
+	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	performancepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/performance"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -29,10 +44,18 @@ import (
 )
 
 type Server struct {
-	config     *config.Config
-	logger     *logger.Logger
-	db         *database.Database
-	grpcServer *grpc.Server
+	config      *config.Config
+	logger      *logger.Logger
+	db          *database.Database
+	keys        *auth.KeyStore
+	jwtService  *auth.JWTService
+	revocations auth.RevocationStore
+	passwords   *password.Policy
+	secretBox   *auth.SecretBox
+	notifier    notify.Notifier
+	weekends    []time.Weekday
+	grpcServer  *grpc.Server
+	httpServer  *http.Server
 }
 
 func main() {
@@ -61,10 +84,58 @@ func main() {
 		os.Exit(1)
 	}
 
+	keys, err := loadKeyStore(cfg)
+	if err != nil {
+		log.Error("Failed to load JWT signing keys", "error", err)
+		os.Exit(1)
+	}
+
+	passwords, err := password.NewPolicy(password.Config{
+		MinLength:      cfg.Password.MinLength,
+		RequireUpper:   cfg.Password.RequireUpper,
+		RequireLower:   cfg.Password.RequireLower,
+		RequireDigit:   cfg.Password.RequireDigit,
+		RequireSymbol:  cfg.Password.RequireSymbol,
+		HistorySize:    cfg.Password.HistorySize,
+		BannedListFile: cfg.Password.BannedListFile,
+	})
+	if err != nil {
+		log.Error("Failed to load password policy", "error", err)
+		os.Exit(1)
+	}
+
+	secretBox, err := auth.NewSecretBox(cfg.MFA.EncryptionKey)
+	if err != nil {
+		log.Error("Failed to load MFA encryption key", "error", err)
+		os.Exit(1)
+	}
+
+	notifier, err := newNotifier(cfg, log)
+	if err != nil {
+		log.Error("Failed to initialize notifier", "error", err)
+		os.Exit(1)
+	}
+
+	weekends, err := holiday.ParseWeekdays(cfg.WeekendDays)
+	if err != nil {
+		log.Error("Failed to parse weekend days", "error", err)
+		os.Exit(1)
+	}
+
 	server := &Server{
-		config: cfg,
-		logger: log,
-		db:     db,
+		config:     cfg,
+		logger:     log,
+		db:         db,
+		keys:       keys,
+		jwtService: auth.NewJWTService(keys, time.Duration(cfg.JWTExpiryHours)*time.Hour),
+		revocations: auth.NewCachedRevocationStore(
+			auth.NewPostgresRevocationStore(db.GetDB()),
+			time.Duration(cfg.RevocationCacheTTLSeconds)*time.Second,
+		),
+		passwords: passwords,
+		secretBox: secretBox,
+		notifier:  notifier,
+		weekends:  weekends,
 	}
 
 	// Start server
@@ -74,24 +145,125 @@ func main() {
 	}
 }
 
+// transportSecurity returns the TLS credentials of the gRPC server and the
+// services client certificates map to. The certificates are reloaded when their
+// files change until ctx is done.
+func (s *Server) transportSecurity(ctx context.Context) ([]grpc.ServerOption, *mtls.Identities, error) {
+	if !s.config.TLS.Enabled {
+		s.logger.Warn("TLS is disabled, the gRPC server accepts plaintext connections")
+		return nil, nil, nil
+	}
+
+	reloader, err := mtls.NewReloader(mtls.Config{
+		CertFile:          s.config.TLS.CertFile,
+		KeyFile:           s.config.TLS.KeyFile,
+		ClientCAFile:      s.config.TLS.ClientCAFile,
+		RequireClientCert: s.config.TLS.RequireClientCert,
+	}, s.logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load TLS certificates: %w", err)
+	}
+
+	go func() {
+		if err := reloader.Watch(ctx); err != nil {
+			s.logger.Error("Failed to watch TLS certificates", "error", err)
+		}
+	}()
+
+	var identities *mtls.Identities
+	if s.config.TLS.ClientIdentitiesFile != "" {
+		identities, err = mtls.LoadIdentities(s.config.TLS.ClientIdentitiesFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	s.logger.Info("TLS enabled", "client_ca", s.config.TLS.ClientCAFile != "", "require_client_cert", s.config.TLS.RequireClientCert)
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(reloader.TLSConfig()))}, identities, nil
+}
+
+// newNotifier returns the configured delivery of notifications
+func newNotifier(cfg *config.Config, log *logger.Logger) (notify.Notifier, error) {
+	if cfg.Notifier.Type == "file" {
+		return notify.NewFileNotifier(cfg.Notifier.FilePath)
+	}
+	return notify.NewLogNotifier(log), nil
+}
+
+// loadKeyStore returns the signing keys for the configured JWT signing method
+func loadKeyStore(cfg *config.Config) (*auth.KeyStore, error) {
+	if cfg.JWTSigningMethod == auth.SigningMethodHS256 {
+		return auth.NewHMACKeyStore(cfg.JWTSecret), nil
+	}
+
+	return auth.NewFileKeyStore(
+		cfg.JWTKeysDir,
+		cfg.JWTSigningMethod,
+		time.Duration(cfg.JWTKeyActivationDelayMinutes)*time.Minute,
+	)
+}
+
 func (s *Server) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Pick up rotated signing keys
+	go s.keys.Watch(ctx, time.Duration(s.config.JWTKeyReloadIntervalSeconds)*time.Second, s.logger)
+
+	// Create and top up leave balances
+	if s.config.AccrualEnabled {
+		accrual := leave.NewAccrualEngine(leave.NewRepository(s.db.GetDB()), s.logger)
+		go accrual.Schedule(ctx, time.Duration(s.config.AccrualIntervalHours)*time.Hour)
+	}
+
+	// Carry leave into the new year and lapse expired carried days
+	if s.config.YearEndEnabled {
+		yearEnd := leave.NewYearEndJob(leave.NewRepository(s.db.GetDB()), s.logger)
+		go yearEnd.Schedule(ctx, time.Duration(s.config.YearEndIntervalHours)*time.Hour)
+	}
+
+	transportOptions, identities, err := s.transportSecurity(ctx)
+	if err != nil {
+		return err
+	}
+
+	authRepo := auth.NewRepository(s.db.GetDB())
+	authenticator := middleware.NewAuthenticator(
+		s.jwtService,
+		s.revocations,
+		auth.NewAPIKeyVerifier(authRepo, s.logger),
+		auth.NewSessionTracker(authRepo, s.logger),
+		identities,
+	)
+	ownershipChecker := middleware.NewOwnershipChecker(
+		employee.NewRepository(s.db.GetDB()),
+		department.NewRepository(s.db.GetDB()),
+		leave.NewRepository(s.db.GetDB()),
+		performance.NewRepository(s.db.GetDB()),
+	)
+	policy := middleware.DefaultPolicy(ownershipChecker)
+	auditRepo := audit.NewRepository(s.db.GetDB())
 
-	s.grpcServer = grpc.NewServer(
+	s.grpcServer = grpc.NewServer(append(transportOptions,
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
 			grpctags.StreamServerInterceptor(),
 			grpclogrus.StreamServerInterceptor(s.logger.GetLogrusEntry()),
-			grpcauth.StreamServerInterceptor(middleware.AuthFunc),
+			grpcauth.StreamServerInterceptor(authenticator.AuthFunc),
+			middleware.StreamImpersonationAuditInterceptor(auditRepo, s.logger),
+			middleware.StreamAuthorizationInterceptor(policy, s.logger),
 			grpcrecovery.StreamServerInterceptor(),
 			middleware.StreamRecoveryInterceptor(s.logger),
 		)),
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
 			grpctags.UnaryServerInterceptor(),
 			grpclogrus.UnaryServerInterceptor(s.logger.GetLogrusEntry()),
-			grpcauth.UnaryServerInterceptor(middleware.AuthFunc),
+			grpcauth.UnaryServerInterceptor(authenticator.AuthFunc),
+			middleware.ImpersonationAuditInterceptor(auditRepo, s.logger),
+			middleware.AuthorizationInterceptor(policy, s.logger),
 			grpcrecovery.UnaryServerInterceptor(),
 			middleware.RecoveryInterceptor(s.logger),
 		)),
-	)
+	)...)
 
 	s.registerServices()
 
@@ -107,12 +279,27 @@ func (s *Server) Start() error {
 
 	s.logger.Info("gRPC server starting", "port", s.config.GRPCPort)
 
-	errChan := make(chan error, 1)
+	mux := http.NewServeMux()
+	mux.Handle(auth.JWKSPath, auth.JWKSHandler(s.keys))
+	s.httpServer = &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.config.ServerPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	s.logger.Info("HTTP server starting", "port", s.config.ServerPort, "jwks", auth.JWKSPath)
+
+	errChan := make(chan error, 2)
 	go func() {
 		if err := s.grpcServer.Serve(listener); err != nil {
 			errChan <- fmt.Errorf("gRPC server error: %w", err)
 		}
 	}()
+	go func() {
+		if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errChan <- fmt.Errorf("HTTP server error: %w", err)
+		}
+	}()
 
 	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
@@ -127,29 +314,98 @@ func (s *Server) Start() error {
 }
 
 func (s *Server) registerServices() {
-	// jwtService := auth.NewJWTService(s.config.JWTSecret, time.Duration(s.config.JWTExpiryHours) * time.Hour)
-
 	employeeRepo := employee.NewRepository(s.db.GetDB())
 	departmentRepo := department.NewRepository(s.db.GetDB())
+	authRepo := auth.NewRepository(s.db.GetDB())
+	auditRepo := audit.NewRepository(s.db.GetDB())
+	leaveRepo := leave.NewRepository(s.db.GetDB())
+	holidayRepo := holiday.NewRepository(s.db.GetDB())
+	performanceRepo := performance.NewRepository(s.db.GetDB())
 
-	employeeService := employee.NewService(employeeRepo, s.logger)
+	lockout := auth.NewLockout(s.lockoutStore(), auth.LockoutPolicy{
+		MaxAccountFailures: s.config.Lockout.MaxAccountFailures,
+		MaxIPFailures:      s.config.Lockout.MaxIPFailures,
+		Window:             time.Duration(s.config.Lockout.WindowMinutes) * time.Minute,
+		LockDuration:       time.Duration(s.config.Lockout.DurationMinutes) * time.Minute,
+		BaseDelay:          time.Duration(s.config.Lockout.BaseDelaySeconds) * time.Second,
+		MaxDelay:           time.Duration(s.config.Lockout.MaxDelaySeconds) * time.Second,
+	}, auditRepo, s.logger)
+
+	authService := auth.NewService(
+		s.jwtService,
+		authRepo,
+		s.revocations,
+		lockout,
+		s.passwords,
+		auth.MFAPolicy{
+			RequiredRoles:   s.config.MFA.RequiredRoles,
+			Issuer:          s.config.MFA.Issuer,
+			ChallengeExpiry: time.Duration(s.config.MFA.ChallengeExpiryMinutes) * time.Minute,
+		},
+		s.secretBox,
+		auth.PasswordResetPolicy{
+			TokenExpiry: time.Duration(s.config.PasswordResetTokenExpiryMinutes) * time.Minute,
+			URL:         s.config.PasswordResetURL,
+		},
+		s.notifier,
+		auditRepo,
+		employeeRepo,
+		time.Duration(s.config.RefreshTokenExpiryHours)*time.Hour,
+		time.Duration(s.config.ImpersonationExpiryMinutes)*time.Minute,
+		s.logger,
+	)
+	employeeService := employee.NewService(employeeRepo, authService, s.passwords, s.logger)
 	departmentService := department.NewService(departmentRepo, s.logger)
+	holidayService := holiday.NewService(holidayRepo, s.logger)
+	leaveService := leave.NewService(
+		leaveRepo,
+		holiday.NewCalendar(holidayRepo, s.weekends),
+		leave.NewAccrualEngine(leaveRepo, s.logger),
+		leave.NewYearEndJob(leaveRepo, s.logger),
+		leave.Policy{
+			WorkingHoursPerDay: s.config.StandardWorkingHours,
+			WorkingDaysPerYear: float64(52 * (7 - len(s.weekends))),
+			CompOffExpiryDays:  s.config.CompOffExpiryDays,
+		},
+		s.logger,
+	)
+	performanceService := performance.NewService(performanceRepo, s.logger)
 
 	employeeHandler := employee.NewHandler(employeeService, s.logger)
-	departmentHandler := department.NewHandler(departmentService,s.logger)
+	departmentHandler := department.NewHandler(departmentService, s.logger)
+	authHandler := auth.NewHandler(authService, s.logger)
+	holidayHandler := holiday.NewHandler(holidayService, s.logger)
+	leaveHandler := leave.NewHandler(leaveService, s.logger)
+	performanceHandler := performance.NewHandler(performanceService, s.logger)
 
 	employeepb.RegisterEmployeeServiceServer(s.grpcServer, employeeHandler)
 	departmentpb.RegisterDepartmentServiceServer(s.grpcServer, departmentHandler)
-	
+	authpb.RegisterAuthServiceServer(s.grpcServer, authHandler)
+	holidaypb.RegisterHolidayServiceServer(s.grpcServer, holidayHandler)
+	leavepb.RegisterLeaveServiceServer(s.grpcServer, leaveHandler)
+	performancepb.RegisterPerformanceServiceServer(s.grpcServer, performanceHandler)
+
 	s.logger.Info("All gRPC services registered successfully")
 }
 
+// lockoutStore returns the configured store of failed login counters
+func (s *Server) lockoutStore() auth.LockoutStore {
+	if s.config.Lockout.Store == "postgres" {
+		return auth.NewPostgresLockoutStore(s.db.GetDB())
+	}
+	return auth.NewMemoryLockoutStore()
+}
+
 func (s *Server) shutdown() error {
 	s.logger.Info("Graxeful shutdown completed")
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.logger.Error("Failed to shutdown HTTP server", "error", err)
+	}
+
 	shutdownCh := make(chan struct{})
 
 	go func() {
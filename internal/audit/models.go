@@ -0,0 +1,28 @@
+package audit
+
+import "time"
+
+// Actions recorded in the audit trail
+const (
+	ActionAccountLocked   = "ACCOUNT_LOCKED"
+	ActionIPLocked        = "IP_LOCKED"
+	ActionAccountUnlocked = "ACCOUNT_UNLOCKED"
+	// ActionImpersonationStarted is recorded when an admin obtains a token acting as an employee
+	ActionImpersonationStarted = "IMPERSONATION_STARTED"
+	// ActionImpersonatedCall is recorded for every RPC made with such a token
+	ActionImpersonatedCall = "IMPERSONATED_CALL"
+)
+
+type Event struct {
+	ID        string         `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Action    string         `json:"action" gorm:"not null;index"`
+	ActorID   *string        `json:"actor_id,omitempty" gorm:"type:uuid;index"`
+	SubjectID *string        `json:"subject_id,omitempty" gorm:"type:uuid;index"`
+	IPAddress string         `json:"ip_address,omitempty"`
+	Details   map[string]any `json:"details,omitempty" gorm:"type:jsonb;serializer:json"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+func (Event) TableName() string {
+	return "audit_events"
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+)
+
+type memoryAPIKeyRepository struct {
+	Repository
+	keys    map[string]*APIKey
+	touched []string
+}
+
+func (r *memoryAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	if key, ok := r.keys[keyHash]; ok {
+		return key, nil
+	}
+	return nil, ErrAPIKeyNotFound
+}
+
+func (r *memoryAPIKeyRepository) TouchAPIKey(ctx context.Context, id string, usedAt time.Time, interval time.Duration) error {
+	r.touched = append(r.touched, id)
+	return nil
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	first, err := generateAPIKey()
+	if err != nil {
+		t.Fatalf("generateAPIKey() error = %v", err)
+	}
+	second, err := generateAPIKey()
+	if err != nil {
+		t.Fatalf("generateAPIKey() error = %v", err)
+	}
+
+	if !strings.HasPrefix(first, apiKeyPrefix) {
+		t.Errorf("generateAPIKey() = %q, want the %q prefix", first, apiKeyPrefix)
+	}
+	if first == second {
+		t.Error("generateAPIKey() returned the same key twice")
+	}
+}
+
+func TestAPIKeyVerifierVerify(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		key     *APIKey
+		present string
+		wantErr error
+	}{
+		{
+			name:    "valid key",
+			key:     &APIKey{ID: "key", Scopes: []string{PermEmployeeRead, PermLeaveRead}},
+			present: "hrk_valid",
+		},
+		{
+			name:    "valid key with a future expiry",
+			key:     &APIKey{ID: "key", Scopes: []string{PermEmployeeRead}, ExpiresAt: &future},
+			present: "hrk_valid",
+		},
+		{
+			name:    "revoked key",
+			key:     &APIKey{ID: "key", Scopes: []string{PermEmployeeRead}, RevokedAt: &past},
+			present: "hrk_valid",
+			wantErr: ErrAPIKeyRevoked,
+		},
+		{
+			name:    "expired key",
+			key:     &APIKey{ID: "key", Scopes: []string{PermEmployeeRead}, ExpiresAt: &past},
+			present: "hrk_valid",
+			wantErr: ErrAPIKeyExpired,
+		},
+		{
+			name:    "unknown key",
+			key:     &APIKey{ID: "key", Scopes: []string{PermEmployeeRead}},
+			present: "hrk_unknown",
+			wantErr: ErrAPIKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryAPIKeyRepository{keys: map[string]*APIKey{hashToken("hrk_valid"): tt.key}}
+			verifier := NewAPIKeyVerifier(repo, logger.NewLogger("panic", "text"))
+
+			claims, err := verifier.Verify(context.Background(), tt.present)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(repo.touched) != 0 {
+					t.Errorf("Verify() recorded the use of a rejected key")
+				}
+				return
+			}
+
+			if claims.Role != RoleService || claims.TokenType != TokenTypeAPIKey || claims.UserID != tt.key.ID {
+				t.Errorf("Verify() claims = %+v, want role %s, token type %s and user %s", claims, RoleService, TokenTypeAPIKey, tt.key.ID)
+			}
+			if !slices.Equal(claims.Permissions, tt.key.Scopes) {
+				t.Errorf("Verify() permissions = %v, want the scopes %v", claims.Permissions, tt.key.Scopes)
+			}
+			if len(repo.touched) != 1 || repo.touched[0] != tt.key.ID {
+				t.Errorf("Verify() touched %v, want [%s]", repo.touched, tt.key.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package auth
+
+import (
+	"context"
+
+	authpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/auth"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Handler struct {
+	authpb.UnimplementedAuthServiceServer
+	service Service
+	logger  *logger.Logger
+}
+
+func NewHandler(service Service, logger *logger.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.HandlerLogger("auth"),
+	}
+}
+
+func (h *Handler) Login(ctx context.Context, req *authpb.LoginRequest) (*authpb.LoginResponse, error) {
+	h.logger.Info("Login called", "email", req.Email)
+
+	resp, err := h.service.Login(ctx, &LoginRequest{
+		Email:     req.Email,
+		Password:  req.Password,
+		IPAddress: PeerIP(ctx),
+		UserAgent: UserAgent(ctx),
+	})
+	if err != nil {
+		h.logger.Error("Failed to login", "email", req.Email, "error", err)
+		return nil, err
+	}
+
+	return resp.ToLoginProto(), nil
+}
+
+func (h *Handler) Logout(ctx context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
+	h.logger.Info("Logout called")
+
+	if err := h.service.Logout(ctx, req.AccessToken); err != nil {
+		h.logger.Error("Failed to logout", "error", err)
+		return nil, err
+	}
+
+	return &authpb.LogoutResponse{
+		Success: true,
+		Message: "Logged out successfully",
+	}, nil
+}
+
+func (h *Handler) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.RefreshTokenResponse, error) {
+	h.logger.Info("RefreshToken called")
+
+	resp, err := h.service.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		h.logger.Error("Failed to refresh token", "error", err)
+		return nil, err
+	}
+
+	return resp.ToRefreshProto(), nil
+}
+
+func (h *Handler) ValidateToken(ctx context.Context, req *authpb.ValidateTokenRequest) (*authpb.ValidateTokenResponse, error) {
+	h.logger.Info("ValidateToken called")
+
+	user, err := h.service.ValidateToken(ctx, req.AccessToken)
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return &authpb.ValidateTokenResponse{Valid: false}, nil
+		}
+		h.logger.Error("Failed to validate token", "error", err)
+		return nil, err
+	}
+
+	return &authpb.ValidateTokenResponse{
+		Valid: true,
+		User:  user.ToProto(),
+	}, nil
+}
+
+func (h *Handler) ChangePassword(ctx context.Context, req *authpb.ChangePasswordRequest) (*authpb.ChangePasswordResponse, error) {
+	h.logger.Info("ChangePassword called")
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	if err := h.service.ChangePassword(ctx, claims.UserID, &ChangePasswordRequest{
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		h.logger.Error("Failed to change password", "id", claims.UserID, "error", err)
+		return nil, err
+	}
+
+	return &authpb.ChangePasswordResponse{
+		Success: true,
+		Message: "Password changed successfully",
+	}, nil
+}
+
+func (h *Handler) RevokeEmployeeSessions(ctx context.Context, req *authpb.RevokeEmployeeSessionsRequest) (*authpb.RevokeEmployeeSessionsResponse, error) {
+	h.logger.Info("RevokeEmployeeSessions called", "employee_id", req.EmployeeId)
+
+	if err := h.service.RevokeEmployeeSessions(ctx, req.EmployeeId); err != nil {
+		h.logger.Error("Failed to revoke employee sessions", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return &authpb.RevokeEmployeeSessionsResponse{
+		Success: true,
+		Message: "Sessions revoked successfully",
+	}, nil
+}
+
+func (h *Handler) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+	h.logger.Info("UnlockAccount called", "employee_id", req.EmployeeId)
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	if err := h.service.UnlockAccount(ctx, req.EmployeeId, claims.UserID); err != nil {
+		h.logger.Error("Failed to unlock account", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return &authpb.UnlockAccountResponse{
+		Success: true,
+		Message: "Account unlocked successfully",
+	}, nil
+}
+
+func (h *Handler) EnrollMfa(ctx context.Context, req *authpb.EnrollMfaRequest) (*authpb.EnrollMfaResponse, error) {
+	h.logger.Info("EnrollMfa called")
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	resp, err := h.service.EnrollMFA(ctx, claims)
+	if err != nil {
+		h.logger.Error("Failed to enroll mfa", "id", claims.UserID, "error", err)
+		return nil, err
+	}
+
+	return &authpb.EnrollMfaResponse{
+		Secret:     resp.Secret,
+		OtpauthUrl: resp.OTPAuthURL,
+	}, nil
+}
+
+func (h *Handler) ConfirmMfaEnrollment(ctx context.Context, req *authpb.ConfirmMfaEnrollmentRequest) (*authpb.ConfirmMfaEnrollmentResponse, error) {
+	h.logger.Info("ConfirmMfaEnrollment called")
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	recoveryCodes, err := h.service.ConfirmMFAEnrollment(ctx, claims, req.Code)
+	if err != nil {
+		h.logger.Error("Failed to confirm mfa enrollment", "id", claims.UserID, "error", err)
+		return nil, err
+	}
+
+	return &authpb.ConfirmMfaEnrollmentResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (h *Handler) VerifyMfa(ctx context.Context, req *authpb.VerifyMfaRequest) (*authpb.LoginResponse, error) {
+	h.logger.Info("VerifyMfa called")
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	resp, err := h.service.VerifyMFA(ctx, claims, &VerifyMFARequest{
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+		IPAddress:    PeerIP(ctx),
+		UserAgent:    UserAgent(ctx),
+	})
+	if err != nil {
+		h.logger.Error("Failed to verify mfa", "id", claims.UserID, "error", err)
+		return nil, err
+	}
+
+	return resp.ToLoginProto(), nil
+}
+
+func (h *Handler) RequestPasswordReset(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+	h.logger.Info("RequestPasswordReset called", "email", req.Email)
+
+	if err := h.service.RequestPasswordReset(ctx, req.Email); err != nil {
+		h.logger.Error("Failed to request password reset", "email", req.Email, "error", err)
+		return nil, err
+	}
+
+	return &authpb.RequestPasswordResetResponse{
+		Success: true,
+		Message: "If the account exists, password reset instructions have been sent",
+	}, nil
+}
+
+func (h *Handler) ConfirmPasswordReset(ctx context.Context, req *authpb.ConfirmPasswordResetRequest) (*authpb.ConfirmPasswordResetResponse, error) {
+	h.logger.Info("ConfirmPasswordReset called")
+
+	if err := h.service.ConfirmPasswordReset(ctx, &ConfirmPasswordResetRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		h.logger.Error("Failed to reset password", "error", err)
+		return nil, err
+	}
+
+	return &authpb.ConfirmPasswordResetResponse{
+		Success: true,
+		Message: "Password reset successfully",
+	}, nil
+}
+
+func (h *Handler) CreateApiKey(ctx context.Context, req *authpb.CreateApiKeyRequest) (*authpb.CreateApiKeyResponse, error) {
+	h.logger.Info("CreateApiKey called", "name", req.Name)
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	createReq := &CreateAPIKeyRequest{
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		createReq.ExpiresAt = &expiresAt
+	}
+
+	resp, err := h.service.CreateAPIKey(ctx, createReq, claims.UserID)
+	if err != nil {
+		h.logger.Error("Failed to create api key", "name", req.Name, "error", err)
+		return nil, err
+	}
+
+	return &authpb.CreateApiKeyResponse{
+		ApiKey: resp.APIKey.ToProto(),
+		Key:    resp.Key,
+	}, nil
+}
+
+func (h *Handler) ListApiKeys(ctx context.Context, req *authpb.ListApiKeysRequest) (*authpb.ListApiKeysResponse, error) {
+	h.logger.Info("ListApiKeys called")
+
+	keys, err := h.service.ListAPIKeys(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list api keys", "error", err)
+		return nil, err
+	}
+
+	pbKeys := make([]*authpb.ApiKey, len(keys))
+	for i, key := range keys {
+		pbKeys[i] = key.ToProto()
+	}
+
+	return &authpb.ListApiKeysResponse{
+		ApiKeys: pbKeys,
+	}, nil
+}
+
+func (h *Handler) RevokeApiKey(ctx context.Context, req *authpb.RevokeApiKeyRequest) (*authpb.RevokeApiKeyResponse, error) {
+	h.logger.Info("RevokeApiKey called", "id", req.Id)
+
+	if err := h.service.RevokeAPIKey(ctx, req.Id); err != nil {
+		h.logger.Error("Failed to revoke api key", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &authpb.RevokeApiKeyResponse{
+		Success: true,
+		Message: "API key revoked successfully",
+	}, nil
+}
+
+func (h *Handler) ImpersonateEmployee(ctx context.Context, req *authpb.ImpersonateEmployeeRequest) (*authpb.ImpersonateEmployeeResponse, error) {
+	h.logger.Info("ImpersonateEmployee called", "employee_id", req.EmployeeId)
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	resp, err := h.service.ImpersonateEmployee(ctx, claims, &ImpersonateRequest{
+		EmployeeID: req.EmployeeId,
+		Reason:     req.Reason,
+		IPAddress:  PeerIP(ctx),
+	})
+	if err != nil {
+		h.logger.Error("Failed to impersonate employee", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return resp.ToImpersonateProto(), nil
+}
+
+func (h *Handler) ListSessions(ctx context.Context, req *authpb.ListSessionsRequest) (*authpb.ListSessionsResponse, error) {
+	h.logger.Info("ListSessions called", "employee_id", req.EmployeeId)
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	sessions, err := h.service.ListSessions(ctx, req.EmployeeId)
+	if err != nil {
+		h.logger.Error("Failed to list sessions", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	pbSessions := make([]*authpb.Session, len(sessions))
+	for i, session := range sessions {
+		pbSessions[i] = session.ToProto(claims.SessionID)
+	}
+
+	return &authpb.ListSessionsResponse{
+		Sessions: pbSessions,
+	}, nil
+}
+
+func (h *Handler) RevokeSession(ctx context.Context, req *authpb.RevokeSessionRequest) (*authpb.RevokeSessionResponse, error) {
+	h.logger.Info("RevokeSession called", "session_id", req.SessionId)
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing authentication")
+	}
+
+	if err := h.service.RevokeSession(ctx, claims, req.SessionId); err != nil {
+		h.logger.Error("Failed to revoke session", "session_id", req.SessionId, "error", err)
+		return nil, err
+	}
+
+	return &authpb.RevokeSessionResponse{
+		Success: true,
+		Message: "Session revoked successfully",
+	}, nil
+}
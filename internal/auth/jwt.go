@@ -13,52 +13,92 @@ type Claims struct {
 	Email       string   `json:"email"`
 	Role        string   `json:"role"`
 	Permissions []string `json:"permissions"`
+	// SessionID is the refresh token family the access token was issued for
+	SessionID string `json:"sid,omitempty"`
+	// TokenType is empty for access tokens
+	TokenType string `json:"typ,omitempty"`
+	// Actor is the admin acting as the employee of the token, set on impersonation tokens only
+	Actor *Actor `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// Actor identifies who really makes the calls of an impersonation token (RFC 8693)
+type Actor struct {
+	UserID string `json:"sub"`
+	Email  string `json:"email"`
+	Role   string `json:"role"`
+}
+
+// TokenTypeMFA marks the short-lived token handed out after the password step
+// of a login that still needs the second factor
+const TokenTypeMFA = "mfa"
+
 type JWTService struct {
-	secretKey []byte
-	issuer    string
-	expiry    time.Duration
+	keys   *KeyStore
+	issuer string
+	expiry time.Duration
 }
 
-func NewJWTService(secretKey string, expiry time.Duration) *JWTService {
+func NewJWTService(keys *KeyStore, expiry time.Duration) *JWTService {
 	return &JWTService{
-		secretKey: []byte(secretKey),
-		issuer:    "hr-management-system",
-		expiry:    expiry,
+		keys:   keys,
+		issuer: "hr-management-system",
+		expiry: expiry,
 	}
 }
 
-func (j *JWTService) GenerateToken(userID, employeeID, email, role string, permissions []string) (string, error) {
+// Expiry returns the lifetime of the access tokens issued by the service
+func (j *JWTService) Expiry() time.Duration {
+	return j.expiry
+}
+
+// GenerateToken signs an access token for the given claims, the registered
+// claims are filled in by the service
+func (j *JWTService) GenerateToken(claims Claims) (string, error) {
+	claims.TokenType = ""
+	return j.sign(claims, j.expiry)
+}
+
+// GenerateImpersonationToken signs an access token of the employee in claims
+// on behalf of actor
+func (j *JWTService) GenerateImpersonationToken(claims Claims, actor *Actor, expiry time.Duration) (string, error) {
+	claims.TokenType = ""
+	claims.SessionID = ""
+	claims.Actor = actor
+	return j.sign(claims, expiry)
+}
+
+// GenerateMFAToken signs a token that only allows completing the second login factor
+func (j *JWTService) GenerateMFAToken(claims Claims, expiry time.Duration) (string, error) {
+	claims.TokenType = TokenTypeMFA
+	claims.Permissions = nil
+	return j.sign(claims, expiry)
+}
+
+func (j *JWTService) sign(claims Claims, expiry time.Duration) (string, error) {
 	now := time.Now()
 
-	claims := Claims{
-		UserID:      userID,
-		EmployeeID:  employeeID,
-		Email:       email,
-		Role:        role,
-		Permissions: permissions,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    j.issuer,
-			Subject:   userID,
-			IssuedAt:  jwt.NewNumericDate(now),
-			ExpiresAt: jwt.NewNumericDate(now.Add(j.expiry)),
-			NotBefore: jwt.NewNumericDate(now),
-		},
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        newUUID(),
+		Issuer:    j.issuer,
+		Subject:   claims.UserID,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+		NotBefore: jwt.NewNumericDate(now),
+	}
+
+	key, err := j.keys.signingKey()
+	if err != nil {
+		return "", err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(j.secretKey)
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+	return token.SignedString(key.private)
 }
 
 func (j *JWTService) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
-		}
-		return j.secretKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, j.keyFunc, jwt.WithValidMethods([]string{j.keys.Method()}))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -83,26 +123,24 @@ func (j *JWTService) ValidateToken(tokenString string) (*Claims, error) {
 	return nil, fmt.Errorf("invalid token")
 }
 
-func (j *JWTService) RefreshToken(tokenString string) (string, error) {
-	claims, err := j.ValidateToken(tokenString)
+// keyFunc resolves the verification key from the kid header of the token
+func (j *JWTService) keyFunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+
+	key, err := j.keys.verificationKey(kid)
 	if err != nil {
-		return "", fmt.Errorf("invalid token for refresh: %w", err)
+		return nil, err
 	}
 
-	return j.GenerateToken(
-		claims.UserID,
-		claims.EmployeeID,
-		claims.Email,
-		claims.Role,
-		claims.Permissions,
-	)
+	if t.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+	}
+	return key.public, nil
 }
 
 // GetClaims extracts claims from a token without validation (for middleware use)
 func (j *JWTService) GetClaims(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
-		return j.secretKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, j.keyFunc, jwt.WithoutClaimsValidation())
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signing methods supported by the key store
+const (
+	SigningMethodHS256 = "HS256"
+	SigningMethodRS256 = "RS256"
+	SigningMethodEdDSA = "EdDSA"
+)
+
+// signingKey is a single key of the key store
+type signingKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	private    any
+	public     any
+	activeFrom time.Time
+}
+
+// KeyStore holds the keys used to sign and verify access tokens. Keys are
+// selected by the kid header, so tokens signed with a retired key stay valid
+// as long as the key is still present in the store.
+type KeyStore struct {
+	method          jwt.SigningMethod
+	dir             string
+	activationDelay time.Duration
+
+	mu   sync.RWMutex
+	keys map[string]*signingKey
+}
+
+// NewHMACKeyStore returns a key store with a single shared HS256 secret
+func NewHMACKeyStore(secret string) *KeyStore {
+	sum := sha256.Sum256([]byte(secret))
+	key := &signingKey{
+		kid:     hex.EncodeToString(sum[:4]),
+		method:  jwt.SigningMethodHS256,
+		private: []byte(secret),
+		public:  []byte(secret),
+	}
+
+	return &KeyStore{
+		method: jwt.SigningMethodHS256,
+		keys:   map[string]*signingKey{key.kid: key},
+	}
+}
+
+// NewFileKeyStore loads every "<kid>.pem" private key of dir. A key starts
+// signing activationDelay after its file was written, so that verifiers can
+// fetch it from the JWKS document before the first token signed with it shows up.
+func NewFileKeyStore(dir, method string, activationDelay time.Duration) (*KeyStore, error) {
+	var signingMethod jwt.SigningMethod
+	switch method {
+	case SigningMethodRS256:
+		signingMethod = jwt.SigningMethodRS256
+	case SigningMethodEdDSA:
+		signingMethod = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported signing method for key files: %s", method)
+	}
+
+	store := &KeyStore{
+		method:          signingMethod,
+		dir:             dir,
+		activationDelay: activationDelay,
+	}
+	if err := store.Reload(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Reload reads the key directory again, keys whose file was removed are retired
+func (k *KeyStore) Reload() error {
+	if k.dir == "" {
+		return nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(k.dir, "*.pem"))
+	if err != nil {
+		return fmt.Errorf("failed to list key files: %w", err)
+	}
+
+	keys := make(map[string]*signingKey, len(paths))
+	for _, path := range paths {
+		key, err := k.loadKey(path)
+		if err != nil {
+			return err
+		}
+		keys[key.kid] = key
+	}
+
+	if len(keys) == 0 {
+		return fmt.Errorf("no signing keys found in %s", k.dir)
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.mu.Unlock()
+
+	return nil
+}
+
+// Watch reloads the key directory every interval until ctx is done
+func (k *KeyStore) Watch(ctx context.Context, interval time.Duration, log *logger.Logger) {
+	if k.dir == "" || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.Reload(); err != nil {
+				log.Error("Failed to reload signing keys, keeping current keys", "dir", k.dir, "error", err)
+				continue
+			}
+			if key, err := k.signingKey(); err == nil {
+				log.Debug("Signing keys reloaded", "active_kid", key.kid)
+			}
+		}
+	}
+}
+
+func (k *KeyStore) loadKey(path string) (*signingKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat key file %s: %w", path, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file %s: %w", path, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key file %s is not PEM encoded", path)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		rsaKey, rsaErr := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if rsaErr != nil {
+			return nil, fmt.Errorf("failed to parse key file %s: %w", path, err)
+		}
+		parsed = rsaKey
+	}
+
+	key := &signingKey{
+		kid:        strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+		method:     k.method,
+		private:    parsed,
+		activeFrom: info.ModTime().Add(k.activationDelay),
+	}
+
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.method != jwt.SigningMethodRS256 {
+			return nil, fmt.Errorf("key file %s holds an RSA key, expected %s", path, k.method.Alg())
+		}
+		key.public = &private.PublicKey
+	case ed25519.PrivateKey:
+		if k.method != jwt.SigningMethodEdDSA {
+			return nil, fmt.Errorf("key file %s holds an Ed25519 key, expected %s", path, k.method.Alg())
+		}
+		key.public = private.Public()
+	default:
+		return nil, fmt.Errorf("key file %s holds an unsupported key type %T", path, parsed)
+	}
+
+	return key, nil
+}
+
+// signingKey returns the most recently activated key. Right after the first
+// start none of the keys may be active yet, the oldest one is used then.
+func (k *KeyStore) signingKey() (*signingKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 {
+		return nil, fmt.Errorf("no signing keys available")
+	}
+
+	keys := make([]*signingKey, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].activeFrom.Equal(keys[j].activeFrom) {
+			return keys[i].kid < keys[j].kid
+		}
+		return keys[i].activeFrom.Before(keys[j].activeFrom)
+	})
+
+	now := time.Now()
+	active := keys[0]
+	for _, key := range keys[1:] {
+		if key.activeFrom.After(now) {
+			break
+		}
+		active = key
+	}
+
+	return active, nil
+}
+
+// verificationKey returns the key of the given kid, tokens without a kid are
+// checked against the current signing key
+func (k *KeyStore) verificationKey(kid string) (*signingKey, error) {
+	if kid == "" {
+		return k.signingKey()
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+// Method returns the signing algorithm of the store
+func (k *KeyStore) Method() string {
+	return k.method.Alg()
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/audit"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// LockoutPolicy configures login throttling
+type LockoutPolicy struct {
+	// MaxAccountFailures locks the account after that many failures within Window
+	MaxAccountFailures int
+	// MaxIPFailures locks the client IP after that many failures within Window
+	MaxIPFailures int
+	Window        time.Duration
+	LockDuration  time.Duration
+	// Each failure doubles the wait before the next attempt, starting at BaseDelay up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Lockout throttles login attempts per account and per client IP
+type Lockout struct {
+	store     LockoutStore
+	policy    LockoutPolicy
+	auditRepo audit.Repository
+	logger    *logger.Logger
+}
+
+func NewLockout(store LockoutStore, policy LockoutPolicy, auditRepo audit.Repository, logger *logger.Logger) *Lockout {
+	return &Lockout{
+		store:     store,
+		policy:    policy,
+		auditRepo: auditRepo,
+		logger:    logger.ServiceLogger("lockout"),
+	}
+}
+
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Check returns a ResourceExhausted error when the account or the client IP is
+// locked or has to wait before the next attempt
+func (l *Lockout) Check(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	var wait time.Duration
+
+	for _, key := range l.keys(email, ip) {
+		state, err := l.store.Get(ctx, key)
+		if err != nil {
+			// Failing open keeps logins possible while the store is unavailable
+			l.logger.Error("Failed to read lockout state", "key", key, "error", err)
+			continue
+		}
+
+		if state.LockedUntil != nil && state.LockedUntil.After(now) {
+			wait = max(wait, state.LockedUntil.Sub(now))
+			continue
+		}
+
+		if state.Failures > 0 && state.LastFailureAt != nil {
+			next := state.LastFailureAt.Add(l.delay(state.Failures))
+			if next.After(now) {
+				wait = max(wait, next.Sub(now))
+			}
+		}
+	}
+
+	if wait > 0 {
+		return throttledError(wait)
+	}
+	return nil
+}
+
+// Failure records a failed attempt and locks the account or IP once its limit is reached.
+// employeeID is nil when the email does not belong to an employee.
+func (l *Lockout) Failure(ctx context.Context, email, ip string, employeeID *string) {
+	if state := l.recordFailure(ctx, accountKey(email)); state != nil && state.Failures >= l.policy.MaxAccountFailures {
+		l.lock(ctx, state, &audit.Event{
+			Action:    audit.ActionAccountLocked,
+			SubjectID: employeeID,
+			IPAddress: ip,
+			Details:   map[string]any{"email": email, "failures": state.Failures},
+		})
+	}
+
+	if ip == "" {
+		return
+	}
+	if state := l.recordFailure(ctx, ipKey(ip)); state != nil && state.Failures >= l.policy.MaxIPFailures {
+		l.lock(ctx, state, &audit.Event{
+			Action:    audit.ActionIPLocked,
+			IPAddress: ip,
+			Details:   map[string]any{"failures": state.Failures},
+		})
+	}
+}
+
+// Success clears the failures of the account, the IP counter is kept so that
+// one valid account cannot be used to reset a password spraying client
+func (l *Lockout) Success(ctx context.Context, email string) {
+	if err := l.store.Reset(ctx, accountKey(email)); err != nil {
+		l.logger.Error("Failed to reset lockout state", "error", err)
+	}
+}
+
+// Unlock clears the failures and the lock of the account
+func (l *Lockout) Unlock(ctx context.Context, email string, employeeID, actorID string) error {
+	if err := l.store.Reset(ctx, accountKey(email)); err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+
+	l.record(ctx, &audit.Event{
+		Action:    audit.ActionAccountUnlocked,
+		ActorID:   &actorID,
+		SubjectID: &employeeID,
+	})
+	return nil
+}
+
+func (l *Lockout) keys(email, ip string) []string {
+	keys := []string{accountKey(email)}
+	if ip != "" {
+		keys = append(keys, ipKey(ip))
+	}
+	return keys
+}
+
+func (l *Lockout) recordFailure(ctx context.Context, key string) *LockoutState {
+	state, err := l.store.RecordFailure(ctx, key, l.policy.Window)
+	if err != nil {
+		l.logger.Error("Failed to record login failure", "key", key, "error", err)
+		return nil
+	}
+	return state
+}
+
+func (l *Lockout) lock(ctx context.Context, state *LockoutState, event *audit.Event) {
+	until := time.Now().Add(l.policy.LockDuration)
+	if err := l.store.Lock(ctx, state.Key, until); err != nil {
+		l.logger.Error("Failed to lock", "key", state.Key, "error", err)
+		return
+	}
+
+	l.logger.Warn("Login locked after repeated failures", "key", state.Key, "failures", state.Failures, "until", until)
+	event.Details["locked_until"] = until
+	l.record(ctx, event)
+}
+
+func (l *Lockout) record(ctx context.Context, event *audit.Event) {
+	if err := l.auditRepo.Create(ctx, event); err != nil {
+		l.logger.Error("Failed to write audit event", "action", event.Action, "error", err)
+	}
+}
+
+// delay returns the wait required after the given number of consecutive failures
+func (l *Lockout) delay(failures int) time.Duration {
+	if l.policy.BaseDelay <= 0 {
+		return 0
+	}
+	delay := float64(l.policy.BaseDelay) * math.Pow(2, float64(failures-1))
+	if delay > float64(l.policy.MaxDelay) {
+		return l.policy.MaxDelay
+	}
+	return time.Duration(delay)
+}
+
+func throttledError(wait time.Duration) error {
+	st := status.New(codes.ResourceExhausted, "Too many failed login attempts, try again later")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(wait.Round(time.Second)),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
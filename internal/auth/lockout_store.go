@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LockoutState is the failed login record of a single account or client IP
+type LockoutState struct {
+	Key           string `gorm:"primaryKey"`
+	Failures      int    `gorm:"not null;default:0"`
+	LastFailureAt *time.Time
+	LockedUntil   *time.Time
+	UpdatedAt     time.Time
+}
+
+func (LockoutState) TableName() string {
+	return "login_throttles"
+}
+
+// LockoutStore keeps the failed login counters
+type LockoutStore interface {
+	Get(ctx context.Context, key string) (*LockoutState, error)
+	// RecordFailure counts a failed attempt, failures older than window are forgotten
+	RecordFailure(ctx context.Context, key string, window time.Duration) (*LockoutState, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+}
+
+type memoryLockoutStore struct {
+	mu        sync.Mutex
+	states    map[string]*LockoutState
+	lastPrune time.Time
+}
+
+// NewMemoryLockoutStore returns a lockout store for a single node
+func NewMemoryLockoutStore() LockoutStore {
+	return &memoryLockoutStore{
+		states:    make(map[string]*LockoutState),
+		lastPrune: time.Now(),
+	}
+}
+
+func (m *memoryLockoutStore) Get(ctx context.Context, key string) (*LockoutState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.states[key]
+	if !ok {
+		return &LockoutState{Key: key}, nil
+	}
+	copied := *state
+	return &copied, nil
+}
+
+func (m *memoryLockoutStore) RecordFailure(ctx context.Context, key string, window time.Duration) (*LockoutState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.prune(now, window)
+
+	state, ok := m.states[key]
+	if !ok {
+		state = &LockoutState{Key: key}
+		m.states[key] = state
+	}
+	if state.LastFailureAt != nil && now.Sub(*state.LastFailureAt) > window {
+		state.Failures = 0
+	}
+	state.Failures++
+	state.LastFailureAt = &now
+	state.UpdatedAt = now
+
+	copied := *state
+	return &copied, nil
+}
+
+func (m *memoryLockoutStore) Lock(ctx context.Context, key string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.states[key]
+	if !ok {
+		state = &LockoutState{Key: key}
+		m.states[key] = state
+	}
+	state.LockedUntil = &until
+	state.UpdatedAt = time.Now()
+	return nil
+}
+
+func (m *memoryLockoutStore) Reset(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.states, key)
+	return nil
+}
+
+// prune forgets keys that are neither locked nor failed recently, at most once
+// per window. The caller must hold the lock.
+func (m *memoryLockoutStore) prune(now time.Time, window time.Duration) {
+	if now.Sub(m.lastPrune) < window {
+		return
+	}
+	m.lastPrune = now
+
+	for key, state := range m.states {
+		locked := state.LockedUntil != nil && state.LockedUntil.After(now)
+		recent := state.LastFailureAt != nil && now.Sub(*state.LastFailureAt) <= window
+		if !locked && !recent {
+			delete(m.states, key)
+		}
+	}
+}
+
+type postgresLockoutStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresLockoutStore returns a lockout store shared by every node using the database
+func NewPostgresLockoutStore(db *gorm.DB) LockoutStore {
+	return &postgresLockoutStore{db: db}
+}
+
+func (p *postgresLockoutStore) Get(ctx context.Context, key string) (*LockoutState, error) {
+	var state LockoutState
+	err := p.db.WithContext(ctx).Where("key = ?", key).First(&state).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &LockoutState{Key: key}, nil
+		}
+		return nil, fmt.Errorf("failed to get lockout state: %w", err)
+	}
+	return &state, nil
+}
+
+func (p *postgresLockoutStore) RecordFailure(ctx context.Context, key string, window time.Duration) (*LockoutState, error) {
+	now := time.Now()
+
+	var state LockoutState
+	err := p.db.WithContext(ctx).Raw(`
+		INSERT INTO login_throttles (key, failures, last_failure_at)
+		VALUES (?, 1, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE
+				WHEN login_throttles.last_failure_at IS NULL OR login_throttles.last_failure_at < ? THEN 1
+				ELSE login_throttles.failures + 1
+			END,
+			last_failure_at = EXCLUDED.last_failure_at
+		RETURNING key, failures, last_failure_at, locked_until, updated_at`,
+		key, now, now.Add(-window),
+	).Scan(&state).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to record login failure: %w", err)
+	}
+	return &state, nil
+}
+
+func (p *postgresLockoutStore) Lock(ctx context.Context, key string, until time.Time) error {
+	if err := p.db.WithContext(ctx).Model(&LockoutState{}).
+		Where("key = ?", key).
+		Update("locked_until", until).Error; err != nil {
+		return fmt.Errorf("failed to lock %s: %w", key, err)
+	}
+	return nil
+}
+
+func (p *postgresLockoutStore) Reset(ctx context.Context, key string) error {
+	if err := p.db.WithContext(ctx).Where("key = ?", key).Delete(&LockoutState{}).Error; err != nil {
+		return fmt.Errorf("failed to reset %s: %w", key, err)
+	}
+	return nil
+}
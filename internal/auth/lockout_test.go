@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/audit"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordingAuditRepository struct {
+	mu     sync.Mutex
+	events []*audit.Event
+}
+
+func (r *recordingAuditRepository) Create(ctx context.Context, event *audit.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *recordingAuditRepository) actions() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	actions := make([]string, len(r.events))
+	for i, event := range r.events {
+		actions[i] = event.Action
+	}
+	return actions
+}
+
+func newTestLockout(policy LockoutPolicy) (*Lockout, LockoutStore, *recordingAuditRepository) {
+	store := NewMemoryLockoutStore()
+	auditRepo := &recordingAuditRepository{}
+	return NewLockout(store, policy, auditRepo, logger.NewLogger("panic", "text")), store, auditRepo
+}
+
+func TestLockoutDelay(t *testing.T) {
+	lockout, _, _ := newTestLockout(LockoutPolicy{BaseDelay: time.Second, MaxDelay: 8 * time.Second})
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{failures: 1, want: time.Second},
+		{failures: 2, want: 2 * time.Second},
+		{failures: 3, want: 4 * time.Second},
+		{failures: 4, want: 8 * time.Second},
+		{failures: 5, want: 8 * time.Second},
+		{failures: 64, want: 8 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := lockout.delay(tt.failures); got != tt.want {
+			t.Errorf("delay(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+
+	disabled, _, _ := newTestLockout(LockoutPolicy{MaxDelay: time.Minute})
+	if got := disabled.delay(3); got != 0 {
+		t.Errorf("delay(3) without BaseDelay = %v, want 0", got)
+	}
+}
+
+func TestLockoutCheckWaitsForDelay(t *testing.T) {
+	ctx := context.Background()
+	lockout, _, _ := newTestLockout(LockoutPolicy{
+		MaxAccountFailures: 10,
+		MaxIPFailures:      10,
+		Window:             time.Hour,
+		BaseDelay:          time.Minute,
+		MaxDelay:           time.Hour,
+	})
+
+	lockout.Failure(ctx, "jane@example.com", "10.0.0.1", nil)
+	lockout.Failure(ctx, "jane@example.com", "10.0.0.1", nil)
+
+	err := lockout.Check(ctx, "jane@example.com", "10.0.0.2")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Check() error = %v, want ResourceExhausted", err)
+	}
+
+	var retry *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	if retry == nil {
+		t.Fatal("Check() error carries no RetryInfo")
+	}
+	if got := retry.RetryDelay.AsDuration(); got != 2*time.Minute {
+		t.Errorf("RetryDelay = %v, want 2m0s after two failures", got)
+	}
+}
+
+func TestLockoutLocks(t *testing.T) {
+	ctx := context.Background()
+	policy := LockoutPolicy{
+		MaxAccountFailures: 3,
+		MaxIPFailures:      5,
+		Window:             time.Hour,
+		LockDuration:       time.Hour,
+	}
+
+	t.Run("account after MaxAccountFailures", func(t *testing.T) {
+		lockout, _, auditRepo := newTestLockout(policy)
+		employeeID := "employee"
+
+		for i := 0; i < policy.MaxAccountFailures-1; i++ {
+			lockout.Failure(ctx, "jane@example.com", "10.0.0.1", &employeeID)
+		}
+		if err := lockout.Check(ctx, "jane@example.com", "10.0.0.1"); err != nil {
+			t.Fatalf("Check() before the limit error = %v", err)
+		}
+
+		lockout.Failure(ctx, "Jane@Example.com ", "10.0.0.1", &employeeID)
+		if err := lockout.Check(ctx, "jane@example.com", "10.0.0.9"); status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("Check() from another IP error = %v, want ResourceExhausted", err)
+		}
+		if err := lockout.Check(ctx, "john@example.com", "10.0.0.1"); err != nil {
+			t.Errorf("Check() of another account error = %v, want nil", err)
+		}
+
+		if got := auditRepo.actions(); len(got) != 1 || got[0] != audit.ActionAccountLocked {
+			t.Fatalf("audit actions = %v, want [%s]", got, audit.ActionAccountLocked)
+		}
+		if subject := auditRepo.events[0].SubjectID; subject == nil || *subject != employeeID {
+			t.Errorf("audit subject = %v, want %s", subject, employeeID)
+		}
+	})
+
+	t.Run("ip after MaxIPFailures", func(t *testing.T) {
+		lockout, _, auditRepo := newTestLockout(policy)
+
+		emails := []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com", "e@example.com"}
+		for _, email := range emails {
+			lockout.Failure(ctx, email, "10.0.0.1", nil)
+		}
+
+		if err := lockout.Check(ctx, "new@example.com", "10.0.0.1"); status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("Check() from the locked IP error = %v, want ResourceExhausted", err)
+		}
+		if err := lockout.Check(ctx, "new@example.com", "10.0.0.2"); err != nil {
+			t.Errorf("Check() from another IP error = %v, want nil", err)
+		}
+		if got := auditRepo.actions(); len(got) != 1 || got[0] != audit.ActionIPLocked {
+			t.Errorf("audit actions = %v, want [%s]", got, audit.ActionIPLocked)
+		}
+	})
+}
+
+func TestLockoutSuccessKeepsIPCounter(t *testing.T) {
+	ctx := context.Background()
+	lockout, store, _ := newTestLockout(LockoutPolicy{
+		MaxAccountFailures: 10,
+		MaxIPFailures:      3,
+		Window:             time.Hour,
+		LockDuration:       time.Hour,
+	})
+
+	lockout.Failure(ctx, "jane@example.com", "10.0.0.1", nil)
+	lockout.Failure(ctx, "jane@example.com", "10.0.0.1", nil)
+	lockout.Success(ctx, "jane@example.com")
+
+	account, _ := store.Get(ctx, accountKey("jane@example.com"))
+	if account.Failures != 0 {
+		t.Errorf("account failures after success = %d, want 0", account.Failures)
+	}
+	ip, _ := store.Get(ctx, ipKey("10.0.0.1"))
+	if ip.Failures != 2 {
+		t.Errorf("ip failures after success = %d, want 2", ip.Failures)
+	}
+
+	lockout.Failure(ctx, "john@example.com", "10.0.0.1", nil)
+	if err := lockout.Check(ctx, "jane@example.com", "10.0.0.1"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Check() error = %v, want ResourceExhausted once the IP reaches its limit", err)
+	}
+}
+
+func TestLockoutUnlock(t *testing.T) {
+	ctx := context.Background()
+	lockout, _, auditRepo := newTestLockout(LockoutPolicy{
+		MaxAccountFailures: 1,
+		MaxIPFailures:      10,
+		Window:             time.Hour,
+		LockDuration:       time.Hour,
+	})
+
+	lockout.Failure(ctx, "jane@example.com", "", nil)
+	if err := lockout.Check(ctx, "jane@example.com", ""); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Check() error = %v, want ResourceExhausted", err)
+	}
+
+	if err := lockout.Unlock(ctx, "jane@example.com", "employee", "admin"); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+	if err := lockout.Check(ctx, "jane@example.com", ""); err != nil {
+		t.Errorf("Check() after unlock error = %v, want nil", err)
+	}
+
+	got := auditRepo.actions()
+	if len(got) != 2 || got[1] != audit.ActionAccountUnlocked {
+		t.Fatalf("audit actions = %v, want the lock followed by %s", got, audit.ActionAccountUnlocked)
+	}
+	event := auditRepo.events[1]
+	if event.ActorID == nil || *event.ActorID != "admin" || event.SubjectID == nil || *event.SubjectID != "employee" {
+		t.Errorf("unlock event actor %v subject %v, want admin and employee", event.ActorID, event.SubjectID)
+	}
+}
@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"time"
+
+	authpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/auth"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type LoginRequest struct {
+	Email     string `json:"email" validate:"required,email"`
+	Password  string `json:"password" validate:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+// RefreshToken is a stored refresh token, the token itself is only handed to the client
+type RefreshToken struct {
+	ID         string     `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID string     `json:"employee_id" gorm:"type:uuid;not null;index"`
+	FamilyID   string     `json:"family_id" gorm:"type:uuid;not null;index"`
+	TokenHash  string     `json:"-" gorm:"not null;uniqueIndex"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	ReplacedBy *string    `json:"replaced_by,omitempty" gorm:"type:uuid"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Session is a login of an employee on one device, it lives as long as its refresh token family
+type Session struct {
+	ID         string     `json:"id" gorm:"type:uuid;primaryKey"`
+	EmployeeID string     `json:"employee_id" gorm:"type:uuid;not null;index"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at" gorm:"not null"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// RevokedToken is an access token revoked before its expiry
+type RevokedToken struct {
+	JTI        string    `json:"jti" gorm:"column:jti;type:uuid;primaryKey"`
+	EmployeeID string    `json:"employee_id" gorm:"type:uuid;not null"`
+	ExpiresAt  time.Time `json:"expires_at" gorm:"not null;index"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// EmployeeTokenRevocation rejects every access token of the employee issued before RevokedBefore
+type EmployeeTokenRevocation struct {
+	EmployeeID    string    `json:"employee_id" gorm:"type:uuid;primaryKey"`
+	RevokedBefore time.Time `json:"revoked_before" gorm:"not null"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// MFAEnrollment is the TOTP enrollment of an employee, it is enabled once a first code was confirmed
+type MFAEnrollment struct {
+	EmployeeID      string     `json:"employee_id" gorm:"type:uuid;primaryKey"`
+	SecretEncrypted string     `json:"-" gorm:"not null"`
+	Enabled         bool       `json:"enabled" gorm:"not null;default:false"`
+	ConfirmedAt     *time.Time `json:"confirmed_at,omitempty"`
+	LastUsedStep    int64      `json:"-" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type MFARecoveryCode struct {
+	ID         string     `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID string     `json:"employee_id" gorm:"type:uuid;not null;index"`
+	CodeHash   string     `json:"-" gorm:"not null"`
+	UsedAt     *time.Time `json:"used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// PasswordResetToken is a single-use token mailed to an employee who forgot the password
+type PasswordResetToken struct {
+	ID         string     `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID string     `json:"employee_id" gorm:"type:uuid;not null;index"`
+	TokenHash  string     `json:"-" gorm:"not null;uniqueIndex"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null"`
+	UsedAt     *time.Time `json:"used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ConfirmPasswordResetRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+// APIKey is a long-lived credential of a machine-to-machine caller
+type APIKey struct {
+	ID         string     `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name       string     `json:"name" gorm:"not null"`
+	Prefix     string     `json:"prefix" gorm:"not null"`
+	KeyHash    string     `json:"-" gorm:"not null;uniqueIndex"`
+	Scopes     []string   `json:"scopes" gorm:"type:jsonb;serializer:json;not null"`
+	CreatedBy  *string    `json:"created_by,omitempty" gorm:"type:uuid"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKey *APIKey `json:"api_key"`
+	// Key is the plain key, it is only returned once
+	Key string `json:"key"`
+}
+
+type ImpersonateRequest struct {
+	EmployeeID string `json:"employee_id"`
+	Reason     string `json:"reason"`
+	IPAddress  string `json:"-"`
+}
+
+type MFAEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauth_url"`
+}
+
+type VerifyMFARequest struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+	IPAddress    string `json:"-"`
+	UserAgent    string `json:"-"`
+}
+
+type UserInfo struct {
+	ID          string   `json:"id"`
+	EmployeeID  string   `json:"employee_id"`
+	Email       string   `json:"email"`
+	FirstName   string   `json:"first_name"`
+	LastName    string   `json:"last_name"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+type TokenResponse struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	User         *UserInfo `json:"user,omitempty"`
+
+	// Set instead of the tokens when the password step needs a second factor
+	MFARequired           bool   `json:"mfa_required,omitempty"`
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required,omitempty"`
+	MFAToken              string `json:"mfa_token,omitempty"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+func (RevokedToken) TableName() string {
+	return "revoked_tokens"
+}
+
+func (EmployeeTokenRevocation) TableName() string {
+	return "employee_token_revocations"
+}
+
+func (MFAEnrollment) TableName() string {
+	return "employee_mfa"
+}
+
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (Session) TableName() string {
+	return "sessions"
+}
+
+func NewUserInfo(emp *employee.Employee, permissions []string) *UserInfo {
+	return &UserInfo{
+		ID:          emp.ID,
+		EmployeeID:  emp.EmployeeID,
+		Email:       emp.Email,
+		FirstName:   emp.FirstName,
+		LastName:    emp.LastName,
+		Role:        emp.Role,
+		Permissions: permissions,
+	}
+}
+
+func (u *UserInfo) ToProto() *authpb.UserInfo {
+	return &authpb.UserInfo{
+		Id:          u.ID,
+		EmployeeId:  u.EmployeeID,
+		Email:       u.Email,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		Role:        u.Role,
+		Permissions: u.Permissions,
+	}
+}
+
+func (t *TokenResponse) ToLoginProto() *authpb.LoginResponse {
+	resp := &authpb.LoginResponse{
+		AccessToken:           t.AccessToken,
+		RefreshToken:          t.RefreshToken,
+		ExpiresAt:             timestamppb.New(t.ExpiresAt),
+		MfaRequired:           t.MFARequired,
+		MfaEnrollmentRequired: t.MFAEnrollmentRequired,
+		MfaToken:              t.MFAToken,
+	}
+	if t.User != nil {
+		resp.User = t.User.ToProto()
+	}
+	return resp
+}
+
+func (t *TokenResponse) ToRefreshProto() *authpb.RefreshTokenResponse {
+	return &authpb.RefreshTokenResponse{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		ExpiresAt:    timestamppb.New(t.ExpiresAt),
+	}
+}
+
+func (k *APIKey) ToProto() *authpb.ApiKey {
+	pb := &authpb.ApiKey{
+		Id:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if k.CreatedBy != nil {
+		pb.CreatedBy = *k.CreatedBy
+	}
+	if k.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		pb.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	if k.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+	return pb
+}
+
+func (t *TokenResponse) ToImpersonateProto() *authpb.ImpersonateEmployeeResponse {
+	resp := &authpb.ImpersonateEmployeeResponse{
+		AccessToken: t.AccessToken,
+		ExpiresAt:   timestamppb.New(t.ExpiresAt),
+	}
+	if t.User != nil {
+		resp.User = t.User.ToProto()
+	}
+	return resp
+}
+
+func (s *Session) ToProto(currentSessionID string) *authpb.Session {
+	return &authpb.Session{
+		Id:         s.ID,
+		EmployeeId: s.EmployeeID,
+		UserAgent:  s.UserAgent,
+		IpAddress:  s.IPAddress,
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+		LastSeenAt: timestamppb.New(s.LastSeenAt),
+		ExpiresAt:  timestamppb.New(s.ExpiresAt),
+		Current:    s.ID == currentSessionID,
+	}
+}
@@ -0,0 +1,83 @@
+package auth
+
+import "slices"
+
+// Roles supported by the employees table.
+const (
+	RoleAdmin    = "ADMIN"
+	RoleHR       = "HR"
+	RoleManager  = "MANAGER"
+	RoleEmployee = "EMPLOYEE"
+	// RoleService is the role of callers authenticated with an API key, their
+	// permissions are the scopes of the key
+	RoleService = "SERVICE"
+)
+
+// Permissions carried in the token claims.
+const (
+	PermEmployeeRead     = "employee:read"
+	PermEmployeeWrite    = "employee:write"
+	PermEmployeeDelete   = "employee:delete"
+	PermDepartmentRead   = "department:read"
+	PermDepartmentWrite  = "department:write"
+	PermDepartmentDelete = "department:delete"
+	PermLeaveRead        = "leave:read"
+	PermLeaveWrite       = "leave:write"
+	PermLeaveApprove     = "leave:approve"
+	PermHolidayRead      = "holiday:read"
+	PermHolidayWrite     = "holiday:write"
+	PermPerformanceRead  = "performance:read"
+	PermPerformanceWrite = "performance:write"
+	PermPayrollRead      = "payroll:read"
+)
+
+var rolePermissions = map[string][]string{
+	RoleAdmin: {
+		PermEmployeeRead, PermEmployeeWrite, PermEmployeeDelete,
+		PermDepartmentRead, PermDepartmentWrite, PermDepartmentDelete,
+		PermLeaveRead, PermLeaveWrite, PermLeaveApprove,
+		PermHolidayRead, PermHolidayWrite,
+		PermPerformanceRead, PermPerformanceWrite,
+		PermPayrollRead,
+	},
+	RoleHR: {
+		PermEmployeeRead, PermEmployeeWrite, PermEmployeeDelete,
+		PermDepartmentRead, PermDepartmentWrite,
+		PermLeaveRead, PermLeaveWrite, PermLeaveApprove,
+		PermHolidayRead, PermHolidayWrite,
+		PermPerformanceRead, PermPerformanceWrite,
+		PermPayrollRead,
+	},
+	RoleManager: {
+		PermEmployeeRead,
+		PermDepartmentRead,
+		PermLeaveRead, PermLeaveWrite, PermLeaveApprove,
+		PermHolidayRead,
+		PermPerformanceRead, PermPerformanceWrite,
+	},
+	RoleEmployee: {
+		PermEmployeeRead,
+		PermDepartmentRead,
+		PermLeaveRead, PermLeaveWrite,
+		PermHolidayRead,
+		PermPerformanceRead,
+	},
+}
+
+// PermissionsForRole returns the permissions granted to a role
+func PermissionsForRole(role string) []string {
+	permissions := rolePermissions[role]
+	result := make([]string, len(permissions))
+	copy(result, permissions)
+	return result
+}
+
+// IsPermission reports whether p is a permission known to the system
+func IsPermission(p string) bool {
+	for _, permissions := range rolePermissions {
+		if slices.Contains(permissions, p) {
+			return true
+		}
+	}
+	return false
+}
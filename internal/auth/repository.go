@@ -0,0 +1,407 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenExpired  = errors.New("refresh token expired")
+	ErrRefreshTokenRevoked  = errors.New("refresh token revoked")
+	ErrRefreshTokenReused   = errors.New("refresh token reused")
+	ErrMFANotEnrolled       = errors.New("mfa not enrolled")
+	ErrMFAStepUsed          = errors.New("mfa code already used")
+	ErrResetTokenNotFound   = errors.New("password reset token not found")
+	ErrResetTokenExpired    = errors.New("password reset token expired")
+	ErrResetTokenUsed       = errors.New("password reset token already used")
+	ErrAPIKeyNotFound       = errors.New("api key not found")
+	ErrSessionNotFound      = errors.New("session not found")
+)
+
+type Repository interface {
+	CreateSession(ctx context.Context, session *Session, token *RefreshToken) error
+	RotateRefreshToken(ctx context.Context, tokenHash string, next *RefreshToken) error
+	RevokeTokenFamily(ctx context.Context, familyID string) error
+	RevokeEmployeeTokens(ctx context.Context, employeeID string) error
+
+	GetMFA(ctx context.Context, employeeID string) (*MFAEnrollment, error)
+	SaveMFAEnrollment(ctx context.Context, enrollment *MFAEnrollment) error
+	ActivateMFA(ctx context.Context, employeeID string, step int64, codeHashes []string) error
+	RecordMFAStep(ctx context.Context, employeeID string, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, employeeID, codeHash string) (bool, error)
+
+	CreatePasswordResetToken(ctx context.Context, token *PasswordResetToken) error
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+	ResetPassword(ctx context.Context, tokenID, employeeID, passwordHash string) error
+
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+	ListAPIKeys(ctx context.Context) ([]*APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) error
+	TouchAPIKey(ctx context.Context, id string, usedAt time.Time, interval time.Duration) error
+
+	GetSession(ctx context.Context, id string) (*Session, error)
+	ListActiveSessions(ctx context.Context, employeeID string) ([]*Session, error)
+	TouchSession(ctx context.Context, id string, seenAt time.Time, interval time.Duration) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// CreateSession stores the session together with the first refresh token of its family
+func (r *repository) CreateSession(ctx context.Context, session *Session, token *RefreshToken) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		token.EmployeeID = session.EmployeeID
+		token.FamilyID = session.ID
+		if err := tx.Create(token).Error; err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+		return nil
+	})
+}
+
+// RotateRefreshToken replaces the token identified by tokenHash with next, which
+// joins the same family. Presenting a token that was already rotated revokes
+// the whole family and returns ErrRefreshTokenReused.
+func (r *repository) RotateRefreshToken(ctx context.Context, tokenHash string, next *RefreshToken) error {
+	reused := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current RefreshToken
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", tokenHash).
+			First(&current).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrRefreshTokenNotFound
+			}
+			return fmt.Errorf("failed to get refresh token: %w", err)
+		}
+
+		if current.RevokedAt != nil && current.ReplacedBy == nil {
+			return ErrRefreshTokenRevoked
+		}
+		if current.RevokedAt != nil {
+			reused = true
+			return revokeFamily(tx, current.FamilyID)
+		}
+
+		now := time.Now()
+		if current.ExpiresAt.Before(now) {
+			return ErrRefreshTokenExpired
+		}
+
+		next.EmployeeID = current.EmployeeID
+		next.FamilyID = current.FamilyID
+		if err := tx.Create(next).Error; err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+
+		if err := tx.Model(&current).Updates(map[string]any{
+			"revoked_at":  now,
+			"replaced_by": next.ID,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", err)
+		}
+
+		if err := tx.Model(&Session{}).Where("id = ?", current.FamilyID).Updates(map[string]any{
+			"last_seen_at": now,
+			"expires_at":   next.ExpiresAt,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update session: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if reused {
+		return ErrRefreshTokenReused
+	}
+	return nil
+}
+
+func (r *repository) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	return revokeFamily(r.db.WithContext(ctx), familyID)
+}
+
+func (r *repository) RevokeEmployeeTokens(ctx context.Context, employeeID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Model(&RefreshToken{}).
+			Where("employee_id = ? AND revoked_at IS NULL", employeeID).
+			Update("revoked_at", now).Error; err != nil {
+			return fmt.Errorf("failed to revoke refresh tokens of employee %s: %w", employeeID, err)
+		}
+		if err := tx.Model(&Session{}).
+			Where("employee_id = ? AND revoked_at IS NULL", employeeID).
+			Update("revoked_at", now).Error; err != nil {
+			return fmt.Errorf("failed to revoke sessions of employee %s: %w", employeeID, err)
+		}
+		return nil
+	})
+}
+
+func (r *repository) GetMFA(ctx context.Context, employeeID string) (*MFAEnrollment, error) {
+	var enrollment MFAEnrollment
+	if err := r.db.WithContext(ctx).Where("employee_id = ?", employeeID).First(&enrollment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMFANotEnrolled
+		}
+		return nil, fmt.Errorf("failed to get mfa enrollment: %w", err)
+	}
+	return &enrollment, nil
+}
+
+// SaveMFAEnrollment stores a pending enrollment. An enabled one is left untouched
+// so that a stolen session cannot replace the second factor of the account.
+func (r *repository) SaveMFAEnrollment(ctx context.Context, enrollment *MFAEnrollment) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "employee_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret_encrypted", "last_used_step", "updated_at"}),
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "employee_mfa.enabled = FALSE"}}},
+	}).Create(enrollment)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save mfa enrollment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("mfa already enabled for employee %s", enrollment.EmployeeID)
+	}
+	return nil
+}
+
+// ActivateMFA enables the pending enrollment, records the TOTP step of the code that
+// confirmed it the way RecordMFAStep does and replaces the recovery codes
+func (r *repository) ActivateMFA(ctx context.Context, employeeID string, step int64, codeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var enrollment MFAEnrollment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("employee_id = ? AND enabled = FALSE", employeeID).
+			First(&enrollment).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMFANotEnrolled
+			}
+			return fmt.Errorf("failed to lock mfa enrollment: %w", err)
+		}
+		if enrollment.LastUsedStep >= step {
+			return ErrMFAStepUsed
+		}
+
+		err := tx.Model(&enrollment).Updates(map[string]any{
+			"enabled":        true,
+			"confirmed_at":   time.Now(),
+			"last_used_step": step,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to activate mfa: %w", err)
+		}
+
+		if err := tx.Where("employee_id = ?", employeeID).Delete(&MFARecoveryCode{}).Error; err != nil {
+			return fmt.Errorf("failed to delete recovery codes: %w", err)
+		}
+
+		codes := make([]MFARecoveryCode, 0, len(codeHashes))
+		for _, hash := range codeHashes {
+			codes = append(codes, MFARecoveryCode{EmployeeID: employeeID, CodeHash: hash})
+		}
+		if err := tx.Create(&codes).Error; err != nil {
+			return fmt.Errorf("failed to create recovery codes: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// RecordMFAStep marks the TOTP time step as used and reports false when it
+// (or a later one) was used before, so that a code cannot be replayed
+func (r *repository) RecordMFAStep(ctx context.Context, employeeID string, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&MFAEnrollment{}).
+		Where("employee_id = ? AND enabled = TRUE AND last_used_step < ?", employeeID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to record mfa step: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UseRecoveryCode consumes an unused recovery code and reports whether one matched
+func (r *repository) UseRecoveryCode(ctx context.Context, employeeID, codeHash string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&MFARecoveryCode{}).
+		Where("employee_id = ? AND code_hash = ? AND used_at IS NULL", employeeID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CreatePasswordResetToken stores the token and invalidates the unused tokens
+// issued to the employee before, so that only the latest reset link works
+func (r *repository) CreatePasswordResetToken(ctx context.Context, token *PasswordResetToken) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&PasswordResetToken{}).
+			Where("employee_id = ? AND used_at IS NULL", token.EmployeeID).
+			Update("used_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to invalidate password reset tokens: %w", err)
+		}
+
+		if err := tx.Create(token).Error; err != nil {
+			return fmt.Errorf("failed to create password reset token: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetPasswordResetToken returns the token if it can still be used
+func (r *repository) GetPasswordResetToken(ctx context.Context, tokenHash string) (*PasswordResetToken, error) {
+	var token PasswordResetToken
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResetTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	if token.UsedAt != nil {
+		return nil, ErrResetTokenUsed
+	}
+	if token.ExpiresAt.Before(time.Now()) {
+		return nil, ErrResetTokenExpired
+	}
+	return &token, nil
+}
+
+// ResetPassword consumes the token and sets the new password of the employee in one
+// transaction, so that a failed update leaves the token usable. It fails with
+// ErrResetTokenUsed when a concurrent request consumed the token first.
+func (r *repository) ResetPassword(ctx context.Context, tokenID, employeeID, passwordHash string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PasswordResetToken{}).
+			Where("id = ? AND used_at IS NULL", tokenID).
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return fmt.Errorf("failed to use password reset token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrResetTokenUsed
+		}
+
+		return employee.SetPassword(tx, employeeID, passwordHash)
+	})
+}
+
+func (r *repository) CreateAPIKey(ctx context.Context, key *APIKey) error {
+	if err := r.db.WithContext(ctx).Create(key).Error; err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) ListAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	var keys []*APIKey
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&keys).Error; err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *repository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	var key APIKey
+	if err := r.db.WithContext(ctx).Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return &key, nil
+}
+
+func (r *repository) RevokeAPIKey(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Model(&APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke api key %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// TouchAPIKey records the use of the key, at most once per interval to keep
+// busy callers from writing on every request
+func (r *repository) TouchAPIKey(ctx context.Context, id string, usedAt time.Time, interval time.Duration) error {
+	if err := r.db.WithContext(ctx).Model(&APIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, usedAt.Add(-interval)).
+		Update("last_used_at", usedAt).Error; err != nil {
+		return fmt.Errorf("failed to update api key last use: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return &session, nil
+}
+
+// ListActiveSessions returns the sessions of the employee that are neither revoked nor expired
+func (r *repository) ListActiveSessions(ctx context.Context, employeeID string) ([]*Session, error) {
+	var sessions []*Session
+	if err := r.db.WithContext(ctx).
+		Where("employee_id = ? AND revoked_at IS NULL AND expires_at > ?", employeeID, time.Now()).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// TouchSession records activity on the session, at most once per interval
+func (r *repository) TouchSession(ctx context.Context, id string, seenAt time.Time, interval time.Duration) error {
+	if err := r.db.WithContext(ctx).Model(&Session{}).
+		Where("id = ? AND last_seen_at < ?", id, seenAt.Add(-interval)).
+		Update("last_seen_at", seenAt).Error; err != nil {
+		return fmt.Errorf("failed to update session last seen: %w", err)
+	}
+	return nil
+}
+
+// revokeFamily revokes the refresh tokens of the family and the session they belong to
+func revokeFamily(db *gorm.DB, familyID string) error {
+	now := time.Now()
+	if err := db.Model(&RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", now).Error; err != nil {
+		return fmt.Errorf("failed to revoke token family %s: %w", familyID, err)
+	}
+	if err := db.Model(&Session{}).
+		Where("id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", now).Error; err != nil {
+		return fmt.Errorf("failed to revoke session %s: %w", familyID, err)
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RevocationStore keeps track of access tokens that must be rejected before they expire
+type RevocationStore interface {
+	// RevokeToken revokes a single access token by its jti
+	RevokeToken(ctx context.Context, jti, employeeID string, expiresAt time.Time) error
+	// RevokeEmployee revokes every access token of the employee issued before the given time
+	RevokeEmployee(ctx context.Context, employeeID string, before time.Time) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	// RevokedBefore returns the zero time when the employee has no revocation
+	RevokedBefore(ctx context.Context, employeeID string) (time.Time, error)
+	// RevokeSession revokes every access token carrying the session id
+	RevokeSession(ctx context.Context, sessionID string) error
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+// IsRevoked reports whether the token described by claims has been revoked
+func IsRevoked(ctx context.Context, store RevocationStore, claims *Claims) (bool, error) {
+	if claims.ID != "" {
+		revoked, err := store.IsTokenRevoked(ctx, claims.ID)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+
+	if claims.SessionID != "" {
+		revoked, err := store.IsSessionRevoked(ctx, claims.SessionID)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+
+	revoked, err := revokedEmployee(ctx, store, claims.UserID, claims)
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	// Impersonation ends with the sessions of the admin as well
+	if claims.Actor != nil {
+		return revokedEmployee(ctx, store, claims.Actor.UserID, claims)
+	}
+	return false, nil
+}
+
+func revokedEmployee(ctx context.Context, store RevocationStore, employeeID string, claims *Claims) (bool, error) {
+	before, err := store.RevokedBefore(ctx, employeeID)
+	if err != nil {
+		return false, err
+	}
+	if before.IsZero() || claims.IssuedAt == nil {
+		return false, nil
+	}
+	// iat only has second precision, a token issued in the second of the revocation
+	// but after it must not count as issued before
+	return claims.IssuedAt.Time.Before(before.Truncate(time.Second)), nil
+}
+
+type postgresRevocationStore struct {
+	db *gorm.DB
+}
+
+func NewPostgresRevocationStore(db *gorm.DB) RevocationStore {
+	return &postgresRevocationStore{db: db}
+}
+
+func (p *postgresRevocationStore) RevokeToken(ctx context.Context, jti, employeeID string, expiresAt time.Time) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Entries are useless once the token expired on its own
+		if err := tx.Where("expires_at < ?", time.Now()).Delete(&RevokedToken{}).Error; err != nil {
+			return fmt.Errorf("failed to purge expired revocations: %w", err)
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&RevokedToken{
+			JTI:        jti,
+			EmployeeID: employeeID,
+			ExpiresAt:  expiresAt,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to revoke token %s: %w", jti, err)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (p *postgresRevocationStore) RevokeEmployee(ctx context.Context, employeeID string, before time.Time) error {
+	if err := p.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "employee_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"revoked_before"}),
+	}).Create(&EmployeeTokenRevocation{
+		EmployeeID:    employeeID,
+		RevokedBefore: before,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to revoke tokens of employee %s: %w", employeeID, err)
+	}
+	return nil
+}
+
+func (p *postgresRevocationStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var count int64
+	if err := p.db.WithContext(ctx).Model(&RevokedToken{}).Where("jti = ?", jti).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (p *postgresRevocationStore) RevokedBefore(ctx context.Context, employeeID string) (time.Time, error) {
+	var revocation EmployeeTokenRevocation
+	err := p.db.WithContext(ctx).Where("employee_id = ?", employeeID).First(&revocation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to check employee revocation: %w", err)
+	}
+	return revocation.RevokedBefore, nil
+}
+
+func (p *postgresRevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	if err := p.db.WithContext(ctx).Model(&Session{}).
+		Where("id = ? AND revoked_at IS NULL", sessionID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("failed to revoke session %s: %w", sessionID, err)
+	}
+	return nil
+}
+
+func (p *postgresRevocationStore) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	var count int64
+	if err := p.db.WithContext(ctx).Model(&Session{}).
+		Where("id = ? AND revoked_at IS NOT NULL", sessionID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check session revocation: %w", err)
+	}
+	return count > 0, nil
+}
+
+type cacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// cachedRevocationStore keeps lookups of the underlying store in memory for ttl.
+// Revocations made through this instance take effect immediately, revocations made
+// by other instances are picked up once the cached entry expires.
+type cachedRevocationStore struct {
+	store     RevocationStore
+	ttl       time.Duration
+	mu        sync.RWMutex
+	tokens    map[string]cacheEntry[bool]
+	employees map[string]cacheEntry[time.Time]
+	sessions  map[string]cacheEntry[bool]
+	lastPrune time.Time
+}
+
+func NewCachedRevocationStore(store RevocationStore, ttl time.Duration) RevocationStore {
+	return &cachedRevocationStore{
+		store:     store,
+		ttl:       ttl,
+		tokens:    make(map[string]cacheEntry[bool]),
+		employees: make(map[string]cacheEntry[time.Time]),
+		sessions:  make(map[string]cacheEntry[bool]),
+		lastPrune: time.Now(),
+	}
+}
+
+func (c *cachedRevocationStore) RevokeToken(ctx context.Context, jti, employeeID string, expiresAt time.Time) error {
+	if err := c.store.RevokeToken(ctx, jti, employeeID, expiresAt); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// A revoked token stays revoked, keep it until it expires
+	c.tokens[jti] = cacheEntry[bool]{value: true, expiresAt: expiresAt}
+	return nil
+}
+
+func (c *cachedRevocationStore) RevokeEmployee(ctx context.Context, employeeID string, before time.Time) error {
+	if err := c.store.RevokeEmployee(ctx, employeeID, before); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.employees[employeeID] = cacheEntry[time.Time]{value: before, expiresAt: time.Now().Add(c.ttl)}
+	return nil
+}
+
+func (c *cachedRevocationStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	now := time.Now()
+
+	c.mu.RLock()
+	entry, ok := c.tokens[jti]
+	c.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	revoked, err := c.store.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune(now)
+	c.tokens[jti] = cacheEntry[bool]{value: revoked, expiresAt: now.Add(c.ttl)}
+	return revoked, nil
+}
+
+func (c *cachedRevocationStore) RevokedBefore(ctx context.Context, employeeID string) (time.Time, error) {
+	now := time.Now()
+
+	c.mu.RLock()
+	entry, ok := c.employees[employeeID]
+	c.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	before, err := c.store.RevokedBefore(ctx, employeeID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune(now)
+	c.employees[employeeID] = cacheEntry[time.Time]{value: before, expiresAt: now.Add(c.ttl)}
+	return before, nil
+}
+
+func (c *cachedRevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	if err := c.store.RevokeSession(ctx, sessionID); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions[sessionID] = cacheEntry[bool]{value: true, expiresAt: time.Now().Add(c.ttl)}
+	return nil
+}
+
+func (c *cachedRevocationStore) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	now := time.Now()
+
+	c.mu.RLock()
+	entry, ok := c.sessions[sessionID]
+	c.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	revoked, err := c.store.IsSessionRevoked(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune(now)
+	c.sessions[sessionID] = cacheEntry[bool]{value: revoked, expiresAt: now.Add(c.ttl)}
+	return revoked, nil
+}
+
+// prune drops expired entries at most once per ttl, the caller must hold the lock
+func (c *cachedRevocationStore) prune(now time.Time) {
+	if now.Sub(c.lastPrune) < c.ttl {
+		return
+	}
+	c.lastPrune = now
+
+	for jti, entry := range c.tokens {
+		if !now.Before(entry.expiresAt) {
+			delete(c.tokens, jti)
+		}
+	}
+	for employeeID, entry := range c.employees {
+		if !now.Before(entry.expiresAt) {
+			delete(c.employees, employeeID)
+		}
+	}
+	for sessionID, entry := range c.sessions {
+		if !now.Before(entry.expiresAt) {
+			delete(c.sessions, sessionID)
+		}
+	}
+}
@@ -0,0 +1,931 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/audit"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"github.com/dmehra2102/hr-management-system/internal/notify"
+	"github.com/dmehra2102/hr-management-system/internal/password"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dummyHash is compared against when the email is unknown so that failed
+// logins take the same time whether or not the account exists.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("hr-management-system"), bcrypt.DefaultCost)
+
+type Service interface {
+	Login(ctx context.Context, req *LoginRequest) (*TokenResponse, error)
+	Logout(ctx context.Context, accessToken string) error
+	RefreshToken(ctx context.Context, refreshToken string) (*TokenResponse, error)
+	ValidateToken(ctx context.Context, accessToken string) (*UserInfo, error)
+	ChangePassword(ctx context.Context, userID string, req *ChangePasswordRequest) error
+	RevokeEmployeeSessions(ctx context.Context, employeeID string) error
+	UnlockAccount(ctx context.Context, employeeID, actorID string) error
+	EnrollMFA(ctx context.Context, claims *Claims) (*MFAEnrollmentResponse, error)
+	ConfirmMFAEnrollment(ctx context.Context, claims *Claims, code string) ([]string, error)
+	VerifyMFA(ctx context.Context, claims *Claims, req *VerifyMFARequest) (*TokenResponse, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetRequest) error
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, actorID string) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context) ([]*APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) error
+	ImpersonateEmployee(ctx context.Context, claims *Claims, req *ImpersonateRequest) (*TokenResponse, error)
+	ListSessions(ctx context.Context, employeeID string) ([]*Session, error)
+	RevokeSession(ctx context.Context, claims *Claims, sessionID string) error
+}
+
+// PasswordResetPolicy configures the forgotten password flow
+type PasswordResetPolicy struct {
+	TokenExpiry time.Duration
+	// URL is the page that accepts the token, it is sent with the token appended
+	// as the token query parameter. The bare token is sent when empty.
+	URL string
+}
+
+type service struct {
+	jwtService          *JWTService
+	repo                Repository
+	revocations         RevocationStore
+	lockout             *Lockout
+	passwords           *password.Policy
+	mfaPolicy           MFAPolicy
+	secretBox           *SecretBox
+	resetPolicy         PasswordResetPolicy
+	notifier            notify.Notifier
+	auditRepo           audit.Repository
+	employeeRepo        employee.Repository
+	refreshTokenExpiry  time.Duration
+	impersonationExpiry time.Duration
+	logger              *logger.Logger
+}
+
+func NewService(jwtService *JWTService, repo Repository, revocations RevocationStore, lockout *Lockout, passwords *password.Policy, mfaPolicy MFAPolicy, secretBox *SecretBox, resetPolicy PasswordResetPolicy, notifier notify.Notifier, auditRepo audit.Repository, employeeRepo employee.Repository, refreshTokenExpiry, impersonationExpiry time.Duration, logger *logger.Logger) Service {
+	return &service{
+		jwtService:          jwtService,
+		repo:                repo,
+		revocations:         revocations,
+		lockout:             lockout,
+		passwords:           passwords,
+		mfaPolicy:           mfaPolicy,
+		secretBox:           secretBox,
+		resetPolicy:         resetPolicy,
+		notifier:            notifier,
+		auditRepo:           auditRepo,
+		employeeRepo:        employeeRepo,
+		refreshTokenExpiry:  refreshTokenExpiry,
+		impersonationExpiry: impersonationExpiry,
+		logger:              logger.ServiceLogger("auth"),
+	}
+}
+
+func (s *service) Login(ctx context.Context, req *LoginRequest) (*TokenResponse, error) {
+	email := strings.TrimSpace(req.Email)
+	s.logger.Info("Login attempt", "email", email)
+
+	if email == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "Email and password are required")
+	}
+
+	if err := s.lockout.Check(ctx, email, req.IPAddress); err != nil {
+		s.logger.Warn("Login throttled", "email", email, "ip", req.IPAddress)
+		return nil, err
+	}
+
+	emp, err := s.employeeRepo.GetByEmail(ctx, email)
+	if err != nil || emp.PasswordHash == nil {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(req.Password))
+		s.logger.Warn("Login failed: unknown account", "email", email, "ip", req.IPAddress)
+		s.lockout.Failure(ctx, email, req.IPAddress, nil)
+		return nil, status.Error(codes.Unauthenticated, "Invalid email or password")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(*emp.PasswordHash), []byte(req.Password)); err != nil {
+		s.logger.Warn("Login failed: wrong password", "id", emp.ID, "ip", req.IPAddress)
+		s.lockout.Failure(ctx, email, req.IPAddress, &emp.ID)
+		return nil, status.Error(codes.Unauthenticated, "Invalid email or password")
+	}
+	s.lockout.Success(ctx, email)
+
+	if !canLogin(emp) {
+		s.logger.Warn("Login rejected for inactive employee", "id", emp.ID, "status", emp.Status)
+		return nil, status.Error(codes.PermissionDenied, "Account is not active")
+	}
+
+	enrollment, err := s.repo.GetMFA(ctx, emp.ID)
+	if err != nil && !errors.Is(err, ErrMFANotEnrolled) {
+		s.logger.Error("Failed to get mfa enrollment", "id", emp.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to login")
+	}
+	enabled := enrollment != nil && enrollment.Enabled
+
+	if enabled || s.mfaPolicy.Required(emp.Role) {
+		return s.mfaChallenge(emp, !enabled)
+	}
+
+	return s.completeLogin(ctx, emp, req.IPAddress, req.UserAgent)
+}
+
+// mfaChallenge returns the token that lets the client finish the login with
+// VerifyMfa, or enroll first when the role requires MFA and none is set up yet
+func (s *service) mfaChallenge(emp *employee.Employee, enrollmentRequired bool) (*TokenResponse, error) {
+	token, err := s.jwtService.GenerateMFAToken(Claims{
+		UserID:     emp.ID,
+		EmployeeID: emp.EmployeeID,
+		Email:      emp.Email,
+		Role:       emp.Role,
+	}, s.mfaPolicy.ChallengeExpiry)
+	if err != nil {
+		s.logger.Error("Failed to generate mfa token", "id", emp.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to generate token")
+	}
+
+	s.logger.Info("Login requires MFA", "id", emp.ID, "enrollment_required", enrollmentRequired)
+	return &TokenResponse{
+		ExpiresAt:             time.Now().Add(s.mfaPolicy.ChallengeExpiry),
+		MFARequired:           true,
+		MFAEnrollmentRequired: enrollmentRequired,
+		MFAToken:              token,
+	}, nil
+}
+
+func (s *service) completeLogin(ctx context.Context, emp *employee.Employee, ipAddress, userAgent string) (*TokenResponse, error) {
+	resp, err := s.startSession(ctx, emp, ipAddress, userAgent)
+	if err != nil {
+		s.logger.Error("Failed to generate token", "id", emp.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to generate token")
+	}
+
+	if err := s.employeeRepo.UpdateLastLogin(ctx, emp.ID, time.Now()); err != nil {
+		s.logger.Error("Failed to update last login", "id", emp.ID, "error", err)
+	}
+
+	s.logger.Info("Login successful", "id", emp.ID)
+	return resp, nil
+}
+
+func (s *service) Logout(ctx context.Context, accessToken string) error {
+	claims, err := s.jwtService.ValidateToken(accessToken)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "Invalid token")
+	}
+
+	if claims.ID != "" && claims.ExpiresAt != nil {
+		if err := s.revocations.RevokeToken(ctx, claims.ID, claims.UserID, claims.ExpiresAt.Time); err != nil {
+			s.logger.Error("Failed to revoke access token", "id", claims.UserID, "error", err)
+			return status.Error(codes.Internal, "Failed to logout")
+		}
+	}
+
+	if claims.SessionID != "" {
+		if err := s.endSession(ctx, claims.SessionID); err != nil {
+			s.logger.Error("Failed to end session", "id", claims.UserID, "error", err)
+			return status.Error(codes.Internal, "Failed to logout")
+		}
+	}
+
+	s.logger.Info("Logout", "id", claims.UserID, "session_id", claims.SessionID)
+	return nil
+}
+
+func (s *service) RefreshToken(ctx context.Context, refreshToken string) (*TokenResponse, error) {
+	if refreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "Refresh token is required")
+	}
+
+	token, err := generateOpaqueToken()
+	if err != nil {
+		s.logger.Error("Failed to generate refresh token", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to refresh token")
+	}
+
+	next := &RefreshToken{
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(s.refreshTokenExpiry),
+	}
+	if err := s.repo.RotateRefreshToken(ctx, hashToken(refreshToken), next); err != nil {
+		switch {
+		case errors.Is(err, ErrRefreshTokenReused):
+			s.logger.Warn("Refresh token reuse detected, token family revoked")
+			return nil, status.Error(codes.Unauthenticated, "Invalid refresh token")
+		case errors.Is(err, ErrRefreshTokenNotFound), errors.Is(err, ErrRefreshTokenExpired), errors.Is(err, ErrRefreshTokenRevoked):
+			s.logger.Warn("Refresh rejected", "error", err)
+			return nil, status.Error(codes.Unauthenticated, "Invalid refresh token")
+		default:
+			s.logger.Error("Failed to rotate refresh token", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to refresh token")
+		}
+	}
+
+	emp, err := s.employeeRepo.GetByID(ctx, next.EmployeeID)
+	if err != nil || !canLogin(emp) {
+		s.logger.Warn("Refresh rejected for inactive employee", "id", next.EmployeeID)
+		if err := s.repo.RevokeTokenFamily(ctx, next.FamilyID); err != nil {
+			s.logger.Error("Failed to revoke refresh tokens", "id", next.EmployeeID, "error", err)
+		}
+		return nil, status.Error(codes.PermissionDenied, "Account is not active")
+	}
+
+	resp, err := s.issueAccessToken(emp, next.FamilyID)
+	if err != nil {
+		s.logger.Error("Failed to refresh token", "id", emp.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to refresh token")
+	}
+	resp.RefreshToken = token
+
+	return resp, nil
+}
+
+func (s *service) ValidateToken(ctx context.Context, accessToken string) (*UserInfo, error) {
+	claims, err := s.jwtService.ValidateToken(accessToken)
+	if err != nil || claims.TokenType != "" {
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+
+	revoked, err := IsRevoked(ctx, s.revocations, claims)
+	if err != nil {
+		s.logger.Error("Failed to check token revocation", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to validate token")
+	}
+	if revoked {
+		return nil, status.Error(codes.Unauthenticated, "Token has been revoked")
+	}
+
+	emp, err := s.employeeRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+	if !canLogin(emp) {
+		return nil, status.Error(codes.Unauthenticated, "Account is not active")
+	}
+
+	return NewUserInfo(emp, claims.Permissions), nil
+}
+
+func (s *service) ChangePassword(ctx context.Context, userID string, req *ChangePasswordRequest) error {
+	s.logger.Info("Changing password", "id", userID)
+
+	if req.OldPassword == "" || req.NewPassword == "" {
+		return status.Error(codes.InvalidArgument, "Old and new password are required")
+	}
+	if req.OldPassword == req.NewPassword {
+		return status.Error(codes.InvalidArgument, "New password must differ from the old password")
+	}
+
+	emp, err := s.employeeRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get employee for password change", "id", userID, "error", err)
+		return status.Error(codes.NotFound, "Employee not found")
+	}
+
+	if emp.PasswordHash == nil || bcrypt.CompareHashAndPassword([]byte(*emp.PasswordHash), []byte(req.OldPassword)) != nil {
+		s.logger.Warn("Password change rejected: wrong password", "id", userID)
+		return status.Error(codes.InvalidArgument, "Old password is incorrect")
+	}
+
+	if err := s.checkNewPassword(ctx, userID, req.NewPassword); err != nil {
+		return err
+	}
+
+	hash, err := hashPassword(req.NewPassword)
+	if err != nil {
+		s.logger.Error("Failed to hash password", "error", err)
+		return status.Error(codes.Internal, "Failed to process password")
+	}
+
+	if err := s.employeeRepo.UpdatePassword(ctx, userID, hash); err != nil {
+		s.logger.Error("Failed to update password", "id", userID, "error", err)
+		return status.Error(codes.Internal, "Failed to change password")
+	}
+
+	// Existing sessions were opened with the old password
+	if err := s.RevokeEmployeeSessions(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke sessions after password change", "id", userID, "error", err)
+	}
+
+	s.logger.Info("Password changed successfully", "id", userID)
+	return nil
+}
+
+// RequestPasswordReset sends a reset token to the employee. It succeeds for
+// unknown emails too so that the RPC cannot be used to probe for accounts.
+func (s *service) RequestPasswordReset(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	s.logger.Info("Password reset requested", "email", email)
+
+	if email == "" {
+		return status.Error(codes.InvalidArgument, "Email is required")
+	}
+
+	emp, err := s.employeeRepo.GetByEmail(ctx, email)
+	if err != nil || !canLogin(emp) {
+		s.logger.Warn("Password reset requested for unknown or inactive account", "email", email)
+		return nil
+	}
+
+	token, err := generateOpaqueToken()
+	if err != nil {
+		s.logger.Error("Failed to generate password reset token", "error", err)
+		return status.Error(codes.Internal, "Failed to request password reset")
+	}
+
+	expiresAt := time.Now().Add(s.resetPolicy.TokenExpiry)
+	if err := s.repo.CreatePasswordResetToken(ctx, &PasswordResetToken{
+		EmployeeID: emp.ID,
+		TokenHash:  hashToken(token),
+		ExpiresAt:  expiresAt,
+	}); err != nil {
+		s.logger.Error("Failed to store password reset token", "id", emp.ID, "error", err)
+		return status.Error(codes.Internal, "Failed to request password reset")
+	}
+
+	if err := s.notifier.Send(ctx, &notify.Message{
+		To:      emp.Email,
+		Subject: "Password reset",
+		Body:    s.resetMessage(emp, token, expiresAt),
+	}); err != nil {
+		s.logger.Error("Failed to send password reset", "id", emp.ID, "error", err)
+		return status.Error(codes.Internal, "Failed to request password reset")
+	}
+
+	s.logger.Info("Password reset sent", "id", emp.ID)
+	return nil
+}
+
+func (s *service) resetMessage(emp *employee.Employee, token string, expiresAt time.Time) string {
+	link := token
+	if s.resetPolicy.URL != "" {
+		link = s.resetPolicy.URL + "?" + url.Values{"token": {token}}.Encode()
+	}
+
+	return fmt.Sprintf("Hello %s,\n\nUse the following to reset your password before %s:\n\n%s\n\nIf you did not ask for a password reset you can ignore this message.\n",
+		emp.FirstName, expiresAt.Format(time.RFC1123), link)
+}
+
+// ConfirmPasswordReset sets a new password with a token sent by RequestPasswordReset
+// and signs the employee out everywhere
+func (s *service) ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetRequest) error {
+	if req.Token == "" || req.NewPassword == "" {
+		return status.Error(codes.InvalidArgument, "Token and new password are required")
+	}
+
+	token, err := s.repo.GetPasswordResetToken(ctx, hashToken(req.Token))
+	if err != nil {
+		if errors.Is(err, ErrResetTokenNotFound) || errors.Is(err, ErrResetTokenExpired) || errors.Is(err, ErrResetTokenUsed) {
+			s.logger.Warn("Password reset rejected", "error", err)
+			return status.Error(codes.InvalidArgument, "Invalid or expired reset token")
+		}
+		s.logger.Error("Failed to get password reset token", "error", err)
+		return status.Error(codes.Internal, "Failed to reset password")
+	}
+
+	emp, err := s.employeeRepo.GetByID(ctx, token.EmployeeID)
+	if err != nil || !canLogin(emp) {
+		s.logger.Warn("Password reset rejected for inactive employee", "id", token.EmployeeID)
+		return status.Error(codes.InvalidArgument, "Invalid or expired reset token")
+	}
+
+	// A password rejected by the policy leaves the token usable for another attempt
+	if err := s.checkNewPassword(ctx, emp.ID, req.NewPassword); err != nil {
+		return err
+	}
+
+	hash, err := hashPassword(req.NewPassword)
+	if err != nil {
+		s.logger.Error("Failed to hash password", "error", err)
+		return status.Error(codes.Internal, "Failed to process password")
+	}
+
+	if err := s.repo.ResetPassword(ctx, token.ID, emp.ID, hash); err != nil {
+		if errors.Is(err, ErrResetTokenUsed) {
+			return status.Error(codes.InvalidArgument, "Invalid or expired reset token")
+		}
+		s.logger.Error("Failed to reset password", "id", emp.ID, "error", err)
+		return status.Error(codes.Internal, "Failed to reset password")
+	}
+
+	// Whoever knew the old password must not stay signed in, and the owner
+	// should not remain locked out
+	if err := s.RevokeEmployeeSessions(ctx, emp.ID); err != nil {
+		s.logger.Error("Failed to revoke sessions after password reset", "id", emp.ID, "error", err)
+	}
+	s.lockout.Success(ctx, emp.Email)
+
+	s.logger.Info("Password reset", "id", emp.ID)
+	return nil
+}
+
+// checkNewPassword applies the password policy, including reuse of recent passwords
+func (s *service) checkNewPassword(ctx context.Context, employeeID, newPassword string) error {
+	violations := s.passwords.Validate("new_password", newPassword)
+	if len(violations) == 0 {
+		history, err := s.employeeRepo.GetPasswordHistory(ctx, employeeID, s.passwords.HistorySize())
+		if err != nil {
+			s.logger.Error("Failed to get password history", "id", employeeID, "error", err)
+			return status.Error(codes.Internal, "Failed to process password")
+		}
+		if violation := s.passwords.CheckReuse("new_password", newPassword, history); violation != nil {
+			violations = append(violations, violation)
+		}
+	}
+	if len(violations) > 0 {
+		s.logger.Warn("Password rejected by policy", "id", employeeID, "violations", len(violations))
+		return password.InvalidArgument(violations)
+	}
+	return nil
+}
+
+// CreateAPIKey issues a key for a machine-to-machine caller. Its scopes are
+// permissions, the plain key is only returned here.
+func (s *service) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, actorID string) (*CreateAPIKeyResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	s.logger.Info("Creating API key", "name", name, "actor_id", actorID)
+
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Name is required")
+	}
+	if len(req.Scopes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "At least one scope is required")
+	}
+	for _, scope := range req.Scopes {
+		if !IsPermission(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown scope %q", scope)
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "Expiry must be in the future")
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		s.logger.Error("Failed to generate api key", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create API key")
+	}
+
+	apiKey := &APIKey{
+		Name:      name,
+		Prefix:    key[:apiKeyDisplayLength],
+		KeyHash:   hashToken(key),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+		CreatedBy: &actorID,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if err := s.repo.CreateAPIKey(ctx, apiKey); err != nil {
+		s.logger.Error("Failed to create api key", "name", name, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create API key")
+	}
+
+	s.logger.Info("API key created", "id", apiKey.ID, "prefix", apiKey.Prefix, "scopes", apiKey.Scopes)
+	return &CreateAPIKeyResponse{APIKey: apiKey, Key: key}, nil
+}
+
+func (s *service) ListAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	keys, err := s.repo.ListAPIKeys(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list api keys", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list API keys")
+	}
+	return keys, nil
+}
+
+func (s *service) RevokeAPIKey(ctx context.Context, id string) error {
+	s.logger.Info("Revoking API key", "id", id)
+
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "API key ID is required")
+	}
+
+	if err := s.repo.RevokeAPIKey(ctx, id); err != nil {
+		if errors.Is(err, ErrAPIKeyNotFound) {
+			return status.Error(codes.NotFound, "API key not found")
+		}
+		s.logger.Error("Failed to revoke api key", "id", id, "error", err)
+		return status.Error(codes.Internal, "Failed to revoke API key")
+	}
+
+	s.logger.Info("API key revoked", "id", id)
+	return nil
+}
+
+// ImpersonateEmployee issues a short-lived access token of the employee that
+// carries the calling admin as actor. The authorization layer only lets it
+// through to read operations.
+func (s *service) ImpersonateEmployee(ctx context.Context, claims *Claims, req *ImpersonateRequest) (*TokenResponse, error) {
+	s.logger.Info("Impersonating employee", "id", req.EmployeeID, "actor_id", claims.UserID)
+
+	if req.EmployeeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason is required")
+	}
+	if claims.Actor != nil {
+		return nil, status.Error(codes.PermissionDenied, "Cannot impersonate while impersonating")
+	}
+	if req.EmployeeID == claims.UserID {
+		return nil, status.Error(codes.InvalidArgument, "Cannot impersonate yourself")
+	}
+
+	emp, err := s.employeeRepo.GetByID(ctx, req.EmployeeID)
+	if err != nil {
+		s.logger.Error("Failed to get employee for impersonation", "id", req.EmployeeID, "error", err)
+		return nil, status.Error(codes.NotFound, "Employee not found")
+	}
+	if emp.Role == RoleAdmin {
+		return nil, status.Error(codes.PermissionDenied, "Administrators cannot be impersonated")
+	}
+	if !canLogin(emp) {
+		return nil, status.Error(codes.FailedPrecondition, "Account is not active")
+	}
+
+	permissions := PermissionsForRole(emp.Role)
+	token, err := s.jwtService.GenerateImpersonationToken(Claims{
+		UserID:      emp.ID,
+		EmployeeID:  emp.EmployeeID,
+		Email:       emp.Email,
+		Role:        emp.Role,
+		Permissions: permissions,
+	}, &Actor{
+		UserID: claims.UserID,
+		Email:  claims.Email,
+		Role:   claims.Role,
+	}, s.impersonationExpiry)
+	if err != nil {
+		s.logger.Error("Failed to generate impersonation token", "id", emp.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to generate token")
+	}
+
+	expiresAt := time.Now().Add(s.impersonationExpiry)
+	if err := s.auditRepo.Create(ctx, &audit.Event{
+		Action:    audit.ActionImpersonationStarted,
+		ActorID:   &claims.UserID,
+		SubjectID: &emp.ID,
+		IPAddress: req.IPAddress,
+		Details:   map[string]any{"reason": req.Reason, "expires_at": expiresAt},
+	}); err != nil {
+		// A token that cannot be accounted for is not handed out
+		s.logger.Error("Failed to audit impersonation", "id", emp.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to start impersonation")
+	}
+
+	s.logger.Warn("Impersonation started", "id", emp.ID, "actor_id", claims.UserID, "expires_at", expiresAt)
+	return &TokenResponse{
+		AccessToken: token,
+		ExpiresAt:   expiresAt,
+		User:        NewUserInfo(emp, permissions),
+	}, nil
+}
+
+// RevokeEmployeeSessions invalidates every access and refresh token issued to the employee so far
+func (s *service) RevokeEmployeeSessions(ctx context.Context, employeeID string) error {
+	s.logger.Info("Revoking employee sessions", "id", employeeID)
+
+	if employeeID == "" {
+		return status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+
+	// iat only has second precision, tokens issued later in this second, such as
+	// a login right after a password change, stay valid
+	if err := s.revocations.RevokeEmployee(ctx, employeeID, time.Now().Truncate(time.Second)); err != nil {
+		s.logger.Error("Failed to revoke access tokens", "id", employeeID, "error", err)
+		return status.Error(codes.Internal, "Failed to revoke sessions")
+	}
+
+	if err := s.repo.RevokeEmployeeTokens(ctx, employeeID); err != nil {
+		s.logger.Error("Failed to revoke refresh tokens", "id", employeeID, "error", err)
+		return status.Error(codes.Internal, "Failed to revoke sessions")
+	}
+
+	s.logger.Info("Employee sessions revoked", "id", employeeID)
+	return nil
+}
+
+// UnlockAccount lifts a login lock of the employee before it expires
+func (s *service) UnlockAccount(ctx context.Context, employeeID, actorID string) error {
+	s.logger.Info("Unlocking account", "id", employeeID, "actor_id", actorID)
+
+	if employeeID == "" {
+		return status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+
+	emp, err := s.employeeRepo.GetByID(ctx, employeeID)
+	if err != nil {
+		s.logger.Error("Failed to get employee for unlock", "id", employeeID, "error", err)
+		return status.Error(codes.NotFound, "Employee not found")
+	}
+
+	if err := s.lockout.Unlock(ctx, emp.Email, emp.ID, actorID); err != nil {
+		s.logger.Error("Failed to unlock account", "id", employeeID, "error", err)
+		return status.Error(codes.Internal, "Failed to unlock account")
+	}
+
+	s.logger.Info("Account unlocked", "id", employeeID, "actor_id", actorID)
+	return nil
+}
+
+// EnrollMFA generates a new TOTP secret for the caller. It only takes effect
+// once a code generated from it is confirmed.
+func (s *service) EnrollMFA(ctx context.Context, claims *Claims) (*MFAEnrollmentResponse, error) {
+	s.logger.Info("Enrolling MFA", "id", claims.UserID)
+
+	enrollment, err := s.repo.GetMFA(ctx, claims.UserID)
+	if err != nil && !errors.Is(err, ErrMFANotEnrolled) {
+		s.logger.Error("Failed to get mfa enrollment", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to enroll MFA")
+	}
+	if enrollment != nil && enrollment.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "MFA is already enabled")
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		s.logger.Error("Failed to generate mfa secret", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to enroll MFA")
+	}
+
+	sealed, err := s.secretBox.Seal(secret)
+	if err != nil {
+		s.logger.Error("Failed to encrypt mfa secret", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to enroll MFA")
+	}
+
+	if err := s.repo.SaveMFAEnrollment(ctx, &MFAEnrollment{
+		EmployeeID:      claims.UserID,
+		SecretEncrypted: sealed,
+	}); err != nil {
+		s.logger.Error("Failed to save mfa enrollment", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to enroll MFA")
+	}
+
+	return &MFAEnrollmentResponse{
+		Secret:     secret,
+		OTPAuthURL: totpURL(s.mfaPolicy.Issuer, claims.Email, secret),
+	}, nil
+}
+
+// ConfirmMFAEnrollment enables MFA once the caller proves the authenticator app
+// works and returns the recovery codes, which are never shown again
+func (s *service) ConfirmMFAEnrollment(ctx context.Context, claims *Claims, code string) ([]string, error) {
+	s.logger.Info("Confirming MFA enrollment", "id", claims.UserID)
+
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "Code is required")
+	}
+
+	enrollment, err := s.repo.GetMFA(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, ErrMFANotEnrolled) {
+			return nil, status.Error(codes.FailedPrecondition, "MFA enrollment has not been started")
+		}
+		s.logger.Error("Failed to get mfa enrollment", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to confirm MFA")
+	}
+	if enrollment.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "MFA is already enabled")
+	}
+
+	secret, err := s.secretBox.Open(enrollment.SecretEncrypted)
+	if err != nil {
+		s.logger.Error("Failed to decrypt mfa secret", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to confirm MFA")
+	}
+
+	step, ok := validateTOTP(secret, code, time.Now())
+	if !ok {
+		s.logger.Warn("MFA enrollment rejected: invalid code", "id", claims.UserID)
+		return nil, status.Error(codes.InvalidArgument, "Invalid code")
+	}
+
+	recoveryCodes, err := generateRecoveryCodes()
+	if err != nil {
+		s.logger.Error("Failed to generate recovery codes", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to confirm MFA")
+	}
+
+	hashes := make([]string, 0, len(recoveryCodes))
+	for _, c := range recoveryCodes {
+		hashes = append(hashes, hashToken(normalizeRecoveryCode(c)))
+	}
+
+	// The step is recorded so the code cannot be replayed against VerifyMfa
+	if err := s.repo.ActivateMFA(ctx, claims.UserID, step, hashes); err != nil {
+		if errors.Is(err, ErrMFAStepUsed) {
+			s.logger.Warn("MFA enrollment rejected: code already used", "id", claims.UserID)
+			return nil, status.Error(codes.InvalidArgument, "Invalid code")
+		}
+		if errors.Is(err, ErrMFANotEnrolled) {
+			return nil, status.Error(codes.FailedPrecondition, "MFA is already enabled")
+		}
+		s.logger.Error("Failed to activate mfa", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to confirm MFA")
+	}
+
+	s.logger.Info("MFA enabled", "id", claims.UserID)
+	return recoveryCodes, nil
+}
+
+// VerifyMFA completes a login with a TOTP or recovery code and the token
+// returned by the password step
+func (s *service) VerifyMFA(ctx context.Context, claims *Claims, req *VerifyMFARequest) (*TokenResponse, error) {
+	if claims.TokenType != TokenTypeMFA {
+		return nil, status.Error(codes.FailedPrecondition, "Login does not await MFA")
+	}
+	if req.Code == "" && req.RecoveryCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "Code or recovery code is required")
+	}
+
+	if err := s.lockout.Check(ctx, claims.Email, req.IPAddress); err != nil {
+		s.logger.Warn("MFA verification throttled", "id", claims.UserID, "ip", req.IPAddress)
+		return nil, err
+	}
+
+	enrollment, err := s.repo.GetMFA(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, ErrMFANotEnrolled) {
+			return nil, status.Error(codes.FailedPrecondition, "MFA is not enabled")
+		}
+		s.logger.Error("Failed to get mfa enrollment", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to verify MFA")
+	}
+	if !enrollment.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "MFA is not enabled")
+	}
+
+	ok, err := s.checkSecondFactor(ctx, enrollment, req)
+	if err != nil {
+		s.logger.Error("Failed to verify mfa", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to verify MFA")
+	}
+	if !ok {
+		s.logger.Warn("MFA verification failed", "id", claims.UserID, "ip", req.IPAddress)
+		s.lockout.Failure(ctx, claims.Email, req.IPAddress, &claims.UserID)
+		return nil, status.Error(codes.Unauthenticated, "Invalid code")
+	}
+	s.lockout.Success(ctx, claims.Email)
+
+	// The challenge token is single use
+	if err := s.revocations.RevokeToken(ctx, claims.ID, claims.UserID, claims.ExpiresAt.Time); err != nil {
+		s.logger.Error("Failed to revoke mfa token", "id", claims.UserID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to verify MFA")
+	}
+
+	emp, err := s.employeeRepo.GetByID(ctx, claims.UserID)
+	if err != nil || !canLogin(emp) {
+		return nil, status.Error(codes.PermissionDenied, "Account is not active")
+	}
+
+	return s.completeLogin(ctx, emp, req.IPAddress, req.UserAgent)
+}
+
+func (s *service) checkSecondFactor(ctx context.Context, enrollment *MFAEnrollment, req *VerifyMFARequest) (bool, error) {
+	if req.RecoveryCode != "" {
+		used, err := s.repo.UseRecoveryCode(ctx, enrollment.EmployeeID, hashToken(normalizeRecoveryCode(req.RecoveryCode)))
+		if used {
+			s.logger.Warn("Recovery code used", "id", enrollment.EmployeeID)
+		}
+		return used, err
+	}
+
+	secret, err := s.secretBox.Open(enrollment.SecretEncrypted)
+	if err != nil {
+		return false, err
+	}
+
+	step, ok := validateTOTP(secret, req.Code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	return s.repo.RecordMFAStep(ctx, enrollment.EmployeeID, step)
+}
+
+// ListSessions returns the signed in devices of the employee
+func (s *service) ListSessions(ctx context.Context, employeeID string) ([]*Session, error) {
+	if employeeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+
+	sessions, err := s.repo.ListActiveSessions(ctx, employeeID)
+	if err != nil {
+		s.logger.Error("Failed to list sessions", "id", employeeID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list sessions")
+	}
+	return sessions, nil
+}
+
+// RevokeSession signs a single device out. Employees may end their own
+// sessions, admins and HR any session.
+func (s *service) RevokeSession(ctx context.Context, claims *Claims, sessionID string) error {
+	s.logger.Info("Revoking session", "session_id", sessionID, "actor_id", claims.UserID)
+
+	if sessionID == "" {
+		return status.Error(codes.InvalidArgument, "Session ID is required")
+	}
+
+	session, err := s.repo.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return status.Error(codes.NotFound, "Session not found")
+		}
+		s.logger.Error("Failed to get session", "session_id", sessionID, "error", err)
+		return status.Error(codes.Internal, "Failed to revoke session")
+	}
+
+	if session.EmployeeID != claims.UserID && claims.Role != RoleAdmin && claims.Role != RoleHR {
+		// Not revealing whether the session exists
+		return status.Error(codes.NotFound, "Session not found")
+	}
+
+	if err := s.endSession(ctx, sessionID); err != nil {
+		s.logger.Error("Failed to revoke session", "session_id", sessionID, "error", err)
+		return status.Error(codes.Internal, "Failed to revoke session")
+	}
+
+	s.logger.Info("Session revoked", "session_id", sessionID, "id", session.EmployeeID, "actor_id", claims.UserID)
+	return nil
+}
+
+// endSession revokes the refresh tokens of the session and every access token issued for it
+func (s *service) endSession(ctx context.Context, sessionID string) error {
+	if err := s.repo.RevokeTokenFamily(ctx, sessionID); err != nil {
+		return err
+	}
+	return s.revocations.RevokeSession(ctx, sessionID)
+}
+
+// startSession records a new session of the employee and issues the first
+// token pair of its refresh token family
+func (s *service) startSession(ctx context.Context, emp *employee.Employee, ipAddress, userAgent string) (*TokenResponse, error) {
+	token, err := generateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &Session{
+		ID:         newUUID(),
+		EmployeeID: emp.ID,
+		UserAgent:  truncateUserAgent(userAgent),
+		IPAddress:  ipAddress,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(s.refreshTokenExpiry),
+	}
+	refreshToken := &RefreshToken{
+		TokenHash: hashToken(token),
+		ExpiresAt: session.ExpiresAt,
+	}
+	if err := s.repo.CreateSession(ctx, session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.issueAccessToken(emp, refreshToken.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	resp.RefreshToken = token
+
+	return resp, nil
+}
+
+func (s *service) issueAccessToken(emp *employee.Employee, sessionID string) (*TokenResponse, error) {
+	permissions := PermissionsForRole(emp.Role)
+
+	token, err := s.jwtService.GenerateToken(Claims{
+		UserID:      emp.ID,
+		EmployeeID:  emp.EmployeeID,
+		Email:       emp.Email,
+		Role:        emp.Role,
+		Permissions: permissions,
+		SessionID:   sessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenResponse{
+		AccessToken: token,
+		ExpiresAt:   time.Now().Add(s.jwtService.Expiry()),
+		User:        NewUserInfo(emp, permissions),
+	}, nil
+}
+
+// canLogin reports whether the employee status allows signing in
+func canLogin(emp *employee.Employee) bool {
+	return emp.Status == "ACTIVE" || emp.Status == "ON_LEAVE"
+}
+
+func hashPassword(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hashedPassword), nil
+}
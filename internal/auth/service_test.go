@@ -0,0 +1,596 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/audit"
+	"github.com/dmehra2102/hr-management-system/internal/database/dbtest"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"github.com/dmehra2102/hr-management-system/internal/notify"
+	"github.com/dmehra2102/hr-management-system/internal/password"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func newTestService(repo Repository, revocations RevocationStore, employeeRepo employee.Repository) *service {
+	log := logger.NewLogger("panic", "text")
+	lockout := NewLockout(NewMemoryLockoutStore(), LockoutPolicy{
+		MaxAccountFailures: 5,
+		MaxIPFailures:      20,
+		Window:             time.Hour,
+		LockDuration:       time.Hour,
+	}, &recordingAuditRepository{}, log)
+	passwords, _ := password.NewPolicy(password.Config{MinLength: 8, HistorySize: 3})
+	mfaPolicy := MFAPolicy{Issuer: "HR Test", ChallengeExpiry: 5 * time.Minute}
+	secretBox, _ := NewSecretBox(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	resetPolicy := PasswordResetPolicy{TokenExpiry: time.Hour}
+	return NewService(NewJWTService(NewHMACKeyStore("test-secret"), time.Minute), repo, revocations, lockout, passwords, mfaPolicy, secretBox, resetPolicy, notify.NewLogNotifier(log), &recordingAuditRepository{}, employeeRepo, time.Hour, 15*time.Minute, log).(*service)
+}
+
+type memoryRevocationStore struct {
+	mu        sync.Mutex
+	tokens    map[string]bool
+	employees map[string]time.Time
+	sessions  map[string]bool
+}
+
+func newMemoryRevocationStore() *memoryRevocationStore {
+	return &memoryRevocationStore{
+		tokens:    make(map[string]bool),
+		employees: make(map[string]time.Time),
+		sessions:  make(map[string]bool),
+	}
+}
+
+func (m *memoryRevocationStore) RevokeToken(ctx context.Context, jti, employeeID string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[jti] = true
+	return nil
+}
+
+func (m *memoryRevocationStore) RevokeEmployee(ctx context.Context, employeeID string, before time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.employees[employeeID] = before
+	return nil
+}
+
+func (m *memoryRevocationStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tokens[jti], nil
+}
+
+func (m *memoryRevocationStore) RevokedBefore(ctx context.Context, employeeID string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.employees[employeeID], nil
+}
+
+func (m *memoryRevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[sessionID] = true
+	return nil
+}
+
+func (m *memoryRevocationStore) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sessions[sessionID], nil
+}
+
+type stubEmployeeRepository struct {
+	employee.Repository
+	employees map[string]*employee.Employee
+}
+
+func (r *stubEmployeeRepository) GetByID(ctx context.Context, id string) (*employee.Employee, error) {
+	if emp, ok := r.employees[id]; ok {
+		return emp, nil
+	}
+	return nil, errors.New("employee not found")
+}
+
+func (r *stubEmployeeRepository) UpdateLastLogin(ctx context.Context, id string, lastLogin time.Time) error {
+	return nil
+}
+
+func (r *stubEmployeeRepository) GetPasswordHistory(ctx context.Context, id string, limit int) ([]string, error) {
+	return nil, nil
+}
+
+func createTestEmployee(t *testing.T, db *gorm.DB) *employee.Employee {
+	t.Helper()
+
+	id := newUUID()
+	emp := &employee.Employee{
+		EmployeeID: "EMP-" + id,
+		FirstName:  "Test",
+		LastName:   "Employee",
+		Email:      id + "@example.com",
+		HireDate:   time.Now(),
+		Status:     "ACTIVE",
+		Role:       RoleEmployee,
+	}
+	if err := employee.NewRepository(db).Create(context.Background(), emp); err != nil {
+		t.Fatalf("failed to create employee: %v", err)
+	}
+	return emp
+}
+
+func TestRefreshTokenRotationIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	svc := newTestService(repo, NewPostgresRevocationStore(db), employee.NewRepository(db))
+	emp := createTestEmployee(t, db)
+
+	first, err := svc.startSession(ctx, emp, "", "")
+	if err != nil {
+		t.Fatalf("startSession() error = %v", err)
+	}
+
+	second, err := svc.RefreshToken(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	if second.RefreshToken == "" || second.RefreshToken == first.RefreshToken {
+		t.Fatalf("RefreshToken() returned refresh token %q, want a new one", second.RefreshToken)
+	}
+	if second.AccessToken == "" {
+		t.Error("RefreshToken() returned no access token")
+	}
+
+	t.Run("replaying the rotated token revokes the family", func(t *testing.T) {
+		_, err := svc.RefreshToken(ctx, first.RefreshToken)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("RefreshToken(replayed) error = %v, want Unauthenticated", err)
+		}
+
+		var active int64
+		if err := db.Model(&RefreshToken{}).
+			Where("employee_id = ? AND revoked_at IS NULL", emp.ID).
+			Count(&active).Error; err != nil {
+			t.Fatalf("failed to count tokens: %v", err)
+		}
+		if active != 0 {
+			t.Errorf("%d tokens of the family are still active, want 0", active)
+		}
+
+		if _, err := svc.RefreshToken(ctx, second.RefreshToken); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("RefreshToken(latest after reuse) error = %v, want Unauthenticated", err)
+		}
+	})
+
+	t.Run("expired token is rejected", func(t *testing.T) {
+		expired := &RefreshToken{
+			TokenHash: hashToken("expired"),
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}
+		session := &Session{
+			ID:         newUUID(),
+			EmployeeID: emp.ID,
+			LastSeenAt: time.Now().Add(-time.Hour),
+			ExpiresAt:  expired.ExpiresAt,
+		}
+		if err := repo.CreateSession(ctx, session, expired); err != nil {
+			t.Fatalf("CreateSession() error = %v", err)
+		}
+
+		err := repo.RotateRefreshToken(ctx, expired.TokenHash, &RefreshToken{TokenHash: hashToken("next"), ExpiresAt: time.Now().Add(time.Hour)})
+		if !errors.Is(err, ErrRefreshTokenExpired) {
+			t.Errorf("RotateRefreshToken(expired) error = %v, want %v", err, ErrRefreshTokenExpired)
+		}
+		if _, err := svc.RefreshToken(ctx, "expired"); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("RefreshToken(expired) error = %v, want Unauthenticated", err)
+		}
+	})
+
+	t.Run("unknown token is rejected", func(t *testing.T) {
+		err := repo.RotateRefreshToken(ctx, hashToken("unknown"), &RefreshToken{TokenHash: hashToken("other"), ExpiresAt: time.Now().Add(time.Hour)})
+		if !errors.Is(err, ErrRefreshTokenNotFound) {
+			t.Errorf("RotateRefreshToken(unknown) error = %v, want %v", err, ErrRefreshTokenNotFound)
+		}
+	})
+}
+
+// memoryResetRepository keeps password reset tokens by hash. ResetPassword
+// leaves the token unused when the password update fails, as the transaction does.
+type memoryResetRepository struct {
+	Repository
+	tokens     map[string]*PasswordResetToken
+	passwords  map[string]string
+	failUpdate bool
+}
+
+func (r *memoryResetRepository) GetPasswordResetToken(ctx context.Context, tokenHash string) (*PasswordResetToken, error) {
+	token, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, ErrResetTokenNotFound
+	}
+	if token.UsedAt != nil {
+		return nil, ErrResetTokenUsed
+	}
+	if token.ExpiresAt.Before(time.Now()) {
+		return nil, ErrResetTokenExpired
+	}
+	copied := *token
+	return &copied, nil
+}
+
+func (r *memoryResetRepository) ResetPassword(ctx context.Context, tokenID, employeeID, passwordHash string) error {
+	for _, token := range r.tokens {
+		if token.ID != tokenID {
+			continue
+		}
+		if token.UsedAt != nil {
+			return ErrResetTokenUsed
+		}
+		if r.failUpdate {
+			return errors.New("failed to update password")
+		}
+		now := time.Now()
+		token.UsedAt = &now
+		r.passwords[employeeID] = passwordHash
+		return nil
+	}
+	return ErrResetTokenNotFound
+}
+
+func (r *memoryResetRepository) RevokeEmployeeTokens(ctx context.Context, employeeID string) error {
+	return nil
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	emp := &employee.Employee{ID: "employee", Email: "jane@example.com", Role: RoleEmployee, Status: "ACTIVE"}
+	employees := &stubEmployeeRepository{employees: map[string]*employee.Employee{emp.ID: emp}}
+	usedAt := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name       string
+		token      *PasswordResetToken
+		password   string
+		failUpdate bool
+		want       codes.Code
+		wantUsed   bool
+	}{
+		{
+			name:     "valid token",
+			token:    &PasswordResetToken{ExpiresAt: time.Now().Add(time.Hour)},
+			password: "new-password",
+			want:     codes.OK,
+			wantUsed: true,
+		},
+		{
+			name:     "token used before",
+			token:    &PasswordResetToken{ExpiresAt: time.Now().Add(time.Hour), UsedAt: &usedAt},
+			password: "new-password",
+			want:     codes.InvalidArgument,
+			wantUsed: true,
+		},
+		{
+			name:     "expired token",
+			token:    &PasswordResetToken{ExpiresAt: time.Now().Add(-time.Minute)},
+			password: "new-password",
+			want:     codes.InvalidArgument,
+		},
+		{
+			name:     "password rejected by the policy",
+			token:    &PasswordResetToken{ExpiresAt: time.Now().Add(time.Hour)},
+			password: "short",
+			want:     codes.InvalidArgument,
+		},
+		{
+			name:       "failed password update",
+			token:      &PasswordResetToken{ExpiresAt: time.Now().Add(time.Hour)},
+			password:   "new-password",
+			failUpdate: true,
+			want:       codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.token.ID = "token"
+			tt.token.EmployeeID = emp.ID
+			tt.token.TokenHash = hashToken("reset-token")
+			repo := &memoryResetRepository{
+				tokens:     map[string]*PasswordResetToken{tt.token.TokenHash: tt.token},
+				passwords:  make(map[string]string),
+				failUpdate: tt.failUpdate,
+			}
+			svc := newTestService(repo, newMemoryRevocationStore(), employees)
+
+			err := svc.ConfirmPasswordReset(context.Background(), &ConfirmPasswordResetRequest{Token: "reset-token", NewPassword: tt.password})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("ConfirmPasswordReset() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if used := tt.token.UsedAt != nil; used != tt.wantUsed {
+				t.Errorf("token used = %v, want %v", used, tt.wantUsed)
+			}
+			if _, changed := repo.passwords[emp.ID]; changed != (tt.want == codes.OK) {
+				t.Errorf("password changed = %v, want %v", changed, tt.want == codes.OK)
+			}
+		})
+	}
+}
+
+func TestConfirmPasswordResetIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	svc := newTestService(repo, NewPostgresRevocationStore(db), employee.NewRepository(db))
+	emp := createTestEmployee(t, db)
+
+	createToken := func(plain string) *PasswordResetToken {
+		t.Helper()
+		token := &PasswordResetToken{EmployeeID: emp.ID, TokenHash: hashToken(plain), ExpiresAt: time.Now().Add(time.Hour)}
+		if err := repo.CreatePasswordResetToken(ctx, token); err != nil {
+			t.Fatalf("CreatePasswordResetToken() error = %v", err)
+		}
+		return token
+	}
+
+	t.Run("token is single use", func(t *testing.T) {
+		createToken("reset-token")
+
+		req := &ConfirmPasswordResetRequest{Token: "reset-token", NewPassword: "new-password"}
+		if err := svc.ConfirmPasswordReset(ctx, req); err != nil {
+			t.Fatalf("ConfirmPasswordReset() error = %v", err)
+		}
+
+		var updated employee.Employee
+		if err := db.Where("id = ?", emp.ID).First(&updated).Error; err != nil {
+			t.Fatalf("failed to get employee: %v", err)
+		}
+		if updated.PasswordHash == nil || bcrypt.CompareHashAndPassword([]byte(*updated.PasswordHash), []byte("new-password")) != nil {
+			t.Error("ConfirmPasswordReset() did not set the new password")
+		}
+
+		req.NewPassword = "other-password"
+		if err := svc.ConfirmPasswordReset(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ConfirmPasswordReset() reusing the token error = %v, want InvalidArgument", err)
+		}
+	})
+
+	t.Run("failed update keeps the token", func(t *testing.T) {
+		token := createToken("retry-token")
+
+		// The column holds 255 characters, the update fails inside the transaction
+		if err := repo.ResetPassword(ctx, token.ID, emp.ID, strings.Repeat("x", 300)); err == nil {
+			t.Fatal("ResetPassword() with an oversized hash error = nil, want an error")
+		}
+		if _, err := repo.GetPasswordResetToken(ctx, token.TokenHash); err != nil {
+			t.Errorf("GetPasswordResetToken() after the failed update error = %v, want the token to stay usable", err)
+		}
+	})
+}
+
+func TestImpersonateEmployee(t *testing.T) {
+	employees := &stubEmployeeRepository{employees: map[string]*employee.Employee{
+		"employee": {ID: "employee", Email: "employee@example.com", Role: RoleEmployee, Status: "ACTIVE"},
+		"other":    {ID: "other", Email: "other@example.com", Role: RoleAdmin, Status: "ACTIVE"},
+		"inactive": {ID: "inactive", Email: "inactive@example.com", Role: RoleEmployee, Status: "TERMINATED"},
+	}}
+	admin := &Claims{UserID: "admin", Email: "admin@example.com", Role: RoleAdmin}
+
+	tests := []struct {
+		name   string
+		claims *Claims
+		req    *ImpersonateRequest
+		want   codes.Code
+	}{
+		{
+			name:   "admin impersonates an employee",
+			claims: admin,
+			req:    &ImpersonateRequest{EmployeeID: "employee", Reason: "support ticket"},
+			want:   codes.OK,
+		},
+		{
+			name:   "missing reason",
+			claims: admin,
+			req:    &ImpersonateRequest{EmployeeID: "employee", Reason: " "},
+			want:   codes.InvalidArgument,
+		},
+		{
+			name:   "impersonating while impersonating",
+			claims: &Claims{UserID: "employee", Role: RoleEmployee, Actor: &Actor{UserID: "admin"}},
+			req:    &ImpersonateRequest{EmployeeID: "inactive", Reason: "support ticket"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "impersonating an administrator",
+			claims: admin,
+			req:    &ImpersonateRequest{EmployeeID: "other", Reason: "support ticket"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "impersonating an inactive employee",
+			claims: admin,
+			req:    &ImpersonateRequest{EmployeeID: "inactive", Reason: "support ticket"},
+			want:   codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(nil, newMemoryRevocationStore(), employees)
+			auditRepo := svc.auditRepo.(*recordingAuditRepository)
+
+			resp, err := svc.ImpersonateEmployee(context.Background(), tt.claims, tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("ImpersonateEmployee() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if err != nil {
+				if len(auditRepo.events) != 0 {
+					t.Errorf("recorded %v for a refused impersonation", auditRepo.actions())
+				}
+				return
+			}
+
+			claims, err := svc.jwtService.ValidateToken(resp.AccessToken)
+			if err != nil {
+				t.Fatalf("ValidateToken() error = %v", err)
+			}
+			if claims.UserID != tt.req.EmployeeID || claims.Actor == nil || claims.Actor.UserID != tt.claims.UserID {
+				t.Errorf("token claims = %+v, want %s acting as %s", claims, tt.claims.UserID, tt.req.EmployeeID)
+			}
+			if resp.RefreshToken != "" {
+				t.Error("impersonation issued a refresh token")
+			}
+
+			if actions := auditRepo.actions(); len(actions) != 1 || actions[0] != audit.ActionImpersonationStarted {
+				t.Fatalf("audit actions = %v, want [%s]", actions, audit.ActionImpersonationStarted)
+			}
+			if reason := auditRepo.events[0].Details["reason"]; reason != tt.req.Reason {
+				t.Errorf("audited reason = %v, want %q", reason, tt.req.Reason)
+			}
+		})
+	}
+}
+
+type memorySessionRepository struct {
+	Repository
+	sessions        map[string]*Session
+	revokedFamilies []string
+}
+
+func (r *memorySessionRepository) GetSession(ctx context.Context, id string) (*Session, error) {
+	if session, ok := r.sessions[id]; ok {
+		return session, nil
+	}
+	return nil, ErrSessionNotFound
+}
+
+func (r *memorySessionRepository) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	r.revokedFamilies = append(r.revokedFamilies, familyID)
+	return nil
+}
+
+func TestRevokeSession(t *testing.T) {
+	tests := []struct {
+		name      string
+		claims    *Claims
+		sessionID string
+		want      codes.Code
+	}{
+		{
+			name:      "own session",
+			claims:    &Claims{UserID: "employee", Role: RoleEmployee},
+			sessionID: "session",
+			want:      codes.OK,
+		},
+		{
+			name:      "session of another employee",
+			claims:    &Claims{UserID: "other", Role: RoleEmployee},
+			sessionID: "session",
+			want:      codes.NotFound,
+		},
+		{
+			name:      "hr revokes the session of an employee",
+			claims:    &Claims{UserID: "hr", Role: RoleHR},
+			sessionID: "session",
+			want:      codes.OK,
+		},
+		{
+			name:      "unknown session",
+			claims:    &Claims{UserID: "employee", Role: RoleEmployee},
+			sessionID: "unknown",
+			want:      codes.NotFound,
+		},
+		{
+			name:   "missing session id",
+			claims: &Claims{UserID: "employee", Role: RoleEmployee},
+			want:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memorySessionRepository{sessions: map[string]*Session{
+				"session": {ID: "session", EmployeeID: "employee"},
+			}}
+			revocations := newMemoryRevocationStore()
+			svc := newTestService(repo, revocations, nil)
+
+			err := svc.RevokeSession(context.Background(), tt.claims, tt.sessionID)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("RevokeSession() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+
+			revoked, _ := revocations.IsSessionRevoked(context.Background(), "session")
+			if wantRevoked := tt.want == codes.OK; revoked != wantRevoked {
+				t.Errorf("access tokens of the session revoked = %v, want %v", revoked, wantRevoked)
+			}
+			if tt.want == codes.OK && (len(repo.revokedFamilies) != 1 || repo.revokedFamilies[0] != "session") {
+				t.Errorf("revoked refresh token families %v, want [session]", repo.revokedFamilies)
+			}
+			if tt.want != codes.OK && len(repo.revokedFamilies) != 0 {
+				t.Errorf("revoked refresh token families %v, want none", repo.revokedFamilies)
+			}
+		})
+	}
+}
+
+func TestRevokeSessionIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	revocations := NewPostgresRevocationStore(db)
+	svc := newTestService(NewRepository(db), revocations, employee.NewRepository(db))
+	emp := createTestEmployee(t, db)
+	owner := &Claims{UserID: emp.ID, Role: emp.Role}
+
+	revoked, err := svc.startSession(ctx, emp, "", "")
+	if err != nil {
+		t.Fatalf("startSession() error = %v", err)
+	}
+	kept, err := svc.startSession(ctx, emp, "", "")
+	if err != nil {
+		t.Fatalf("startSession() error = %v", err)
+	}
+	revokedClaims, err := svc.jwtService.ValidateToken(revoked.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	keptClaims, err := svc.jwtService.ValidateToken(kept.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+
+	if err := svc.RevokeSession(ctx, owner, revokedClaims.SessionID); err != nil {
+		t.Fatalf("RevokeSession() error = %v", err)
+	}
+
+	if isRevoked, err := IsRevoked(ctx, revocations, revokedClaims); err != nil || !isRevoked {
+		t.Errorf("IsRevoked(access token of the revoked session) = %v, %v, want true", isRevoked, err)
+	}
+	if _, err := svc.RefreshToken(ctx, revoked.RefreshToken); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("RefreshToken(revoked session) error = %v, want Unauthenticated", err)
+	}
+
+	if isRevoked, err := IsRevoked(ctx, revocations, keptClaims); err != nil || isRevoked {
+		t.Errorf("IsRevoked(access token of another session) = %v, %v, want false", isRevoked, err)
+	}
+	if _, err := svc.RefreshToken(ctx, kept.RefreshToken); err != nil {
+		t.Errorf("RefreshToken(other session) error = %v", err)
+	}
+
+	sessions, err := svc.ListSessions(ctx, emp.ID)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != keptClaims.SessionID {
+		t.Errorf("ListSessions() returned %d sessions, want only the one kept", len(sessions))
+	}
+}
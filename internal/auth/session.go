@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+)
+
+const (
+	// sessionTouchInterval limits how often the last activity of a session is written
+	sessionTouchInterval = time.Minute
+	// maxUserAgentLength matches the sessions.user_agent column
+	maxUserAgentLength = 512
+)
+
+// SessionTracker records when sessions were last used
+type SessionTracker struct {
+	repo   Repository
+	logger *logger.Logger
+
+	mu        sync.Mutex
+	touched   map[string]time.Time
+	lastPrune time.Time
+}
+
+func NewSessionTracker(repo Repository, logger *logger.Logger) *SessionTracker {
+	return &SessionTracker{
+		repo:      repo,
+		logger:    logger.ServiceLogger("sessions"),
+		touched:   make(map[string]time.Time),
+		lastPrune: time.Now(),
+	}
+}
+
+// Touch marks the session as seen now. Writes are skipped while the session
+// was touched less than a minute ago by this instance.
+func (t *SessionTracker) Touch(ctx context.Context, sessionID string) {
+	now := time.Now()
+
+	t.mu.Lock()
+	last, ok := t.touched[sessionID]
+	if ok && now.Sub(last) < sessionTouchInterval {
+		t.mu.Unlock()
+		return
+	}
+	t.touched[sessionID] = now
+	t.prune(now)
+	t.mu.Unlock()
+
+	if err := t.repo.TouchSession(ctx, sessionID, now, sessionTouchInterval); err != nil {
+		t.logger.Error("Failed to record session activity", "session_id", sessionID, "error", err)
+	}
+}
+
+// prune forgets sessions that were not touched recently, the caller must hold the lock
+func (t *SessionTracker) prune(now time.Time) {
+	if now.Sub(t.lastPrune) < sessionTouchInterval {
+		return
+	}
+	t.lastPrune = now
+
+	for sessionID, last := range t.touched {
+		if now.Sub(last) >= sessionTouchInterval {
+			delete(t.touched, sessionID)
+		}
+	}
+}
+
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) <= maxUserAgentLength {
+		return userAgent
+	}
+	return userAgent[:maxUserAgentLength]
+}
@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/database/dbtest"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rfcSecret is the SHA1 key of the RFC 6238 test vectors, "12345678901234567890" in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	// The RFC lists 8 digit codes, 6 digit codes are their last 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		t.Run(time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			got, err := totpCode(rfcSecret, tt.unix/totpPeriod)
+			if err != nil {
+				t.Fatalf("totpCode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("totpCode() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := totpCode("not base32!", 1); err == nil {
+		t.Error("totpCode() with an invalid secret returned no error")
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	newYear := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+	newYearStep := newYear.Unix() / totpPeriod
+
+	codeOf := func(step int64) string {
+		code, err := totpCode(rfcSecret, step)
+		if err != nil {
+			t.Fatalf("totpCode() error = %v", err)
+		}
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		now      time.Time
+		wantStep int64
+		wantOK   bool
+	}{
+		{
+			name:     "code of the current step",
+			secret:   rfcSecret,
+			code:     codeOf(newYearStep),
+			now:      newYear.Add(10 * time.Second),
+			wantStep: newYearStep,
+			wantOK:   true,
+		},
+		{
+			name:     "Dec 31 code entered just after midnight",
+			secret:   rfcSecret,
+			code:     codeOf(newYearStep - 1),
+			now:      newYear,
+			wantStep: newYearStep - 1,
+			wantOK:   true,
+		},
+		{
+			name:     "Jan 1 code entered just before midnight",
+			secret:   rfcSecret,
+			code:     codeOf(newYearStep),
+			now:      newYear.Add(-time.Second),
+			wantStep: newYearStep,
+			wantOK:   true,
+		},
+		{
+			name:   "code two steps old",
+			secret: rfcSecret,
+			code:   codeOf(newYearStep - 2),
+			now:    newYear,
+		},
+		{
+			name:   "code two steps ahead",
+			secret: rfcSecret,
+			code:   codeOf(newYearStep + 1),
+			now:    newYear.Add(-time.Second),
+		},
+		{
+			name:     "surrounding spaces are ignored",
+			secret:   rfcSecret,
+			code:     " " + codeOf(newYearStep) + " ",
+			now:      newYear,
+			wantStep: newYearStep,
+			wantOK:   true,
+		},
+		{
+			name:     "lower case secret",
+			secret:   "gezdgnbvgy3tqojqgezdgnbvgy3tqojq",
+			code:     codeOf(newYearStep),
+			now:      newYear,
+			wantStep: newYearStep,
+			wantOK:   true,
+		},
+		{
+			name:   "too short",
+			secret: rfcSecret,
+			code:   codeOf(newYearStep)[:5],
+			now:    newYear,
+		},
+		{
+			name:   "too long",
+			secret: rfcSecret,
+			code:   codeOf(newYearStep) + "0",
+			now:    newYear,
+		},
+		{
+			name:   "empty",
+			secret: rfcSecret,
+			now:    newYear,
+		},
+		{
+			name:   "invalid secret",
+			secret: "not base32!",
+			code:   codeOf(newYearStep),
+			now:    newYear,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := validateTOTP(tt.secret, tt.code, tt.now)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("validateTOTP() = %d, %v, want %d, %v", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+// memoryMFARepository keeps the enrollment of a single employee the way the
+// employee_mfa table does
+type memoryMFARepository struct {
+	Repository
+	enrollment    *MFAEnrollment
+	recoveryCodes map[string]bool
+}
+
+func (r *memoryMFARepository) GetMFA(ctx context.Context, employeeID string) (*MFAEnrollment, error) {
+	if r.enrollment == nil || r.enrollment.EmployeeID != employeeID {
+		return nil, ErrMFANotEnrolled
+	}
+	copied := *r.enrollment
+	return &copied, nil
+}
+
+func (r *memoryMFARepository) SaveMFAEnrollment(ctx context.Context, enrollment *MFAEnrollment) error {
+	if r.enrollment != nil && r.enrollment.Enabled {
+		return errors.New("mfa already enabled")
+	}
+	copied := *enrollment
+	r.enrollment = &copied
+	return nil
+}
+
+func (r *memoryMFARepository) ActivateMFA(ctx context.Context, employeeID string, step int64, codeHashes []string) error {
+	if r.enrollment == nil || r.enrollment.Enabled {
+		return ErrMFANotEnrolled
+	}
+	if r.enrollment.LastUsedStep >= step {
+		return ErrMFAStepUsed
+	}
+	r.enrollment.Enabled = true
+	r.enrollment.LastUsedStep = step
+	r.recoveryCodes = make(map[string]bool)
+	for _, hash := range codeHashes {
+		r.recoveryCodes[hash] = false
+	}
+	return nil
+}
+
+func (r *memoryMFARepository) RecordMFAStep(ctx context.Context, employeeID string, step int64) (bool, error) {
+	if r.enrollment == nil || !r.enrollment.Enabled || r.enrollment.LastUsedStep >= step {
+		return false, nil
+	}
+	r.enrollment.LastUsedStep = step
+	return true, nil
+}
+
+func (r *memoryMFARepository) UseRecoveryCode(ctx context.Context, employeeID, codeHash string) (bool, error) {
+	used, ok := r.recoveryCodes[codeHash]
+	if !ok || used {
+		return false, nil
+	}
+	r.recoveryCodes[codeHash] = true
+	return true, nil
+}
+
+func (r *memoryMFARepository) CreateSession(ctx context.Context, session *Session, token *RefreshToken) error {
+	return nil
+}
+
+func TestConfirmedMFAStepIsSingleUse(t *testing.T) {
+	emp := &employee.Employee{ID: "employee", Email: "jane@example.com", Role: RoleEmployee, Status: "ACTIVE"}
+	svc := newTestService(
+		&memoryMFARepository{},
+		newMemoryRevocationStore(),
+		&stubEmployeeRepository{employees: map[string]*employee.Employee{emp.ID: emp}},
+	)
+
+	checkConfirmedStepIsSingleUse(t, svc, emp)
+}
+
+func TestConfirmedMFAStepIsSingleUseIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	svc := newTestService(NewRepository(db), NewPostgresRevocationStore(db), employee.NewRepository(db))
+
+	checkConfirmedStepIsSingleUse(t, svc, createTestEmployee(t, db))
+}
+
+// checkConfirmedStepIsSingleUse enrolls the employee and checks that the code
+// confirming the enrollment cannot complete the next login
+func checkConfirmedStepIsSingleUse(t *testing.T, svc *service, emp *employee.Employee) {
+	t.Helper()
+	ctx := context.Background()
+	claims := &Claims{UserID: emp.ID, Email: emp.Email, Role: emp.Role}
+
+	enrollment, err := svc.EnrollMFA(ctx, claims)
+	if err != nil {
+		t.Fatalf("EnrollMFA() error = %v", err)
+	}
+
+	step := time.Now().Unix() / totpPeriod
+	code, err := totpCode(enrollment.Secret, step)
+	if err != nil {
+		t.Fatalf("totpCode() error = %v", err)
+	}
+	recoveryCodes, err := svc.ConfirmMFAEnrollment(ctx, claims, code)
+	if err != nil {
+		t.Fatalf("ConfirmMFAEnrollment() error = %v", err)
+	}
+
+	challenge := func() *Claims {
+		return &Claims{
+			UserID:    emp.ID,
+			Email:     emp.Email,
+			TokenType: TokenTypeMFA,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        newUUID(),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		}
+	}
+
+	if _, err := svc.VerifyMFA(ctx, challenge(), &VerifyMFARequest{Code: code}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("VerifyMFA() with the confirmation code error = %v, want Unauthenticated", err)
+	}
+
+	next, err := totpCode(enrollment.Secret, step+1)
+	if err != nil {
+		t.Fatalf("totpCode() error = %v", err)
+	}
+	if _, err := svc.VerifyMFA(ctx, challenge(), &VerifyMFARequest{Code: next}); err != nil {
+		t.Fatalf("VerifyMFA() with the code of the next step error = %v", err)
+	}
+	if _, err := svc.VerifyMFA(ctx, challenge(), &VerifyMFARequest{Code: next}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("VerifyMFA() replaying the code of the next step error = %v, want Unauthenticated", err)
+	}
+
+	recovery := strings.ToLower(recoveryCodes[0])
+	if _, err := svc.VerifyMFA(ctx, challenge(), &VerifyMFARequest{RecoveryCode: recovery}); err != nil {
+		t.Fatalf("VerifyMFA() with a recovery code error = %v", err)
+	}
+	if _, err := svc.VerifyMFA(ctx, challenge(), &VerifyMFARequest{RecoveryCode: recovery}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("VerifyMFA() reusing a recovery code error = %v, want Unauthenticated", err)
+	}
+}
@@ -18,12 +18,53 @@ type Config struct {
 	ServerHost string `mapstructure:"SERVER_HOST"`
 	GRPCPort   int    `mapstructure:"GRPC_PORT"`
 
+	// TLS settings of the gRPC server
+	TLS TLSConfig `mapstructure:",squash"`
+
 	// Database settings
 	Database DatabaseConfig `mapstructure:",squash"`
 
 	// JWT settings
-	JWTSecret      string `mapstructure:"JWT_SECRET"`
-	JWTExpiryHours int    `mapstructure:"JWT_EXPIRY_HOURS"`
+	JWTSigningMethod             string `mapstructure:"JWT_SIGNING_METHOD"`
+	JWTSecret                    string `mapstructure:"JWT_SECRET"`
+	JWTKeysDir                   string `mapstructure:"JWT_KEYS_DIR"`
+	JWTKeyActivationDelayMinutes int    `mapstructure:"JWT_KEY_ACTIVATION_DELAY_MINUTES"`
+	JWTKeyReloadIntervalSeconds  int    `mapstructure:"JWT_KEY_RELOAD_INTERVAL_SECONDS"`
+	JWTExpiryHours               int    `mapstructure:"JWT_EXPIRY_HOURS"`
+
+	// Refresh token settings
+	RefreshTokenExpiryHours int `mapstructure:"REFRESH_TOKEN_EXPIRY_HOURS"`
+
+	// Token revocation settings
+	RevocationCacheTTLSeconds int `mapstructure:"REVOCATION_CACHE_TTL_SECONDS"`
+
+	// Impersonation settings
+	ImpersonationExpiryMinutes int `mapstructure:"IMPERSONATION_EXPIRY_MINUTES"`
+
+	// Login lockout settings
+	Lockout LockoutConfig `mapstructure:",squash"`
+
+	// Password policy settings
+	Password PasswordConfig `mapstructure:",squash"`
+
+	// Multi-factor authentication settings
+	MFA MFAConfig `mapstructure:",squash"`
+
+	// Password reset settings
+	PasswordResetTokenExpiryMinutes int    `mapstructure:"PASSWORD_RESET_TOKEN_EXPIRY_MINUTES"`
+	PasswordResetURL                string `mapstructure:"PASSWORD_RESET_URL"`
+
+	// Leave settings
+	WeekendDays          []string `mapstructure:"WEEKEND_DAYS"`
+	StandardWorkingHours float64  `mapstructure:"STANDARD_WORKING_HOURS"`
+	AccrualEnabled       bool     `mapstructure:"ACCRUAL_ENABLED"`
+	AccrualIntervalHours int      `mapstructure:"ACCRUAL_INTERVAL_HOURS"`
+	YearEndEnabled       bool     `mapstructure:"YEAR_END_ENABLED"`
+	YearEndIntervalHours int      `mapstructure:"YEAR_END_INTERVAL_HOURS"`
+	CompOffExpiryDays    int      `mapstructure:"COMP_OFF_EXPIRY_DAYS"`
+
+	// Notification settings
+	Notifier NotifierConfig `mapstructure:",squash"`
 
 	// Redis settings
 	Redis RedisConfig `mapstructure:",squash"`
@@ -54,6 +95,47 @@ type DatabaseConfig struct {
 	SSLMode  string `mapstructure:"DB_SSL_MODE"`
 }
 
+type TLSConfig struct {
+	Enabled              bool   `mapstructure:"TLS_ENABLED"`
+	CertFile             string `mapstructure:"TLS_CERT_FILE"`
+	KeyFile              string `mapstructure:"TLS_KEY_FILE"`
+	ClientCAFile         string `mapstructure:"TLS_CLIENT_CA_FILE"`
+	RequireClientCert    bool   `mapstructure:"TLS_REQUIRE_CLIENT_CERT"`
+	ClientIdentitiesFile string `mapstructure:"TLS_CLIENT_IDENTITIES_FILE"`
+}
+
+type LockoutConfig struct {
+	Store              string `mapstructure:"LOCKOUT_STORE"`
+	MaxAccountFailures int    `mapstructure:"LOCKOUT_MAX_ACCOUNT_FAILURES"`
+	MaxIPFailures      int    `mapstructure:"LOCKOUT_MAX_IP_FAILURES"`
+	WindowMinutes      int    `mapstructure:"LOCKOUT_WINDOW_MINUTES"`
+	DurationMinutes    int    `mapstructure:"LOCKOUT_DURATION_MINUTES"`
+	BaseDelaySeconds   int    `mapstructure:"LOCKOUT_BASE_DELAY_SECONDS"`
+	MaxDelaySeconds    int    `mapstructure:"LOCKOUT_MAX_DELAY_SECONDS"`
+}
+
+type PasswordConfig struct {
+	MinLength      int    `mapstructure:"PASSWORD_MIN_LENGTH"`
+	RequireUpper   bool   `mapstructure:"PASSWORD_REQUIRE_UPPER"`
+	RequireLower   bool   `mapstructure:"PASSWORD_REQUIRE_LOWER"`
+	RequireDigit   bool   `mapstructure:"PASSWORD_REQUIRE_DIGIT"`
+	RequireSymbol  bool   `mapstructure:"PASSWORD_REQUIRE_SYMBOL"`
+	HistorySize    int    `mapstructure:"PASSWORD_HISTORY_SIZE"`
+	BannedListFile string `mapstructure:"PASSWORD_BANNED_LIST_FILE"`
+}
+
+type MFAConfig struct {
+	RequiredRoles          []string `mapstructure:"MFA_REQUIRED_ROLES"`
+	Issuer                 string   `mapstructure:"MFA_ISSUER"`
+	ChallengeExpiryMinutes int      `mapstructure:"MFA_CHALLENGE_EXPIRY_MINUTES"`
+	EncryptionKey          string   `mapstructure:"MFA_ENCRYPTION_KEY"`
+}
+
+type NotifierConfig struct {
+	Type     string `mapstructure:"NOTIFIER_TYPE"`
+	FilePath string `mapstructure:"NOTIFIER_FILE_PATH"`
+}
+
 type RedisConfig struct {
 	Host     string `mapstructure:"REDIS_HOST"`
 	Port     int    `mapstructure:"REDIS_PORT"`
@@ -116,6 +198,14 @@ func setDefaults() {
 	viper.SetDefault("SERVER_HOST", "0.0.0.0")
 	viper.SetDefault("GRPC_PORT", 9090)
 
+	// TLS defaults
+	viper.SetDefault("TLS_ENABLED", false)
+	viper.SetDefault("TLS_CERT_FILE", "")
+	viper.SetDefault("TLS_KEY_FILE", "")
+	viper.SetDefault("TLS_CLIENT_CA_FILE", "")
+	viper.SetDefault("TLS_REQUIRE_CLIENT_CERT", false)
+	viper.SetDefault("TLS_CLIENT_IDENTITIES_FILE", "")
+
 	// Database defaults
 	viper.SetDefault("DB_HOST", "localhost")
 	viper.SetDefault("DB_PORT", 5432)
@@ -124,9 +214,55 @@ func setDefaults() {
 	viper.SetDefault("DB_NAME", "hrmanagement")
 	viper.SetDefault("DB_SSL_MODE", "disable")
 
-	// JWT defaults
-	viper.SetDefault("JWT_SECRET", "39w0jcnsu9dns8end8e30dxk20snjw9enn9fnci39dn73839djd93")
+	// JWT defaults, there is deliberately no default secret
+	viper.SetDefault("JWT_SIGNING_METHOD", "RS256")
+	viper.SetDefault("JWT_KEYS_DIR", "./configs/keys")
+	viper.SetDefault("JWT_KEY_ACTIVATION_DELAY_MINUTES", 10)
+	viper.SetDefault("JWT_KEY_RELOAD_INTERVAL_SECONDS", 60)
 	viper.SetDefault("JWT_EXPIRY_HOURS", 24)
+	viper.SetDefault("REFRESH_TOKEN_EXPIRY_HOURS", 720)
+	viper.SetDefault("REVOCATION_CACHE_TTL_SECONDS", 30)
+	viper.SetDefault("IMPERSONATION_EXPIRY_MINUTES", 15)
+
+	// Lockout defaults
+	viper.SetDefault("LOCKOUT_STORE", "memory")
+	viper.SetDefault("LOCKOUT_MAX_ACCOUNT_FAILURES", 5)
+	viper.SetDefault("LOCKOUT_MAX_IP_FAILURES", 20)
+	viper.SetDefault("LOCKOUT_WINDOW_MINUTES", 15)
+	viper.SetDefault("LOCKOUT_DURATION_MINUTES", 15)
+	viper.SetDefault("LOCKOUT_BASE_DELAY_SECONDS", 1)
+	viper.SetDefault("LOCKOUT_MAX_DELAY_SECONDS", 30)
+
+	// Password policy defaults
+	viper.SetDefault("PASSWORD_MIN_LENGTH", 12)
+	viper.SetDefault("PASSWORD_REQUIRE_UPPER", true)
+	viper.SetDefault("PASSWORD_REQUIRE_LOWER", true)
+	viper.SetDefault("PASSWORD_REQUIRE_DIGIT", true)
+	viper.SetDefault("PASSWORD_REQUIRE_SYMBOL", true)
+	viper.SetDefault("PASSWORD_HISTORY_SIZE", 5)
+	viper.SetDefault("PASSWORD_BANNED_LIST_FILE", "")
+
+	// MFA defaults, there is deliberately no default encryption key
+	viper.SetDefault("MFA_REQUIRED_ROLES", []string{"ADMIN", "HR"})
+	viper.SetDefault("MFA_ISSUER", "HR Management System")
+	viper.SetDefault("MFA_CHALLENGE_EXPIRY_MINUTES", 5)
+
+	// Password reset defaults
+	viper.SetDefault("PASSWORD_RESET_TOKEN_EXPIRY_MINUTES", 30)
+	viper.SetDefault("PASSWORD_RESET_URL", "")
+
+	// Leave defaults
+	viper.SetDefault("WEEKEND_DAYS", []string{"SATURDAY", "SUNDAY"})
+	viper.SetDefault("STANDARD_WORKING_HOURS", 8)
+	viper.SetDefault("ACCRUAL_ENABLED", true)
+	viper.SetDefault("ACCRUAL_INTERVAL_HOURS", 24)
+	viper.SetDefault("YEAR_END_ENABLED", true)
+	viper.SetDefault("YEAR_END_INTERVAL_HOURS", 24)
+	viper.SetDefault("COMP_OFF_EXPIRY_DAYS", 90)
+
+	// Notification defaults
+	viper.SetDefault("NOTIFIER_TYPE", "log")
+	viper.SetDefault("NOTIFIER_FILE_PATH", "./notifications/outbox.jsonl")
 
 	// Redis defaults
 	viper.SetDefault("REDIS_HOST", "localhost")
@@ -160,11 +296,79 @@ func (c *Config) Validate() error {
 	if c.Database.Name == "" {
 		return fmt.Errorf("database name is required")
 	}
-	if c.JWTSecret == "" {
-		return fmt.Errorf("JWT secret is required")
+	switch c.JWTSigningMethod {
+	case "HS256":
+		if c.JWTSecret == "" {
+			return fmt.Errorf("JWT secret is required for HS256")
+		}
+		if len(c.JWTSecret) < 32 {
+			return fmt.Errorf("JWT secret must be at least 32 characters long")
+		}
+	case "RS256", "EdDSA":
+		if c.JWTKeysDir == "" {
+			return fmt.Errorf("JWT keys directory is required for %s", c.JWTSigningMethod)
+		}
+	default:
+		return fmt.Errorf("unsupported JWT signing method: %s", c.JWTSigningMethod)
+	}
+	if c.TLS.Enabled {
+		if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+			return fmt.Errorf("TLS certificate and key files are required when TLS is enabled")
+		}
+		if c.TLS.ClientCAFile == "" && (c.TLS.RequireClientCert || c.TLS.ClientIdentitiesFile != "") {
+			return fmt.Errorf("TLS client CA file is required for client certificates")
+		}
+	}
+	if c.RefreshTokenExpiryHours <= 0 {
+		return fmt.Errorf("refresh token expiry must be positive")
+	}
+	if c.ImpersonationExpiryMinutes <= 0 {
+		return fmt.Errorf("impersonation expiry must be positive")
+	}
+	if c.Lockout.Store != "memory" && c.Lockout.Store != "postgres" {
+		return fmt.Errorf("unsupported lockout store: %s", c.Lockout.Store)
+	}
+	if c.Lockout.MaxAccountFailures <= 0 || c.Lockout.MaxIPFailures <= 0 {
+		return fmt.Errorf("lockout failure limits must be positive")
+	}
+	if c.Password.MinLength < 8 {
+		return fmt.Errorf("password minimum length must be at least 8")
 	}
-	if len(c.JWTSecret) < 32 {
-		return fmt.Errorf("JWT secret must be at least 32 characters long")
+	if c.Password.HistorySize < 0 {
+		return fmt.Errorf("password history size cannot be negative")
+	}
+	if c.MFA.EncryptionKey == "" {
+		return fmt.Errorf("MFA encryption key is required")
+	}
+	if c.MFA.ChallengeExpiryMinutes <= 0 {
+		return fmt.Errorf("MFA challenge expiry must be positive")
+	}
+	if c.PasswordResetTokenExpiryMinutes <= 0 {
+		return fmt.Errorf("password reset token expiry must be positive")
+	}
+	if len(c.WeekendDays) >= 7 {
+		return fmt.Errorf("weekend days must leave at least one working day")
+	}
+	if c.StandardWorkingHours <= 0 || c.StandardWorkingHours > 24 {
+		return fmt.Errorf("standard working hours must be between 0 and 24")
+	}
+	if c.AccrualEnabled && c.AccrualIntervalHours <= 0 {
+		return fmt.Errorf("accrual interval must be positive")
+	}
+	if c.YearEndEnabled && c.YearEndIntervalHours <= 0 {
+		return fmt.Errorf("year-end interval must be positive")
+	}
+	if c.CompOffExpiryDays <= 0 {
+		return fmt.Errorf("comp-off expiry must be positive")
+	}
+	switch c.Notifier.Type {
+	case "log":
+	case "file":
+		if c.Notifier.FilePath == "" {
+			return fmt.Errorf("notifier file path is required for the file notifier")
+		}
+	default:
+		return fmt.Errorf("unsupported notifier type: %s", c.Notifier.Type)
 	}
 	if c.GRPCPort <= 0 || c.GRPCPort > 65535 {
 		return fmt.Errorf("invalid gRPC port: %d", c.GRPCPort)
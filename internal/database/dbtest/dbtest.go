@@ -0,0 +1,83 @@
+// Package dbtest gives integration tests a migrated PostgreSQL database.
+package dbtest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// DSNVariable names the environment variable holding the postgres:// URL of the test database
+const DSNVariable = "TEST_DATABASE_DSN"
+
+var (
+	once    sync.Once
+	db      *gorm.DB
+	openErr error
+)
+
+// Open returns a transaction on the test database that is rolled back when the
+// test ends. The database is migrated to the latest version first. The test is
+// skipped in short mode or when DSNVariable is not set.
+func Open(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	dsn := os.Getenv(DSNVariable)
+	if dsn == "" {
+		t.Skipf("%s is not set", DSNVariable)
+	}
+
+	once.Do(func() {
+		db, openErr = open(dsn)
+	})
+	if openErr != nil {
+		t.Fatalf("failed to open test database: %v", openErr)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		t.Fatalf("failed to begin transaction: %v", tx.Error)
+	}
+	t.Cleanup(func() {
+		tx.Rollback()
+	})
+
+	return tx
+}
+
+func open(dsn string) (*gorm.DB, error) {
+	_, file, _, _ := runtime.Caller(0)
+	migrationPath := filepath.ToSlash(filepath.Join(filepath.Dir(file), "..", "migrations"))
+
+	m, err := migrate.New(fmt.Sprintf("file://%s", migrationPath), dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migrate instance: %w", err)
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Discard,
+		NowFunc: func() time.Time {
+			return time.Now().UTC()
+		},
+	})
+}
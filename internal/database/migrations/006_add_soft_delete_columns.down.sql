@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_leave_balances_deleted_at;
+DROP INDEX IF EXISTS idx_leaves_deleted_at;
+DROP INDEX IF EXISTS idx_employees_deleted_at;
+DROP INDEX IF EXISTS idx_departments_deleted_at;
+
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE leaves DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE employees DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE departments DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,10 @@
+-- The GORM models soft delete through deleted_at
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE employees ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE leaves ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_departments_deleted_at ON departments(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_employees_deleted_at ON employees(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_leaves_deleted_at ON leaves(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_leave_balances_deleted_at ON leave_balances(deleted_at);
@@ -0,0 +1,4 @@
+-- Direct manager of an employee, used for reporting lines and ownership checks
+ALTER TABLE employees ADD COLUMN IF NOT EXISTS manager_id UUID REFERENCES employees(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employees_manager_id ON employees(manager_id);
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_refresh_tokens_updated_at ON refresh_tokens;
+DROP TABLE IF EXISTS refresh_tokens;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_employee_token_revocations_updated_at ON employee_token_revocations;
+DROP TABLE IF EXISTS employee_token_revocations;
+DROP TABLE IF EXISTS revoked_tokens;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_login_throttles_updated_at ON login_throttles;
+DROP TABLE IF EXISTS login_throttles;
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_employee_mfa_updated_at ON employee_mfa;
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS employee_mfa;
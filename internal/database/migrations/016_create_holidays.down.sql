@@ -0,0 +1,4 @@
+ALTER TABLE leaves DROP COLUMN IF EXISTS excluded_dates;
+
+DROP TRIGGER IF EXISTS update_holidays_updated_at ON holidays;
+DROP TABLE IF EXISTS holidays;
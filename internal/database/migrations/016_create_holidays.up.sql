@@ -0,0 +1,25 @@
+-- Public holidays per country. An empty location applies to the whole country,
+-- otherwise only to departments at that location.
+CREATE TABLE IF NOT EXISTS holidays (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    date DATE NOT NULL,
+    country VARCHAR(100) NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_holidays_calendar_date
+    ON holidays(country, LOWER(location), date) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_holidays_date ON holidays(date);
+CREATE INDEX IF NOT EXISTS idx_holidays_deleted_at ON holidays(deleted_at);
+
+CREATE TRIGGER update_holidays_updated_at
+    BEFORE UPDATE ON holidays
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Weekends and holidays skipped when counting the working days of a leave request
+ALTER TABLE leaves ADD COLUMN IF NOT EXISTS excluded_dates JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,9 @@
+ALTER TABLE leave_balances DROP COLUMN remaining_days;
+ALTER TABLE leave_balances ALTER COLUMN used_days TYPE INTEGER USING CEIL(used_days);
+ALTER TABLE leave_balances ALTER COLUMN total_days TYPE INTEGER USING CEIL(total_days);
+ALTER TABLE leave_balances ADD COLUMN remaining_days INTEGER GENERATED ALWAYS AS (total_days - used_days) STORED;
+
+ALTER TABLE leaves DROP CONSTRAINT IF EXISTS valid_partial_day;
+ALTER TABLE leaves DROP COLUMN IF EXISTS hours;
+ALTER TABLE leaves DROP COLUMN IF EXISTS duration;
+ALTER TABLE leaves ALTER COLUMN days_requested TYPE INTEGER USING CEIL(days_requested);
@@ -0,0 +1,16 @@
+-- Leave is booked in fractional days: full days, half days (AM or PM) or hours
+-- of a standard working day. Days are kept with 4 decimals for hourly leave.
+ALTER TABLE leaves ALTER COLUMN days_requested TYPE NUMERIC(8,4);
+ALTER TABLE leaves ADD COLUMN IF NOT EXISTS duration VARCHAR(20) NOT NULL DEFAULT 'FULL_DAY'
+    CHECK (duration IN ('FULL_DAY','HALF_DAY_AM','HALF_DAY_PM','HOURLY'));
+ALTER TABLE leaves ADD COLUMN IF NOT EXISTS hours NUMERIC(5,2);
+ALTER TABLE leaves ADD CONSTRAINT valid_partial_day CHECK (
+    (duration = 'FULL_DAY' OR start_date = end_date)
+    AND ((duration = 'HOURLY') = (hours IS NOT NULL AND hours > 0))
+);
+
+-- remaining_days is generated from the other two columns and has to be recreated
+ALTER TABLE leave_balances DROP COLUMN remaining_days;
+ALTER TABLE leave_balances ALTER COLUMN total_days TYPE NUMERIC(8,4);
+ALTER TABLE leave_balances ALTER COLUMN used_days TYPE NUMERIC(8,4);
+ALTER TABLE leave_balances ADD COLUMN remaining_days NUMERIC(8,4) GENERATED ALWAYS AS (total_days - used_days) STORED;
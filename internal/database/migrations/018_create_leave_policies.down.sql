@@ -0,0 +1,4 @@
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS accrued_days;
+
+DROP TRIGGER IF EXISTS update_leave_policies_updated_at ON leave_policies;
+DROP TABLE IF EXISTS leave_policies;
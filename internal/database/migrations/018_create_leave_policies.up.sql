@@ -0,0 +1,29 @@
+-- Accrual policy per leave type. ANNUAL grants the yearly days at once, MONTHLY
+-- credits a twelfth of them every month. Both are prorated from the hire date
+-- and only start once probation_months have passed.
+CREATE TABLE IF NOT EXISTS leave_policies (
+    leave_type VARCHAR(20) PRIMARY KEY CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL')),
+    accrual_method VARCHAR(20) NOT NULL CHECK (accrual_method IN ('ANNUAL','MONTHLY')),
+    days_per_year NUMERIC(8,4) NOT NULL CHECK (days_per_year >= 0),
+    probation_months INTEGER NOT NULL DEFAULT 0 CHECK (probation_months >= 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_leave_policies_updated_at
+    BEFORE UPDATE ON leave_policies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Maternity and paternity leave are granted case by case and have no policy
+INSERT INTO leave_policies (leave_type, accrual_method, days_per_year, probation_months) VALUES
+    ('ANNUAL', 'MONTHLY', 18, 3),
+    ('SICK', 'ANNUAL', 12, 0),
+    ('PERSONAL', 'ANNUAL', 6, 3),
+    ('EMERGENCY', 'ANNUAL', 3, 0)
+ON CONFLICT (leave_type) DO NOTHING;
+
+-- Days credited by the accrual job so far, so reruns only credit the difference
+-- and manual adjustments of total_days are kept
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS accrued_days NUMERIC(8,4) NOT NULL DEFAULT 0;
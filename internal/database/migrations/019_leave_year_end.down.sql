@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS update_payroll_line_items_updated_at ON payroll_line_items;
+DROP TABLE IF EXISTS payroll_line_items;
+
+DROP INDEX IF EXISTS idx_leave_balances_carry_expires_on;
+ALTER TABLE leave_balances DROP CONSTRAINT IF EXISTS valid_spent_days;
+ALTER TABLE leave_balances DROP COLUMN remaining_days;
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS encashed_days;
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS expired_days;
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS carry_expired_at;
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS carry_expires_on;
+ALTER TABLE leave_balances DROP COLUMN IF EXISTS carried_days;
+ALTER TABLE leave_balances ADD COLUMN remaining_days NUMERIC(8,4) GENERATED ALWAYS AS (total_days - used_days) STORED;
+
+ALTER TABLE leave_policies DROP COLUMN IF EXISTS carry_forward_expiry_months;
+ALTER TABLE leave_policies DROP COLUMN IF EXISTS carry_forward_max_days;
@@ -0,0 +1,50 @@
+-- Carry-forward rules of a leave type: at most carry_forward_max_days unused days
+-- move to the next year and lapse carry_forward_expiry_months into it (0 keeps them)
+ALTER TABLE leave_policies ADD COLUMN IF NOT EXISTS carry_forward_max_days NUMERIC(8,4) NOT NULL DEFAULT 0
+    CHECK (carry_forward_max_days >= 0);
+ALTER TABLE leave_policies ADD COLUMN IF NOT EXISTS carry_forward_expiry_months INTEGER NOT NULL DEFAULT 0
+    CHECK (carry_forward_expiry_months >= 0);
+
+UPDATE leave_policies SET carry_forward_max_days = 5, carry_forward_expiry_months = 3 WHERE leave_type = 'ANNUAL';
+
+-- carried_days are part of total_days and used first. Expired and encashed days
+-- are no longer available, remaining_days has to be recreated to subtract them.
+ALTER TABLE leave_balances DROP COLUMN remaining_days;
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS carried_days NUMERIC(8,4) NOT NULL DEFAULT 0;
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS carry_expires_on DATE;
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS carry_expired_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS expired_days NUMERIC(8,4) NOT NULL DEFAULT 0;
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS encashed_days NUMERIC(8,4) NOT NULL DEFAULT 0;
+ALTER TABLE leave_balances ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE leave_balances ADD COLUMN remaining_days NUMERIC(8,4)
+    GENERATED ALWAYS AS (total_days - used_days - expired_days - encashed_days) STORED;
+ALTER TABLE leave_balances ADD CONSTRAINT valid_spent_days
+    CHECK (used_days + expired_days + encashed_days <= total_days);
+
+CREATE INDEX IF NOT EXISTS idx_leave_balances_carry_expires_on ON leave_balances(carry_expires_on)
+    WHERE carry_expired_at IS NULL;
+
+-- Amounts owed to employees outside the regular salary, a payroll run picks them
+-- up and sets payroll_id
+CREATE TABLE IF NOT EXISTS payroll_line_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    payroll_id UUID REFERENCES payroll(id) ON DELETE SET NULL,
+    item_type VARCHAR(30) NOT NULL CHECK (item_type IN ('LEAVE_ENCASHMENT')),
+    description TEXT,
+    quantity NUMERIC(8,4) NOT NULL,
+    rate DECIMAL(15,2) NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    reference_id UUID,
+    created_by UUID REFERENCES employees(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_line_items_employee_id ON payroll_line_items(employee_id);
+CREATE INDEX IF NOT EXISTS idx_payroll_line_items_unpaid ON payroll_line_items(employee_id) WHERE payroll_id IS NULL;
+
+CREATE TRIGGER update_payroll_line_items_updated_at
+    BEFORE UPDATE ON payroll_line_items
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,2 @@
+ALTER TABLE departments DROP COLUMN IF EXISTS staffing_enforcement;
+ALTER TABLE departments DROP COLUMN IF EXISTS max_concurrent_absences;
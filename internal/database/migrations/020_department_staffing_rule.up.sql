@@ -0,0 +1,7 @@
+-- Staffing rule of a department: at most max_concurrent_absences employees may be
+-- on approved leave on the same day (0 disables the rule). WARN reports breaches,
+-- BLOCK also refuses to approve leave that would cause one.
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS max_concurrent_absences INTEGER NOT NULL DEFAULT 0
+    CHECK (max_concurrent_absences >= 0);
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS staffing_enforcement VARCHAR(10) NOT NULL DEFAULT 'WARN'
+    CHECK (staffing_enforcement IN ('WARN', 'BLOCK'));
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS update_leave_approval_delegations_updated_at ON leave_approval_delegations;
+DROP TABLE IF EXISTS leave_approval_delegations;
+
+ALTER TABLE leaves DROP COLUMN IF EXISTS current_step;
+
+DROP TRIGGER IF EXISTS update_leave_approval_steps_updated_at ON leave_approval_steps;
+DROP TABLE IF EXISTS leave_approval_steps;
+
+DROP TRIGGER IF EXISTS update_leave_approval_rules_updated_at ON leave_approval_rules;
+DROP TABLE IF EXISTS leave_approval_rules;
@@ -0,0 +1,100 @@
+-- Approval chain per leave type: the steps of a request in step_order. A step only
+-- applies to requests of more than min_days, MANAGER is the employee's direct
+-- manager, DEPARTMENT_MANAGER the manager of their department and HR anyone in HR.
+CREATE TABLE IF NOT EXISTS leave_approval_rules (
+    leave_type VARCHAR(20) NOT NULL CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL')),
+    step_order INTEGER NOT NULL CHECK (step_order > 0),
+    approver_type VARCHAR(20) NOT NULL CHECK (approver_type IN ('MANAGER','DEPARTMENT_MANAGER','HR')),
+    min_days NUMERIC(8,4) NOT NULL DEFAULT 0 CHECK (min_days >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (leave_type, step_order)
+);
+
+CREATE TRIGGER update_leave_approval_rules_updated_at
+    BEFORE UPDATE ON leave_approval_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO leave_approval_rules (leave_type, step_order, approver_type, min_days)
+SELECT leave_type, 1, 'MANAGER', 0
+FROM unnest(ARRAY['ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL']) AS leave_type
+ON CONFLICT DO NOTHING;
+
+INSERT INTO leave_approval_rules (leave_type, step_order, approver_type, min_days) VALUES
+    ('ANNUAL', 2, 'DEPARTMENT_MANAGER', 0),
+    ('ANNUAL', 3, 'HR', 10),
+    ('SICK', 2, 'DEPARTMENT_MANAGER', 0),
+    ('SICK', 3, 'HR', 10),
+    ('PERSONAL', 2, 'DEPARTMENT_MANAGER', 0),
+    ('PERSONAL', 3, 'HR', 10),
+    ('EMERGENCY', 2, 'DEPARTMENT_MANAGER', 0),
+    ('EMERGENCY', 3, 'HR', 10),
+    ('PATERNITY', 2, 'DEPARTMENT_MANAGER', 0),
+    ('PATERNITY', 3, 'HR', 10),
+    ('MATERNITY', 2, 'DEPARTMENT_MANAGER', 0),
+    ('MATERNITY', 3, 'HR', 0)
+ON CONFLICT DO NOTHING;
+
+-- The approval steps of a request, resolved from the rules when it is created or
+-- changed. approver_id is empty for HR steps, on_behalf_of is set when a delegate decided.
+CREATE TABLE IF NOT EXISTS leave_approval_steps (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    leave_id UUID NOT NULL REFERENCES leaves(id) ON DELETE CASCADE,
+    step_order INTEGER NOT NULL CHECK (step_order > 0),
+    approver_type VARCHAR(20) NOT NULL CHECK (approver_type IN ('MANAGER','DEPARTMENT_MANAGER','HR')),
+    approver_id UUID REFERENCES employees(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING','APPROVED','REJECTED','SKIPPED')),
+    decided_by UUID REFERENCES employees(id) ON DELETE SET NULL,
+    on_behalf_of UUID REFERENCES employees(id) ON DELETE SET NULL,
+    comments TEXT,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (leave_id, step_order)
+);
+
+CREATE INDEX IF NOT EXISTS idx_leave_approval_steps_approver_id ON leave_approval_steps(approver_id) WHERE status = 'PENDING';
+
+CREATE TRIGGER update_leave_approval_steps_updated_at
+    BEFORE UPDATE ON leave_approval_steps
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Step awaiting a decision, 0 once the request is decided
+ALTER TABLE leaves ADD COLUMN IF NOT EXISTS current_step INTEGER NOT NULL DEFAULT 0;
+
+-- Pending requests keep the single decision they had so far: their manager, or HR
+-- for employees without one
+INSERT INTO leave_approval_steps (leave_id, step_order, approver_type, approver_id)
+SELECT leaves.id, 1, CASE WHEN employees.manager_id IS NULL THEN 'HR' ELSE 'MANAGER' END, employees.manager_id
+FROM leaves
+JOIN employees ON employees.id = leaves.employee_id
+WHERE leaves.status = 'PENDING' AND leaves.deleted_at IS NULL
+ON CONFLICT DO NOTHING;
+
+UPDATE leaves SET current_step = 1 WHERE status = 'PENDING' AND deleted_at IS NULL;
+
+-- Approvers hand their approvals to a delegate while they are away
+CREATE TABLE IF NOT EXISTS leave_approval_delegations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    delegator_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    delegate_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT valid_delegation_dates CHECK (end_date >= start_date),
+    CONSTRAINT no_self_delegation CHECK (delegator_id <> delegate_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_leave_approval_delegations_delegator ON leave_approval_delegations(delegator_id, start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_leave_approval_delegations_delegate_id ON leave_approval_delegations(delegate_id);
+CREATE INDEX IF NOT EXISTS idx_leave_approval_delegations_deleted_at ON leave_approval_delegations(deleted_at);
+
+CREATE TRIGGER update_leave_approval_delegations_updated_at
+    BEFORE UPDATE ON leave_approval_delegations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,11 @@
+ALTER TABLE leaves
+    DROP COLUMN IF EXISTS withdrawal_reason,
+    DROP COLUMN IF EXISTS withdrawn_at,
+    DROP COLUMN IF EXISTS withdrawn_by,
+    DROP COLUMN IF EXISTS withdrawn_days,
+    DROP COLUMN IF EXISTS original_end_date;
+
+ALTER TABLE leaves
+    DROP COLUMN IF EXISTS cancellation_reason,
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS cancelled_by;
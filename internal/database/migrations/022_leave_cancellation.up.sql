@@ -0,0 +1,15 @@
+-- Who cancelled a leave request before it started, and why
+ALTER TABLE leaves
+    ADD COLUMN IF NOT EXISTS cancelled_by UUID REFERENCES employees(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS cancellation_reason TEXT;
+
+-- Approved leave cut short while in progress keeps the end date it was approved
+-- with and the working days given back to the balance. The last withdrawal is
+-- recorded, withdrawn_days adds up all of them.
+ALTER TABLE leaves
+    ADD COLUMN IF NOT EXISTS original_end_date DATE,
+    ADD COLUMN IF NOT EXISTS withdrawn_days NUMERIC(8,4) NOT NULL DEFAULT 0 CHECK (withdrawn_days >= 0),
+    ADD COLUMN IF NOT EXISTS withdrawn_by UUID REFERENCES employees(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS withdrawn_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS withdrawal_reason TEXT;
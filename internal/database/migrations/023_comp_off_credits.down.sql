@@ -0,0 +1,23 @@
+DROP TRIGGER IF EXISTS update_comp_off_credits_updated_at ON comp_off_credits;
+DROP TABLE IF EXISTS comp_off_credits;
+
+DELETE FROM leave_approval_rules WHERE leave_type = 'COMP_OFF';
+DELETE FROM leave_policies WHERE leave_type = 'COMP_OFF';
+DELETE FROM leave_balances WHERE leave_type = 'COMP_OFF';
+DELETE FROM leaves WHERE leave_type = 'COMP_OFF';
+
+ALTER TABLE leave_approval_rules DROP CONSTRAINT IF EXISTS leave_approval_rules_leave_type_check;
+ALTER TABLE leave_approval_rules ADD CONSTRAINT leave_approval_rules_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL'));
+
+ALTER TABLE leave_policies DROP CONSTRAINT IF EXISTS leave_policies_leave_type_check;
+ALTER TABLE leave_policies ADD CONSTRAINT leave_policies_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL'));
+
+ALTER TABLE leave_balances DROP CONSTRAINT IF EXISTS leave_balances_leave_type_check;
+ALTER TABLE leave_balances ADD CONSTRAINT leave_balances_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL'));
+
+ALTER TABLE leaves DROP CONSTRAINT IF EXISTS leaves_leave_type_check;
+ALTER TABLE leaves ADD CONSTRAINT leaves_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL'));
@@ -0,0 +1,60 @@
+-- COMP_OFF leave is time given back for working on a weekend or holiday
+ALTER TABLE leaves DROP CONSTRAINT IF EXISTS leaves_leave_type_check;
+ALTER TABLE leaves ADD CONSTRAINT leaves_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL','COMP_OFF'));
+
+ALTER TABLE leave_balances DROP CONSTRAINT IF EXISTS leave_balances_leave_type_check;
+ALTER TABLE leave_balances ADD CONSTRAINT leave_balances_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL','COMP_OFF'));
+
+ALTER TABLE leave_policies DROP CONSTRAINT IF EXISTS leave_policies_leave_type_check;
+ALTER TABLE leave_policies ADD CONSTRAINT leave_policies_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL','COMP_OFF'));
+
+ALTER TABLE leave_approval_rules DROP CONSTRAINT IF EXISTS leave_approval_rules_leave_type_check;
+ALTER TABLE leave_approval_rules ADD CONSTRAINT leave_approval_rules_leave_type_check
+    CHECK (leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY','EMERGENCY','PERSONAL','COMP_OFF'));
+
+-- Comp-off days are credited by approved credits rather than accrued, the policy is
+-- inactive so the accrual job skips it. Days left at year end move into the next
+-- year and lapse three months into it, credits expire on their own before that.
+INSERT INTO leave_policies (leave_type, accrual_method, days_per_year, probation_months, active, carry_forward_max_days, carry_forward_expiry_months)
+VALUES ('COMP_OFF', 'ANNUAL', 0, 0, FALSE, 366, 3)
+ON CONFLICT (leave_type) DO NOTHING;
+
+INSERT INTO leave_approval_rules (leave_type, step_order, approver_type, min_days)
+VALUES ('COMP_OFF', 1, 'MANAGER', 0)
+ON CONFLICT DO NOTHING;
+
+-- Credit for a weekend or holiday worked, submitted by the employee's manager and
+-- approved by HR. Approval adds the days to the COMP_OFF balance of the work date's
+-- year, whatever is unused of them by expires_on lapses.
+CREATE TABLE IF NOT EXISTS comp_off_credits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    work_date DATE NOT NULL,
+    days NUMERIC(8,4) NOT NULL CHECK (days IN (0.5, 1)),
+    reason TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING','APPROVED','REJECTED')),
+    requested_by UUID REFERENCES employees(id) ON DELETE SET NULL,
+    approver_id UUID REFERENCES employees(id) ON DELETE SET NULL,
+    comments TEXT,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    balance_id UUID REFERENCES leave_balances(id) ON DELETE SET NULL,
+    expires_on DATE,
+    expired_days NUMERIC(8,4) NOT NULL DEFAULT 0 CHECK (expired_days >= 0),
+    expired_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A day worked is credited once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comp_off_credits_work_date
+    ON comp_off_credits(employee_id, work_date) WHERE status <> 'REJECTED';
+CREATE INDEX IF NOT EXISTS idx_comp_off_credits_status ON comp_off_credits(status);
+CREATE INDEX IF NOT EXISTS idx_comp_off_credits_expires_on ON comp_off_credits(expires_on) WHERE expired_at IS NULL;
+
+CREATE TRIGGER update_comp_off_credits_updated_at
+    BEFORE UPDATE ON comp_off_credits
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
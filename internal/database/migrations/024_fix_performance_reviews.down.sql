@@ -0,0 +1,7 @@
+ALTER TABLE performance_goals ALTER COLUMN status SET DEFAULT 'NOT STARTED';
+ALTER TABLE performance_goals RENAME COLUMN unit TO uint;
+
+ALTER TABLE performance_reviews DROP CONSTRAINT IF EXISTS performance_reviews_review_period_check;
+UPDATE performance_reviews SET review_period = 'HALF_YEALY' WHERE review_period = 'HALF_YEARLY';
+ALTER TABLE performance_reviews ADD CONSTRAINT performance_reviews_review_period_check
+    CHECK (review_period IN ('QUARTERLY','HALF_YEALY','ANNUAL', 'PROBATION'));
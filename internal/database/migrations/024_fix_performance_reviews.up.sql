@@ -0,0 +1,9 @@
+-- Migration 004 misspelt the half-yearly review period, named the goal unit column
+-- uint and gave goals a default status its own check rejects
+ALTER TABLE performance_reviews DROP CONSTRAINT IF EXISTS performance_reviews_review_period_check;
+UPDATE performance_reviews SET review_period = 'HALF_YEARLY' WHERE review_period = 'HALF_YEALY';
+ALTER TABLE performance_reviews ADD CONSTRAINT performance_reviews_review_period_check
+    CHECK (review_period IN ('QUARTERLY','HALF_YEARLY','ANNUAL','PROBATION'));
+
+ALTER TABLE performance_goals RENAME COLUMN uint TO unit;
+ALTER TABLE performance_goals ALTER COLUMN status SET DEFAULT 'NOT_STARTED';
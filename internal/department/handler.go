@@ -30,6 +30,9 @@ func (h *Handler) CreateDepartment(ctx context.Context, req *departmentpb.Create
 		ManagerID:   &req.ManagerId,
 		Budget:      req.Budget,
 		Location:    req.Location,
+
+		MaxConcurrentAbsences: int(req.MaxConcurrentAbsences),
+		StaffingEnforcement:   staffingEnforcementFromProto(req.StaffingEnforcement),
 	}
 
 	department, err := h.service.CreateDepartment(ctx, createReq)
@@ -66,6 +69,12 @@ func (h *Handler) UpdateDepartment(ctx context.Context, req *departmentpb.Update
 		ManagerID:   &req.ManagerId,
 		Budget:      req.Budget,
 		Location:    req.Location,
+
+		StaffingEnforcement: staffingEnforcementFromProto(req.StaffingEnforcement),
+	}
+	if req.MaxConcurrentAbsences != nil {
+		maxAbsences := int(req.MaxConcurrentAbsences.Value)
+		updateReq.MaxConcurrentAbsences = &maxAbsences
 	}
 
 	department, err := h.service.UpdateDepartment(ctx, req.GetId(), updateReq)
@@ -117,3 +126,14 @@ func (h *Handler) ListDepartments(ctx context.Context, req *departmentpb.ListDep
 		PageSize:    int32(response.PageSize),
 	}, nil
 }
+
+func staffingEnforcementFromProto(enforcement departmentpb.StaffingEnforcement) string {
+	switch enforcement {
+	case departmentpb.StaffingEnforcement_STAFFING_ENFORCEMENT_WARN:
+		return StaffingWarn
+	case departmentpb.StaffingEnforcement_STAFFING_ENFORCEMENT_BLOCK:
+		return StaffingBlock
+	default:
+		return ""
+	}
+}
@@ -18,6 +18,9 @@ type Department struct {
 	Budget        float64   `json:"budget" gorm:"default:0"`
 	Location      string    `json:"location"`
 	EmployeeCount int       `json:"employee_count" gorm:"default:0"`
+	// At most MaxConcurrentAbsences employees may be on leave on the same day, 0 disables the rule
+	MaxConcurrentAbsences int    `json:"max_concurrent_absences" gorm:"not null;default:0"`
+	StaffingEnforcement   string `json:"staffing_enforcement" gorm:"not null;default:'WARN';check:staffing_enforcement IN ('WARN','BLOCK')"`
 
 	Employees []Employee `json:"employees,omitempty" gorm:"foreignKey:DepartmentID"`
 
@@ -37,6 +40,12 @@ type Employee struct {
 	Status       string  `json:"status"`
 }
 
+// Enforcement of a department's staffing rule when approving leave
+const (
+	StaffingWarn  = "WARN"
+	StaffingBlock = "BLOCK"
+)
+
 func (Department) TableName() string {
 	return "departments"
 }
@@ -47,6 +56,9 @@ type CreateDepartmentRequest struct {
 	ManagerID   *string `json:"manager_id,omitempty"`
 	Budget      float64 `json:"budget" validate:"gte=0"`
 	Location    string  `json:"location,omitempty"`
+
+	MaxConcurrentAbsences int    `json:"max_concurrent_absences,omitempty" validate:"gte=0"`
+	StaffingEnforcement   string `json:"staffing_enforcement,omitempty" validate:"omitempty,oneof=WARN BLOCK"`
 }
 
 type UpdateDepartmentRequest struct {
@@ -55,6 +67,9 @@ type UpdateDepartmentRequest struct {
 	ManagerID   *string `json:"manager_id,omitempty"`
 	Budget      float64 `json:"budget,omitempty" validate:"gte=0"`
 	Location    string  `json:"location,omitempty"`
+
+	MaxConcurrentAbsences *int   `json:"max_concurrent_absences,omitempty" validate:"omitempty,gte=0"`
+	StaffingEnforcement   string `json:"staffing_enforcement,omitempty" validate:"omitempty,oneof=WARN BLOCK"`
 }
 
 type ListDepartmentsRequest struct {
@@ -79,6 +94,17 @@ func (d *Department) ToProto() *departmentpb.Department {
 		EmployeeCount: int32(d.EmployeeCount),
 		CreatedAt:     timestamppb.New(d.CreatedAt),
 		UpdatedAt:     timestamppb.New(d.UpdatedAt),
+
+		MaxConcurrentAbsences: int32(d.MaxConcurrentAbsences),
+	}
+
+	switch d.StaffingEnforcement {
+	case StaffingWarn:
+		dept.StaffingEnforcement = departmentpb.StaffingEnforcement_STAFFING_ENFORCEMENT_WARN
+	case StaffingBlock:
+		dept.StaffingEnforcement = departmentpb.StaffingEnforcement_STAFFING_ENFORCEMENT_BLOCK
+	default:
+		dept.StaffingEnforcement = departmentpb.StaffingEnforcement_STAFFING_ENFORCEMENT_UNSPECIFIED
 	}
 
 	if d.ManagerID != nil {
@@ -92,13 +118,20 @@ func (d *Department) ToProto() *departmentpb.Department {
 }
 
 func FromCreateRequest(req *CreateDepartmentRequest) *Department {
-	return &Department{
+	department := &Department{
 		Name:        req.Name,
 		Description: req.Description,
 		ManagerID:   req.ManagerID,
 		Budget:      req.Budget,
 		Location:    req.Location,
+
+		MaxConcurrentAbsences: req.MaxConcurrentAbsences,
+		StaffingEnforcement:   req.StaffingEnforcement,
+	}
+	if department.StaffingEnforcement == "" {
+		department.StaffingEnforcement = StaffingWarn
 	}
+	return department
 }
 
 func (d *Department) HasManager() bool {
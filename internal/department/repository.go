@@ -81,6 +81,12 @@ func (r *repository) Update(ctx context.Context, id string, req *UpdateDepartmen
 	if req.Name != "" {
 		updateData["name"] = req.Name
 	}
+	if req.MaxConcurrentAbsences != nil {
+		updateData["max_concurrent_absences"] = *req.MaxConcurrentAbsences
+	}
+	if req.StaffingEnforcement != "" {
+		updateData["staffing_enforcement"] = req.StaffingEnforcement
+	}
 
 	if len(updateData) == 0 {
 		return fmt.Errorf("no fields to update")
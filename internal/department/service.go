@@ -33,6 +33,10 @@ func (s *service) CreateDepartment(ctx context.Context, req *CreateDepartmentReq
 		return nil, status.Error(codes.AlreadyExists, "Department with this name alreday exists")
 	}
 
+	if req.MaxConcurrentAbsences < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Maximum concurrent absences cannot be negative")
+	}
+
 	department := FromCreateRequest(req)
 
 	if err := s.repo.Create(ctx, department); err != nil {
@@ -76,6 +80,10 @@ func (s *service) DeleteDepartment(ctx context.Context, id string) error {
 func (s *service) UpdateDepartment(ctx context.Context, id string, req *UpdateDepartmentRequest) (*Department, error) {
 	s.logger.Info("Updating department", "id", id)
 
+	if req.MaxConcurrentAbsences != nil && *req.MaxConcurrentAbsences < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Maximum concurrent absences cannot be negative")
+	}
+
 	if err := s.repo.Update(ctx, id, req); err != nil {
 		s.logger.Error("Failed to update department", "id", id, "error", err)
 		return nil, status.Error(codes.Internal, "Failed to update department")
@@ -29,8 +29,10 @@ func (h *Handler) CreateEmployee(ctx context.Context, req *employeepb.CreateEmpl
 		FirstName:    req.FirstName,
 		LastName:     req.LastName,
 		Email:        req.Email,
+		Password:     req.Password,
 		PhoneNumber:  stringPtr(req.PhoneNumber),
 		DepartmentID: stringPtr(req.DepartmentId),
+		ManagerID:    stringPtr(req.ManagerId),
 		Position:     req.Position,
 		Salary:       req.Salary,
 		HireDate:     req.HireDate.AsTime(),
@@ -78,6 +80,7 @@ func (h *Handler) UpdateEmployee(ctx context.Context, req *employeepb.UpdateEmpl
 		Email:        req.Email,
 		PhoneNumber:  stringPtr(req.PhoneNumber),
 		DepartmentID: stringPtr(req.DepartmentId),
+		ManagerID:    stringPtr(req.ManagerId),
 		Position:     req.Position,
 		Salary:       req.Salary,
 	}
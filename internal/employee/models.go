@@ -20,6 +20,9 @@ type Employee struct {
 	DepartmentID *string     `json:"department_id,omitempty"`
 	Department   *Department `json:"department,omitempty" gorm:"foreignKey:DepartmentID"`
 
+	// Reporting line
+	ManagerID *string `json:"manager_id,omitempty"`
+
 	// Job Details
 	Position string    `json:"position,omitempty"`
 	Salary   float64   `json:"salary" gorm:"default:0"`
@@ -45,15 +48,28 @@ type Employee struct {
 }
 
 type Department struct {
-	ID          string `json:"id" gorm:"type:uuid;primaryKey"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID          string  `json:"id" gorm:"type:uuid;primaryKey"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	ManagerID   *string `json:"manager_id,omitempty"`
 }
 
 func (Employee) TableName() string {
 	return "employees"
 }
 
+// PasswordHistory is a password hash the employee used before
+type PasswordHistory struct {
+	ID           string    `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID   string    `json:"employee_id" gorm:"type:uuid;not null;index"`
+	PasswordHash string    `json:"-" gorm:"not null"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (PasswordHistory) TableName() string {
+	return "password_history"
+}
+
 type CreateEmployeeRequest struct {
 	EmployeeID   string    `json:"employee_id" validate:"required"`
 	FirstName    string    `json:"first_name" validate:"required"`
@@ -61,6 +77,7 @@ type CreateEmployeeRequest struct {
 	Email        string    `json:"email" validate:"required,email"`
 	PhoneNumber  *string   `json:"phone_number,omitempty"`
 	DepartmentID *string   `json:"department_id,omitempty"`
+	ManagerID    *string   `json:"manager_id,omitempty"`
 	Position     string    `json:"position,omitempty"`
 	Salary       float64   `json:"salary" validate:"required"`
 	HireDate     time.Time `json:"hire_date" validate:"required"`
@@ -78,6 +95,7 @@ type UpdateEmployeeRequest struct {
 	Email        string  `json:"email,omitempty" validate:"omitempty,eamil"`
 	PhoneNumber  *string `json:"phone_number,omitempty"`
 	DepartmentID *string `json:"department_id,omitempty"`
+	ManagerID    *string `json:"manager_id,omitempty"`
 	Position     string  `json:"position,omitempty"`
 	Salary       float64 `json:"salary,omitempty" validate:"gte=8"`
 	Status       string  `json:"status,omitempty" validate:"omitempty,oneof=ACTIVE INACTIVE TERMINATED ON_LEAVE"`
@@ -123,6 +141,9 @@ func (e *Employee) ToProto() *employeepb.Employee {
 	if e.DepartmentID != nil {
 		emp.DepartmentId = *e.DepartmentID
 	}
+	if e.ManagerID != nil {
+		emp.ManagerId = *e.ManagerID
+	}
 
 	switch e.Status {
 	case "ACTIVE":
@@ -164,6 +185,7 @@ func FromCreateRequest(req *CreateEmployeeRequest) *Employee {
 		Email:        req.Email,
 		PhoneNumber:  req.PhoneNumber,
 		DepartmentID: req.DepartmentID,
+		ManagerID:    req.ManagerID,
 		Position:     req.Position,
 		Salary:       req.Salary,
 		HireDate:     req.HireDate,
@@ -194,6 +216,9 @@ func (e *Employee) ApplyUpdate(req *UpdateEmployeeRequest) {
 	if req.DepartmentID != nil {
 		e.DepartmentID = req.DepartmentID
 	}
+	if req.ManagerID != nil {
+		e.ManagerID = req.ManagerID
+	}
 	if req.Position != "" {
 		e.Position = req.Position
 	}
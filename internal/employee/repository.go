@@ -22,6 +22,8 @@ type Repository interface {
 	UpdatePassword(ctx context.Context, id string, passwordHash string) error
 	Count(ctx context.Context) (int64, error)
 	GetManagers(ctx context.Context) ([]*Employee, error)
+	IsManagedBy(ctx context.Context, id, managerID string) (bool, error)
+	GetPasswordHistory(ctx context.Context, id string, limit int) ([]string, error)
 }
 
 type repository struct {
@@ -33,10 +35,18 @@ func NewRepository(db *gorm.DB) Repository {
 }
 
 func (r *repository) Create(ctx context.Context, employee *Employee) error {
-	if err := r.db.WithContext(ctx).Create(employee).Error; err != nil {
-		return fmt.Errorf("failed to create employee: %w", err)
-	}
-	return nil
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(employee).Error; err != nil {
+			return fmt.Errorf("failed to create employee: %w", err)
+		}
+
+		if employee.PasswordHash != nil {
+			return addPasswordHistory(tx, employee.ID, *employee.PasswordHash)
+		}
+		return nil
+	})
+
+	return err
 }
 
 func (r *repository) GetByID(ctx context.Context, id string) (*Employee, error) {
@@ -167,9 +177,62 @@ func (r *repository) UpdateLastLogin(ctx context.Context, id string, lastLogin t
 }
 
 func (r *repository) UpdatePassword(ctx context.Context, id string, passwordHash string) error {
-	if err := r.db.WithContext(ctx).Model(&Employee{}).Where("id = ?", id).Update("password_hash", passwordHash).Error; err != nil {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return SetPassword(tx, id, passwordHash)
+	})
+}
+
+// SetPassword updates the password of the employee and records it in the password
+// history, tx lets callers change it together with their own rows
+func SetPassword(tx *gorm.DB, id string, passwordHash string) error {
+	if err := tx.Model(&Employee{}).Where("id = ?", id).Update("password_hash", passwordHash).Error; err != nil {
 		return fmt.Errorf("failed to update password: %w", err)
 	}
+	return addPasswordHistory(tx, id, passwordHash)
+}
+
+// GetPasswordHistory returns the current password hash followed by the most recent previous ones
+func (r *repository) GetPasswordHistory(ctx context.Context, id string, limit int) ([]string, error) {
+	var hashes []string
+
+	var current Employee
+	if err := r.db.WithContext(ctx).Select("password_hash").Where("id = ?", id).First(&current).Error; err != nil {
+		return nil, fmt.Errorf("failed to get password of employee %s: %w", id, err)
+	}
+	if current.PasswordHash != nil {
+		hashes = append(hashes, *current.PasswordHash)
+	}
+
+	if limit <= 0 {
+		return hashes, nil
+	}
+
+	var history []string
+	if err := r.db.WithContext(ctx).Model(&PasswordHistory{}).
+		Where("employee_id = ?", id).
+		Order("created_at DESC").
+		Limit(limit).
+		Pluck("password_hash", &history).Error; err != nil {
+		return nil, fmt.Errorf("failed to get password history of employee %s: %w", id, err)
+	}
+
+	for _, hash := range history {
+		if len(hashes) > 0 && hash == hashes[0] {
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if len(hashes) > limit {
+		hashes = hashes[:limit]
+	}
+	return hashes, nil
+}
+
+func addPasswordHistory(tx *gorm.DB, id, passwordHash string) error {
+	if err := tx.Create(&PasswordHistory{EmployeeID: id, PasswordHash: passwordHash}).Error; err != nil {
+		return fmt.Errorf("failed to record password history: %w", err)
+	}
 	return nil
 }
 
@@ -195,3 +258,19 @@ func (r *repository) GetManagers(ctx context.Context) ([]*Employee, error) {
 
 	return employees, nil
 }
+
+// IsManagedBy reports whether managerID is the direct manager of the employee
+// or the manager of the employee's department
+func (r *repository) IsManagedBy(ctx context.Context, id, managerID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&Employee{}).
+		Joins("LEFT JOIN departments ON departments.id = employees.department_id").
+		Where("employees.id = ?", id).
+		Where("employees.manager_id = ? OR departments.manager_id = ?", managerID, managerID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check reporting line: %w", err)
+	}
+
+	return count > 0, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/dmehra2102/hr-management-system/internal/password"
 	"github.com/dmehra2102/hr-management-system/pkg/logger"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
@@ -20,13 +21,25 @@ type Service interface {
 	GetEmployeesByDepartment(ctx context.Context, departmentID string, page, pageSie int) (*ListEmployeesResponse, error)
 }
 
+// SessionRevoker invalidates the sessions of an employee who must lose access
+type SessionRevoker interface {
+	RevokeEmployeeSessions(ctx context.Context, employeeID string) error
+}
+
 type service struct {
-	repo   Repository
-	logger *logger.Logger
+	repo      Repository
+	sessions  SessionRevoker
+	passwords *password.Policy
+	logger    *logger.Logger
 }
 
-func NewService(repo Repository, logger *logger.Logger) Service {
-	return &service{repo: repo, logger: logger.ServiceLogger("employee")}
+func NewService(repo Repository, sessions SessionRevoker, passwords *password.Policy, logger *logger.Logger) Service {
+	return &service{
+		repo:      repo,
+		sessions:  sessions,
+		passwords: passwords,
+		logger:    logger.ServiceLogger("employee"),
+	}
 }
 
 func (s *service) DeleteEmployee(ctx context.Context, id string) error {
@@ -48,6 +61,8 @@ func (s *service) DeleteEmployee(ctx context.Context, id string) error {
 		return status.Error(codes.Internal, "Failed to delete employee")
 	}
 
+	s.revokeSessions(ctx, id)
+
 	s.logger.Info("Employee deleted successfully", "id", id, "employee_id", employee.EmployeeID)
 	return nil
 }
@@ -67,6 +82,11 @@ func (s *service) GetEmployee(ctx context.Context, id string) (*Employee, error)
 func (s *service) CreateEmployee(ctx context.Context, req *CreateEmployeeRequest) (*Employee, error) {
 	s.logger.Info("Creating new employee", "email", req.Email, "employee_id", req.EmployeeID)
 
+	if violations := s.passwords.Validate("password", req.Password); len(violations) > 0 {
+		s.logger.Warn("Password rejected by policy", "employee_id", req.EmployeeID, "violations", len(violations))
+		return nil, password.InvalidArgument(violations)
+	}
+
 	// Check if employee with same email or employee ID already exists
 	if existingEmp, _ := s.repo.GetByEmail(ctx, req.Email); existingEmp != nil {
 		s.logger.Warn("Employee with email already exists", "email", req.Email)
@@ -93,6 +113,13 @@ func (s *service) CreateEmployee(ctx context.Context, req *CreateEmployeeRequest
 		// TODO : check wheather department exists or not
 	}
 
+	if req.ManagerID != nil {
+		if _, err := s.repo.GetByID(ctx, *req.ManagerID); err != nil {
+			s.logger.Warn("Manager not found", "manager_id", *req.ManagerID)
+			return nil, status.Error(codes.InvalidArgument, "Manager not found")
+		}
+	}
+
 	if err := s.repo.Create(ctx, employee); err != nil {
 		s.logger.Error("Failed to create employee", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to create employee")
@@ -126,6 +153,17 @@ func (s *service) UpdateEmployee(ctx context.Context, id string, req *UpdateEmpl
 		}
 	}
 
+	if req.ManagerID != nil {
+		if *req.ManagerID == id {
+			return nil, status.Error(codes.InvalidArgument, "Employee cannot be their own manager")
+		}
+		if _, err := s.repo.GetByID(ctx, *req.ManagerID); err != nil {
+			s.logger.Warn("Manager not found", "manager_id", *req.ManagerID)
+			return nil, status.Error(codes.InvalidArgument, "Manager not found")
+		}
+	}
+
+	previousStatus := employee.Status
 	employee.ApplyUpdate(req)
 
 	if err := s.repo.Update(ctx, employee); err != nil {
@@ -133,6 +171,10 @@ func (s *service) UpdateEmployee(ctx context.Context, id string, req *UpdateEmpl
 		return nil, status.Error(codes.Internal, "Failed to update employee")
 	}
 
+	if employee.Status != previousStatus && (employee.Status == "TERMINATED" || employee.Status == "INACTIVE") {
+		s.revokeSessions(ctx, id)
+	}
+
 	s.logger.Info("Emmployee updated successfully", "id", id)
 
 	// Get updated employee with relationships
@@ -199,6 +241,17 @@ func (s *service) GetEmployeesByDepartment(ctx context.Context, departmentID str
 	return response, nil
 }
 
+// revokeSessions logs out an employee who can no longer sign in, failures are
+// logged only since the employee change itself has been saved
+func (s *service) revokeSessions(ctx context.Context, id string) {
+	if s.sessions == nil {
+		return
+	}
+	if err := s.sessions.RevokeEmployeeSessions(ctx, id); err != nil {
+		s.logger.Error("Failed to revoke employee sessions", "id", id, "error", err)
+	}
+}
+
 func (s *service) hashPassword(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
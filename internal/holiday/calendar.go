@@ -0,0 +1,89 @@
+package holiday
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Calendar counts working days from the configured weekend days and the holiday calendars
+type Calendar struct {
+	repo     Repository
+	weekends map[time.Weekday]bool
+}
+
+// WorkingDays is the outcome of counting the working days of a period
+type WorkingDays struct {
+	Days     int
+	Excluded []ExcludedDate
+}
+
+func NewCalendar(repo Repository, weekends []time.Weekday) *Calendar {
+	days := make(map[time.Weekday]bool, len(weekends))
+	for _, day := range weekends {
+		days[day] = true
+	}
+	return &Calendar{repo: repo, weekends: days}
+}
+
+// WorkingDays counts the days between start and end inclusive that are neither weekend
+// days nor holidays observed in the country at the location
+func (c *Calendar) WorkingDays(ctx context.Context, country, location string, start, end time.Time) (*WorkingDays, error) {
+	start, end = DateOf(start), DateOf(end)
+
+	holidays, err := c.repo.InRange(ctx, NormalizeCountry(country), strings.TrimSpace(location), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[time.Time]*Holiday, len(holidays))
+	for _, h := range holidays {
+		byDate[DateOf(h.Date)] = h
+	}
+
+	result := &WorkingDays{Excluded: []ExcludedDate{}}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		// A holiday is reported by name even when it falls on a weekend
+		if h, ok := byDate[day]; ok {
+			result.Excluded = append(result.Excluded, ExcludedDate{Date: day, Reason: ReasonHoliday, Name: h.Name})
+			continue
+		}
+		if c.weekends[day.Weekday()] {
+			result.Excluded = append(result.Excluded, ExcludedDate{Date: day, Reason: ReasonWeekend})
+			continue
+		}
+		result.Days++
+	}
+
+	return result, nil
+}
+
+// Holidays returns the holidays observed in the country at the location between start and end inclusive
+func (c *Calendar) Holidays(ctx context.Context, country, location string, start, end time.Time) ([]*Holiday, error) {
+	return c.repo.InRange(ctx, NormalizeCountry(country), strings.TrimSpace(location), DateOf(start), DateOf(end))
+}
+
+// ParseWeekdays turns weekday names such as SATURDAY or sun into weekdays
+func ParseWeekdays(names []string) ([]time.Weekday, error) {
+	weekdays := make([]time.Weekday, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(name, day.String()) || strings.EqualFold(name, day.String()[:3]) {
+				weekdays = append(weekdays, day)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown weekday: %s", name)
+		}
+	}
+	return weekdays, nil
+}
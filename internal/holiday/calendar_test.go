@@ -0,0 +1,139 @@
+package holiday
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeRepository serves InRange from a fixed list of holidays
+type fakeRepository struct {
+	Repository
+	holidays []*Holiday
+}
+
+func (f *fakeRepository) InRange(_ context.Context, _, _ string, start, end time.Time) ([]*Holiday, error) {
+	var found []*Holiday
+	for _, h := range f.holidays {
+		if !h.Date.Before(start) && !h.Date.After(end) {
+			found = append(found, h)
+		}
+	}
+	return found, nil
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarWorkingDays(t *testing.T) {
+	newYear := &Holiday{Name: "New Year", Date: date(2027, time.January, 1)}
+	boxingDay := &Holiday{Name: "Boxing Day", Date: date(2026, time.December, 26)}
+	calendar := NewCalendar(&fakeRepository{holidays: []*Holiday{newYear, boxingDay}}, []time.Weekday{time.Saturday, time.Sunday})
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		days     int
+		excluded []ExcludedDate
+	}{
+		{
+			name:     "single working day",
+			start:    date(2026, time.December, 31),
+			end:      date(2026, time.December, 31),
+			days:     1,
+			excluded: []ExcludedDate{},
+		},
+		{
+			name:  "Dec 31 to Jan 1 skips the new year holiday",
+			start: date(2026, time.December, 31),
+			end:   date(2027, time.January, 1),
+			days:  1,
+			excluded: []ExcludedDate{
+				{Date: date(2027, time.January, 1), Reason: ReasonHoliday, Name: "New Year"},
+			},
+		},
+		{
+			name:  "week across the year end",
+			start: date(2026, time.December, 28),
+			end:   date(2027, time.January, 3),
+			days:  4,
+			excluded: []ExcludedDate{
+				{Date: date(2027, time.January, 1), Reason: ReasonHoliday, Name: "New Year"},
+				{Date: date(2027, time.January, 2), Reason: ReasonWeekend},
+				{Date: date(2027, time.January, 3), Reason: ReasonWeekend},
+			},
+		},
+		{
+			name:  "holiday on a weekend is reported as holiday",
+			start: date(2026, time.December, 26),
+			end:   date(2026, time.December, 27),
+			days:  0,
+			excluded: []ExcludedDate{
+				{Date: date(2026, time.December, 26), Reason: ReasonHoliday, Name: "Boxing Day"},
+				{Date: date(2026, time.December, 27), Reason: ReasonWeekend},
+			},
+		},
+		{
+			name:  "times of day are dropped in UTC",
+			start: time.Date(2026, time.December, 31, 23, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+			end:   time.Date(2027, time.January, 1, 18, 30, 0, 0, time.UTC),
+			days:  0,
+			excluded: []ExcludedDate{
+				{Date: date(2027, time.January, 1), Reason: ReasonHoliday, Name: "New Year"},
+			},
+		},
+		{
+			name:     "end before start",
+			start:    date(2027, time.January, 1),
+			end:      date(2026, time.December, 31),
+			days:     0,
+			excluded: []ExcludedDate{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calendar.WorkingDays(context.Background(), "us", "", tt.start, tt.end)
+			if err != nil {
+				t.Fatalf("WorkingDays() error = %v", err)
+			}
+			if got.Days != tt.days {
+				t.Errorf("WorkingDays() days = %d, want %d", got.Days, tt.days)
+			}
+			if !reflect.DeepEqual(got.Excluded, tt.excluded) {
+				t.Errorf("WorkingDays() excluded = %+v, want %+v", got.Excluded, tt.excluded)
+			}
+		})
+	}
+}
+
+func TestParseWeekdays(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []time.Weekday
+		wantErr bool
+	}{
+		{name: "full names", names: []string{"SATURDAY", "SUNDAY"}, want: []time.Weekday{time.Saturday, time.Sunday}},
+		{name: "short names in any case", names: []string{"fri", "Sat"}, want: []time.Weekday{time.Friday, time.Saturday}},
+		{name: "blanks are skipped", names: []string{" sunday ", ""}, want: []time.Weekday{time.Sunday}},
+		{name: "none", names: nil, want: []time.Weekday{}},
+		{name: "unknown name", names: []string{"Sat", "Funday"}, wantErr: true},
+		{name: "partial name", names: []string{"satur"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWeekdays(tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWeekdays() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseWeekdays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package holiday
+
+import (
+	"context"
+	"time"
+
+	holidaypb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/holiday"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	holidaypb.UnimplementedHolidayServiceServer
+	service Service
+	logger  *logger.Logger
+}
+
+func NewHandler(service Service, logger *logger.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.HandlerLogger("holiday"),
+	}
+}
+
+func (h *Handler) CreateHoliday(ctx context.Context, req *holidaypb.CreateHolidayRequest) (*holidaypb.CreateHolidayResponse, error) {
+	h.logger.Info("CreateHoliday called", "name", req.Name, "country", req.Country)
+
+	holiday, err := h.service.CreateHoliday(ctx, &CreateHolidayRequest{
+		Name:     req.Name,
+		Date:     timeFromProto(req.Date),
+		Country:  req.Country,
+		Location: req.Location,
+	})
+	if err != nil {
+		h.logger.Error("Failed to create holiday", "error", err)
+		return nil, err
+	}
+
+	return &holidaypb.CreateHolidayResponse{
+		Holiday: holiday.ToProto(),
+	}, nil
+}
+
+func (h *Handler) GetHoliday(ctx context.Context, req *holidaypb.GetHolidayRequest) (*holidaypb.GetHolidayResponse, error) {
+	h.logger.Info("GetHoliday called", "id", req.Id)
+
+	holiday, err := h.service.GetHoliday(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to get holiday", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &holidaypb.GetHolidayResponse{
+		Holiday: holiday.ToProto(),
+	}, nil
+}
+
+func (h *Handler) UpdateHoliday(ctx context.Context, req *holidaypb.UpdateHolidayRequest) (*holidaypb.UpdateHolidayResponse, error) {
+	h.logger.Info("UpdateHoliday called", "id", req.Id)
+
+	holiday, err := h.service.UpdateHoliday(ctx, req.Id, &UpdateHolidayRequest{
+		Name:     req.Name,
+		Date:     timeFromProto(req.Date),
+		Country:  req.Country,
+		Location: req.Location,
+	})
+	if err != nil {
+		h.logger.Error("Failed to update holiday", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &holidaypb.UpdateHolidayResponse{
+		Holiday: holiday.ToProto(),
+	}, nil
+}
+
+func (h *Handler) DeleteHoliday(ctx context.Context, req *holidaypb.DeleteHolidayRequest) (*emptypb.Empty, error) {
+	h.logger.Info("DeleteHoliday called", "id", req.Id)
+
+	if err := h.service.DeleteHoliday(ctx, req.Id); err != nil {
+		h.logger.Error("Failed to delete holiday", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListHolidays(ctx context.Context, req *holidaypb.ListHolidaysRequest) (*holidaypb.ListHolidaysResponse, error) {
+	h.logger.Info("ListHolidays called", "country", req.Country, "location", req.Location, "year", req.Year)
+
+	response, err := h.service.ListHolidays(ctx, &ListHolidaysRequest{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		Country:  req.Country,
+		Location: req.Location,
+		Year:     int(req.Year),
+	})
+	if err != nil {
+		h.logger.Error("Failed to list holidays", "error", err)
+		return nil, err
+	}
+
+	holidays := make([]*holidaypb.Holiday, len(response.Holidays))
+	for i, holiday := range response.Holidays {
+		holidays[i] = holiday.ToProto()
+	}
+
+	return &holidaypb.ListHolidaysResponse{
+		Holidays:   holidays,
+		TotalCount: int32(response.TotalCount),
+		Page:       int32(response.Page),
+		PageSize:   int32(response.PageSize),
+	}, nil
+}
+
+// timeFromProto returns the zero time for unset timestamps
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
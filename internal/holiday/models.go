@@ -0,0 +1,115 @@
+package holiday
+
+import (
+	"strings"
+	"time"
+
+	holidaypb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/holiday"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Reasons a date is excluded from the working days of a leave request
+const (
+	ReasonWeekend = "WEEKEND"
+	ReasonHoliday = "HOLIDAY"
+)
+
+type Holiday struct {
+	ID       string    `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name     string    `json:"name" gorm:"not null"`
+	Date     time.Time `json:"date" gorm:"type:date;not null"`
+	Country  string    `json:"country" gorm:"not null"`
+	Location string    `json:"location"` // Empty for holidays of the whole country
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
+// ExcludedDate is a date in a leave period that doesn't count as a working day
+type ExcludedDate struct {
+	Date   time.Time `json:"date"`
+	Reason string    `json:"reason"`
+	Name   string    `json:"name,omitempty"`
+}
+
+type CreateHolidayRequest struct {
+	Name     string    `json:"name" validate:"required,max=100"`
+	Date     time.Time `json:"date" validate:"required"`
+	Country  string    `json:"country" validate:"required"`
+	Location string    `json:"location,omitempty"`
+}
+
+type UpdateHolidayRequest struct {
+	Name     string    `json:"name,omitempty" validate:"omitempty,max=100"`
+	Date     time.Time `json:"date,omitempty"`
+	Country  string    `json:"country,omitempty"`
+	Location string    `json:"location,omitempty"`
+}
+
+type ListHolidaysRequest struct {
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+	Country  string `json:"country,omitempty"`
+	Location string `json:"location,omitempty"`
+	Year     int    `json:"year,omitempty"`
+}
+
+type ListHolidaysResponse struct {
+	Holidays   []*Holiday `json:"holidays"`
+	TotalCount int64      `json:"total_count"`
+	Page       int        `json:"page"`
+	PageSize   int        `json:"page_size"`
+}
+
+func (h *Holiday) ToProto() *holidaypb.Holiday {
+	return &holidaypb.Holiday{
+		Id:        h.ID,
+		Name:      h.Name,
+		Date:      timestamppb.New(h.Date),
+		Country:   h.Country,
+		Location:  h.Location,
+		CreatedAt: timestamppb.New(h.CreatedAt),
+		UpdatedAt: timestamppb.New(h.UpdatedAt),
+	}
+}
+
+func FromCreateRequest(req *CreateHolidayRequest) *Holiday {
+	return &Holiday{
+		Name:     req.Name,
+		Date:     DateOf(req.Date),
+		Country:  NormalizeCountry(req.Country),
+		Location: strings.TrimSpace(req.Location),
+	}
+}
+
+func (h *Holiday) ApplyUpdate(req *UpdateHolidayRequest) {
+	if req.Name != "" {
+		h.Name = req.Name
+	}
+	if !req.Date.IsZero() {
+		h.Date = DateOf(req.Date)
+	}
+	if req.Country != "" {
+		h.Country = NormalizeCountry(req.Country)
+	}
+	if req.Location != "" {
+		h.Location = strings.TrimSpace(req.Location)
+	}
+}
+
+// DateOf strips the time of day, dates are compared in UTC like they are stored
+func DateOf(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// NormalizeCountry makes country codes match regardless of how they were typed
+func NormalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
@@ -0,0 +1,137 @@
+package holiday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrHolidayNotFound = errors.New("holiday not found")
+
+type Repository interface {
+	Create(ctx context.Context, holiday *Holiday) error
+	GetByID(ctx context.Context, id string) (*Holiday, error)
+	GetByDate(ctx context.Context, country, location string, date time.Time) (*Holiday, error)
+	Update(ctx context.Context, holiday *Holiday) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error)
+	// InRange returns the holidays observed at the location between start and end inclusive,
+	// which are the holidays of the whole country and those of the location itself
+	InRange(ctx context.Context, country, location string, start, end time.Time) ([]*Holiday, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, holiday *Holiday) error {
+	if err := r.db.WithContext(ctx).Create(holiday).Error; err != nil {
+		return fmt.Errorf("failed to create holiday: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetByID(ctx context.Context, id string) (*Holiday, error) {
+	var holiday Holiday
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&holiday).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("holiday with id %s: %w", id, ErrHolidayNotFound)
+		}
+		return nil, fmt.Errorf("failed to get holiday by ID (%s): %w", id, err)
+	}
+	return &holiday, nil
+}
+
+func (r *repository) GetByDate(ctx context.Context, country, location string, date time.Time) (*Holiday, error) {
+	var holiday Holiday
+	err := r.db.WithContext(ctx).
+		Where("country = ? AND LOWER(location) = LOWER(?) AND date = ?", country, location, DateOf(date)).
+		First(&holiday).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrHolidayNotFound
+		}
+		return nil, fmt.Errorf("failed to get holiday by date: %w", err)
+	}
+	return &holiday, nil
+}
+
+func (r *repository) Update(ctx context.Context, holiday *Holiday) error {
+	if err := r.db.WithContext(ctx).
+		Model(&Holiday{}).
+		Where("id = ?", holiday.ID).
+		Updates(map[string]any{
+			"name":     holiday.Name,
+			"date":     holiday.Date,
+			"country":  holiday.Country,
+			"location": holiday.Location,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update holiday: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&Holiday{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete holiday with id %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrHolidayNotFound
+	}
+	return nil
+}
+
+func (r *repository) List(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	var holidays []*Holiday
+	var totalCount int64
+
+	query := r.db.WithContext(ctx).Model(&Holiday{})
+
+	if req.Country != "" {
+		query = query.Where("country = ?", req.Country)
+	}
+	if req.Location != "" {
+		query = query.Where("LOWER(location) = LOWER(?)", req.Location)
+	}
+	if req.Year != 0 {
+		query = query.Where("EXTRACT(YEAR FROM date) = ?", req.Year)
+	}
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, fmt.Errorf("failed to count holidays: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	if err := query.Offset(offset).Limit(req.PageSize).Order("date ASC").Find(&holidays).Error; err != nil {
+		return nil, fmt.Errorf("failed to list holidays: %w", err)
+	}
+
+	return &ListHolidaysResponse{
+		Holidays:   holidays,
+		TotalCount: totalCount,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}, nil
+}
+
+func (r *repository) InRange(ctx context.Context, country, location string, start, end time.Time) ([]*Holiday, error) {
+	var holidays []*Holiday
+	err := r.db.WithContext(ctx).
+		Where("country = ? AND (location = '' OR LOWER(location) = LOWER(?))", country, location).
+		Where("date BETWEEN ? AND ?", DateOf(start), DateOf(end)).
+		Order("date ASC").
+		Find(&holidays).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays between %s and %s: %w", start.Format(time.DateOnly), end.Format(time.DateOnly), err)
+	}
+	return holidays, nil
+}
@@ -0,0 +1,154 @@
+package holiday
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service interface {
+	CreateHoliday(ctx context.Context, req *CreateHolidayRequest) (*Holiday, error)
+	GetHoliday(ctx context.Context, id string) (*Holiday, error)
+	UpdateHoliday(ctx context.Context, id string, req *UpdateHolidayRequest) (*Holiday, error)
+	DeleteHoliday(ctx context.Context, id string) error
+	ListHolidays(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error)
+}
+
+type service struct {
+	repo   Repository
+	logger *logger.Logger
+}
+
+func NewService(repo Repository, logger *logger.Logger) Service {
+	return &service{
+		repo:   repo,
+		logger: logger.ServiceLogger("holiday"),
+	}
+}
+
+func (s *service) CreateHoliday(ctx context.Context, req *CreateHolidayRequest) (*Holiday, error) {
+	s.logger.Info("Creating holiday", "name", req.Name, "country", req.Country, "location", req.Location)
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Holiday name is required")
+	}
+	if req.Date.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "Holiday date is required")
+	}
+	if req.Country == "" {
+		return nil, status.Error(codes.InvalidArgument, "Country is required")
+	}
+
+	holiday := FromCreateRequest(req)
+
+	if err := s.checkDuplicate(ctx, holiday); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, holiday); err != nil {
+		s.logger.Error("Failed to create holiday", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create holiday")
+	}
+
+	s.logger.Info("Holiday created successfully", "id", holiday.ID, "date", holiday.Date)
+	return holiday, nil
+}
+
+func (s *service) GetHoliday(ctx context.Context, id string) (*Holiday, error) {
+	s.logger.Info("Getting holiday", "id", id)
+
+	holiday, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get holiday", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get holiday")
+	}
+	return holiday, nil
+}
+
+func (s *service) UpdateHoliday(ctx context.Context, id string, req *UpdateHolidayRequest) (*Holiday, error) {
+	s.logger.Info("Updating holiday", "id", id)
+
+	holiday, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get holiday for update", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get holiday")
+	}
+
+	holiday.ApplyUpdate(req)
+
+	if err := s.checkDuplicate(ctx, holiday); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, holiday); err != nil {
+		s.logger.Error("Failed to update holiday", "id", id, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update holiday")
+	}
+
+	s.logger.Info("Holiday updated successfully", "id", id)
+	return s.GetHoliday(ctx, id)
+}
+
+func (s *service) DeleteHoliday(ctx context.Context, id string) error {
+	s.logger.Info("Deleting holiday", "id", id)
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.logger.Error("Failed to delete holiday", "id", id, "error", err)
+		return statusFromError(err, "Failed to delete holiday")
+	}
+
+	s.logger.Info("Holiday deleted successfully", "id", id)
+	return nil
+}
+
+func (s *service) ListHolidays(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	s.logger.Info("Listing holidays", "country", req.Country, "location", req.Location, "year", req.Year)
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+	req.Country = NormalizeCountry(req.Country)
+
+	response, err := s.repo.List(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list holidays", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list holidays")
+	}
+
+	s.logger.Info("Successfully listed holidays", "count", len(response.Holidays), "total", response.TotalCount)
+	return response, nil
+}
+
+// checkDuplicate rejects a second holiday on the same date of the same calendar
+func (s *service) checkDuplicate(ctx context.Context, holiday *Holiday) error {
+	existing, err := s.repo.GetByDate(ctx, holiday.Country, holiday.Location, holiday.Date)
+	if err != nil {
+		if errors.Is(err, ErrHolidayNotFound) {
+			return nil
+		}
+		s.logger.Error("Failed to check for duplicate holiday", "error", err)
+		return status.Error(codes.Internal, "Failed to check for duplicate holiday")
+	}
+
+	if existing.ID != holiday.ID {
+		s.logger.Warn("Holiday already exists", "country", holiday.Country, "location", holiday.Location, "date", holiday.Date)
+		return status.Error(codes.AlreadyExists, "A holiday already exists on this date for this country and location")
+	}
+	return nil
+}
+
+func statusFromError(err error, internalMessage string) error {
+	if errors.Is(err, ErrHolidayNotFound) {
+		return status.Error(codes.NotFound, "Holiday not found")
+	}
+	return status.Error(codes.Internal, internalMessage)
+}
@@ -0,0 +1,201 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+)
+
+// prorationCutoffDay is the last day of a month on which becoming eligible
+// still earns that month, later dates start accruing the month after
+const prorationCutoffDay = 15
+
+var ErrAccrualFutureYear = errors.New("cannot accrue leave for a future year")
+
+// AccrualEngine creates and tops up leave balances from the leave policies.
+// Runs are idempotent: every balance remembers the days accrued so far and
+// only the difference to the current entitlement is credited.
+type AccrualEngine struct {
+	repo   Repository
+	logger *logger.Logger
+}
+
+func NewAccrualEngine(repo Repository, logger *logger.Logger) *AccrualEngine {
+	return &AccrualEngine{
+		repo:   repo,
+		logger: logger.ServiceLogger("leave_accrual"),
+	}
+}
+
+// Run accrues the leave of req.Year as of now. Past years are accrued as of
+// their last day, which backfills balances that were never created.
+func (a *AccrualEngine) Run(ctx context.Context, req *RunAccrualRequest, now time.Time) (*RunAccrualResponse, error) {
+	today := holiday.DateOf(now)
+	if req.Year == 0 {
+		req.Year = today.Year()
+	}
+	if req.Year > today.Year() {
+		return nil, ErrAccrualFutureYear
+	}
+
+	asOf := today
+	if req.Year < today.Year() {
+		asOf = time.Date(req.Year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+
+	policies, err := a.repo.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	employees, err := a.repo.ListAccrualEmployees(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunAccrualResponse{
+		Accruals: []*Accrual{},
+		Year:     req.Year,
+		AsOf:     asOf,
+		DryRun:   req.DryRun,
+	}
+
+	for _, employee := range employees {
+		accruals, err := a.accrueEmployee(ctx, employee, policies, req.Year, asOf, req.DryRun)
+		if err != nil {
+			a.logger.Error("Failed to accrue leave", "employee_id", employee.ID, "year", req.Year, "error", err)
+			response.EmployeesFailed++
+			continue
+		}
+
+		response.EmployeesProcessed++
+		for _, accrual := range accruals {
+			response.TotalCreditedDays = RoundDays(response.TotalCreditedDays + accrual.CreditedDays)
+		}
+		response.Accruals = append(response.Accruals, accruals...)
+	}
+
+	a.logger.Info("Leave accrual finished",
+		"year", req.Year,
+		"as_of", asOf.Format(time.DateOnly),
+		"dry_run", req.DryRun,
+		"employees", response.EmployeesProcessed,
+		"failed", response.EmployeesFailed,
+		"credited_days", response.TotalCreditedDays,
+	)
+	return response, nil
+}
+
+// Schedule runs the accrual of the current year right away and then every interval until ctx is done
+func (a *AccrualEngine) Schedule(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.Run(ctx, &RunAccrualRequest{}, time.Now()); err != nil {
+			a.logger.Error("Scheduled leave accrual failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *AccrualEngine) accrueEmployee(ctx context.Context, employee *Employee, policies []*LeavePolicy, year int, asOf time.Time, dryRun bool) ([]*Accrual, error) {
+	accruals := make([]*Accrual, 0, len(policies))
+	for _, policy := range policies {
+		if !policy.Active {
+			continue
+		}
+
+		entitled := policy.Entitlement(employee.HireDate, year, asOf)
+
+		if dryRun {
+			accrual, err := a.preview(ctx, employee.ID, policy.LeaveType, year, entitled)
+			if err != nil {
+				return nil, err
+			}
+			accruals = append(accruals, accrual)
+			continue
+		}
+
+		accrual, err := a.repo.ApplyAccrual(ctx, employee.ID, policy.LeaveType, year, entitled)
+		if err != nil {
+			return nil, fmt.Errorf("failed to accrue %s leave: %w", policy.LeaveType, err)
+		}
+		accruals = append(accruals, accrual)
+	}
+	return accruals, nil
+}
+
+// preview reports what ApplyAccrual would credit without writing anything
+func (a *AccrualEngine) preview(ctx context.Context, employeeID, leaveType string, year int, entitled float64) (*Accrual, error) {
+	var accrued float64
+	balance, err := a.repo.GetBalance(ctx, employeeID, leaveType, year)
+	switch {
+	case err == nil && balance.ClosedAt != nil:
+		return nil, ErrBalanceClosed
+	case err == nil:
+		accrued = balance.AccruedDays
+	case !errors.Is(err, ErrBalanceNotFound):
+		return nil, err
+	}
+
+	return &Accrual{
+		EmployeeID:            employeeID,
+		LeaveType:             leaveType,
+		Year:                  year,
+		EntitledDays:          entitled,
+		PreviouslyAccruedDays: accrued,
+		CreditedDays:          creditFor(accrued, entitled),
+	}, nil
+}
+
+// Entitlement returns the days of the policy accrued in year as of asOf by an
+// employee hired on hireDate. Accrual starts once probation is over, the month
+// it ends in counts when it ends by the proration cutoff day.
+func (p *LeavePolicy) Entitlement(hireDate time.Time, year int, asOf time.Time) float64 {
+	eligibleFrom := holiday.DateOf(hireDate).AddDate(0, p.ProbationMonths, 0)
+	yearStart := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if eligibleFrom.Before(yearStart) {
+		eligibleFrom = yearStart
+	}
+	if eligibleFrom.Year() > year || eligibleFrom.After(asOf) {
+		return 0
+	}
+
+	firstMonth := int(eligibleFrom.Month())
+	if eligibleFrom.Day() > prorationCutoffDay {
+		firstMonth++
+	}
+
+	lastMonth := 12
+	if p.AccrualMethod == AccrualMonthly && asOf.Year() == year {
+		lastMonth = int(asOf.Month())
+	}
+
+	months := lastMonth - firstMonth + 1
+	if months <= 0 {
+		return 0
+	}
+	return RoundDays(p.DaysPerYear * float64(months) / 12)
+}
+
+// creditFor returns the days to credit on top of those accrued before. Lowering
+// a policy never takes credited days back.
+func creditFor(accrued, entitled float64) float64 {
+	if entitled <= accrued {
+		return 0
+	}
+	return RoundDays(entitled - accrued)
+}
@@ -0,0 +1,181 @@
+package leave
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+)
+
+func TestLeavePolicyEntitlement(t *testing.T) {
+	monthly := &LeavePolicy{AccrualMethod: AccrualMonthly, DaysPerYear: 24}
+	annual := &LeavePolicy{AccrualMethod: AccrualAnnual, DaysPerYear: 24}
+	longAgo := date(2020, time.March, 2)
+
+	tests := []struct {
+		name     string
+		policy   *LeavePolicy
+		hireDate time.Time
+		year     int
+		asOf     time.Time
+		want     float64
+	}{
+		{
+			name:     "monthly on Dec 31 credits the whole year",
+			policy:   monthly,
+			hireDate: longAgo,
+			year:     2026,
+			asOf:     date(2026, time.December, 31),
+			want:     24,
+		},
+		{
+			name:     "monthly on Jan 1 credits the first month",
+			policy:   monthly,
+			hireDate: longAgo,
+			year:     2027,
+			asOf:     date(2027, time.January, 1),
+			want:     2,
+		},
+		{
+			name:     "monthly backfill of the past year on Jan 1",
+			policy:   monthly,
+			hireDate: longAgo,
+			year:     2026,
+			asOf:     date(2027, time.January, 1),
+			want:     24,
+		},
+		{
+			name:     "annual on Jan 1 credits the whole year",
+			policy:   annual,
+			hireDate: longAgo,
+			year:     2027,
+			asOf:     date(2027, time.January, 1),
+			want:     24,
+		},
+		{
+			name:     "nothing for a year that has not started",
+			policy:   annual,
+			hireDate: longAgo,
+			year:     2027,
+			asOf:     date(2026, time.December, 31),
+			want:     0,
+		},
+		{
+			name:     "hired by the cutoff day counts the month",
+			policy:   annual,
+			hireDate: date(2026, time.December, 15),
+			year:     2026,
+			asOf:     date(2026, time.December, 31),
+			want:     2,
+		},
+		{
+			name:     "hired after the cutoff day in December gets nothing that year",
+			policy:   annual,
+			hireDate: date(2026, time.December, 16),
+			year:     2026,
+			asOf:     date(2026, time.December, 31),
+			want:     0,
+		},
+		{
+			name:     "hired on Dec 31 accrues from January",
+			policy:   monthly,
+			hireDate: date(2026, time.December, 31),
+			year:     2027,
+			asOf:     date(2027, time.January, 1),
+			want:     2,
+		},
+		{
+			name:     "hired on Jan 1 accrues January",
+			policy:   monthly,
+			hireDate: date(2027, time.January, 1),
+			year:     2027,
+			asOf:     date(2027, time.January, 1),
+			want:     2,
+		},
+		{
+			name:     "probation ending in the next year",
+			policy:   &LeavePolicy{AccrualMethod: AccrualAnnual, DaysPerYear: 24, ProbationMonths: 3},
+			hireDate: date(2026, time.October, 1),
+			year:     2026,
+			asOf:     date(2026, time.December, 31),
+			want:     0,
+		},
+		{
+			name:     "probation ending on Jan 1",
+			policy:   &LeavePolicy{AccrualMethod: AccrualAnnual, DaysPerYear: 24, ProbationMonths: 3},
+			hireDate: date(2026, time.October, 1),
+			year:     2027,
+			asOf:     date(2027, time.January, 1),
+			want:     24,
+		},
+		{
+			name:     "probation not over yet",
+			policy:   &LeavePolicy{AccrualMethod: AccrualMonthly, DaysPerYear: 24, ProbationMonths: 6},
+			hireDate: date(2026, time.September, 1),
+			year:     2027,
+			asOf:     date(2027, time.January, 1),
+			want:     0,
+		},
+		{
+			name:     "fractional days are rounded",
+			policy:   &LeavePolicy{AccrualMethod: AccrualMonthly, DaysPerYear: 10},
+			hireDate: longAgo,
+			year:     2027,
+			asOf:     date(2027, time.January, 1),
+			want:     0.8333,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Entitlement(tt.hireDate, tt.year, tt.asOf); got != tt.want {
+				t.Errorf("Entitlement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type stubAccrualRepository struct {
+	Repository
+	balances map[string]*LeaveBalance
+}
+
+func (r *stubAccrualRepository) ListPolicies(ctx context.Context) ([]*LeavePolicy, error) {
+	return []*LeavePolicy{{LeaveType: "ANNUAL", AccrualMethod: AccrualAnnual, DaysPerYear: 20, Active: true}}, nil
+}
+
+func (r *stubAccrualRepository) ListAccrualEmployees(ctx context.Context, employeeID string) ([]*Employee, error) {
+	return []*Employee{
+		{ID: "open", HireDate: date(2020, time.January, 6)},
+		{ID: "closed", HireDate: date(2020, time.January, 6)},
+	}, nil
+}
+
+func (r *stubAccrualRepository) GetBalance(ctx context.Context, employeeID, leaveType string, year int) (*LeaveBalance, error) {
+	if balance, ok := r.balances[employeeID]; ok {
+		return balance, nil
+	}
+	return nil, ErrBalanceNotFound
+}
+
+func TestAccrualDryRunSkipsClosedBalances(t *testing.T) {
+	closedAt := time.Now()
+	repo := &stubAccrualRepository{balances: map[string]*LeaveBalance{
+		"open":   {EmployeeID: "open", LeaveType: "ANNUAL", Year: 2024, AccruedDays: 15},
+		"closed": {EmployeeID: "closed", LeaveType: "ANNUAL", Year: 2024, AccruedDays: 15, ClosedAt: &closedAt},
+	}}
+	engine := NewAccrualEngine(repo, logger.NewLogger("panic", "text"))
+
+	resp, err := engine.Run(context.Background(), &RunAccrualRequest{Year: 2024, DryRun: true}, date(2025, time.March, 1))
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if resp.EmployeesProcessed != 1 || resp.EmployeesFailed != 1 {
+		t.Errorf("Run() processed %d and failed %d employees, want 1 and 1", resp.EmployeesProcessed, resp.EmployeesFailed)
+	}
+	if len(resp.Accruals) != 1 || resp.Accruals[0].EmployeeID != "open" || resp.Accruals[0].CreditedDays != 5 {
+		t.Errorf("Run() accruals = %+v, want 5 days credited to the open balance only", resp.Accruals)
+	}
+}
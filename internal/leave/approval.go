@@ -0,0 +1,57 @@
+package leave
+
+// buildApprovalChain resolves the approval rules of the leave type into the steps
+// of the request. Steps without an approver, such as a missing manager, and steps
+// of an approver already in the chain are left out. A request no rule resolves
+// for is decided by HR.
+func buildApprovalChain(rules []*ApprovalRule, leave *LeaveRequest, employee *Employee) []*ApprovalStep {
+	steps := []*ApprovalStep{}
+	approvers := map[string]bool{leave.EmployeeID: true}
+	hasHR := false
+
+	for _, rule := range rules {
+		if rule.MinDays > 0 && leave.DaysRequested <= rule.MinDays {
+			continue
+		}
+
+		step := &ApprovalStep{ApproverType: rule.ApproverType, Status: StepPending}
+		switch rule.ApproverType {
+		case ApproverManager:
+			step.ApproverID = employee.ManagerID
+		case ApproverDepartmentManager:
+			step.ApproverID = employee.DepartmentManagerID
+		case ApproverHR:
+			if hasHR {
+				continue
+			}
+			hasHR = true
+		default:
+			continue
+		}
+
+		if rule.ApproverType != ApproverHR {
+			if step.ApproverID == nil || approvers[*step.ApproverID] {
+				continue
+			}
+			approvers[*step.ApproverID] = true
+		}
+
+		step.StepOrder = len(steps) + 1
+		steps = append(steps, step)
+	}
+
+	if len(steps) == 0 {
+		steps = append(steps, &ApprovalStep{StepOrder: 1, ApproverType: ApproverHR, Status: StepPending})
+	}
+	return steps
+}
+
+// PendingStep returns the approval step awaiting a decision, nil once the request is decided
+func (lr *LeaveRequest) PendingStep() *ApprovalStep {
+	for _, step := range lr.ApprovalSteps {
+		if step.StepOrder == lr.CurrentStep && step.Status == StepPending {
+			return step
+		}
+	}
+	return nil
+}
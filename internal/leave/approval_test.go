@@ -0,0 +1,147 @@
+package leave
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// chainSteps describes each step as "order type approver", the approver is empty for HR
+func chainSteps(steps []*ApprovalStep) []string {
+	var described []string
+	for _, step := range steps {
+		approver := ""
+		if step.ApproverID != nil {
+			approver = *step.ApproverID
+		}
+		described = append(described, fmt.Sprintf("%d %s %s", step.StepOrder, step.ApproverType, approver))
+	}
+	return described
+}
+
+func TestBuildApprovalChain(t *testing.T) {
+	annual := []*ApprovalRule{
+		{StepOrder: 1, ApproverType: ApproverManager},
+		{StepOrder: 2, ApproverType: ApproverDepartmentManager},
+		{StepOrder: 3, ApproverType: ApproverHR, MinDays: 10},
+	}
+	employee := &Employee{ID: "employee", ManagerID: ptr("manager"), DepartmentManagerID: ptr("head")}
+
+	tests := []struct {
+		name     string
+		rules    []*ApprovalRule
+		employee *Employee
+		days     float64
+		want     []string
+	}{
+		{
+			name:     "below the HR threshold",
+			rules:    annual,
+			employee: employee,
+			days:     3,
+			want:     []string{"1 MANAGER manager", "2 DEPARTMENT_MANAGER head"},
+		},
+		{
+			name:     "at the HR threshold",
+			rules:    annual,
+			employee: employee,
+			days:     10,
+			want:     []string{"1 MANAGER manager", "2 DEPARTMENT_MANAGER head"},
+		},
+		{
+			name:     "above the HR threshold",
+			rules:    annual,
+			employee: employee,
+			days:     12,
+			want:     []string{"1 MANAGER manager", "2 DEPARTMENT_MANAGER head", "3 HR "},
+		},
+		{
+			name:     "manager heading the department",
+			rules:    annual,
+			employee: &Employee{ID: "employee", ManagerID: ptr("head"), DepartmentManagerID: ptr("head")},
+			days:     12,
+			want:     []string{"1 MANAGER head", "2 HR "},
+		},
+		{
+			name:     "without a manager",
+			rules:    annual,
+			employee: &Employee{ID: "employee", DepartmentManagerID: ptr("head")},
+			days:     3,
+			want:     []string{"1 DEPARTMENT_MANAGER head"},
+		},
+		{
+			name:     "employee heading the department",
+			rules:    annual,
+			employee: &Employee{ID: "head", ManagerID: ptr("manager"), DepartmentManagerID: ptr("head")},
+			days:     3,
+			want:     []string{"1 MANAGER manager"},
+		},
+		{
+			name:     "no approver resolved",
+			rules:    annual,
+			employee: &Employee{ID: "employee"},
+			days:     3,
+			want:     []string{"1 HR "},
+		},
+		{
+			name:     "HR only",
+			rules:    []*ApprovalRule{{StepOrder: 1, ApproverType: ApproverHR}},
+			employee: employee,
+			days:     3,
+			want:     []string{"1 HR "},
+		},
+		{
+			name:     "HR listed twice",
+			rules:    []*ApprovalRule{{StepOrder: 1, ApproverType: ApproverHR}, {StepOrder: 2, ApproverType: ApproverManager}, {StepOrder: 3, ApproverType: ApproverHR}},
+			employee: employee,
+			days:     3,
+			want:     []string{"1 HR ", "2 MANAGER manager"},
+		},
+		{
+			name:     "no rules",
+			employee: employee,
+			days:     3,
+			want:     []string{"1 HR "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leave := &LeaveRequest{EmployeeID: tt.employee.ID, DaysRequested: tt.days}
+			steps := buildApprovalChain(tt.rules, leave, tt.employee)
+
+			if got := chainSteps(steps); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildApprovalChain() = %q, want %q", got, tt.want)
+			}
+			for _, step := range steps {
+				if step.Status != StepPending {
+					t.Errorf("step %d status = %s, want %s", step.StepOrder, step.Status, StepPending)
+				}
+			}
+		})
+	}
+}
+
+func TestPendingStep(t *testing.T) {
+	leave := &LeaveRequest{
+		CurrentStep: 2,
+		ApprovalSteps: []*ApprovalStep{
+			{StepOrder: 1, Status: StepApproved},
+			{StepOrder: 2, Status: StepPending},
+			{StepOrder: 3, Status: StepPending},
+		},
+	}
+	if step := leave.PendingStep(); step == nil || step.StepOrder != 2 {
+		t.Fatalf("PendingStep() = %+v, want step 2", step)
+	}
+
+	leave.ApprovalSteps[1].Status = StepRejected
+	if step := leave.PendingStep(); step != nil {
+		t.Errorf("PendingStep() = %+v for a decided step, want nil", step)
+	}
+
+	leave.CurrentStep = 0
+	if step := leave.PendingStep(); step != nil {
+		t.Errorf("PendingStep() = %+v for a decided request, want nil", step)
+	}
+}
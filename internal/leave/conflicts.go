@@ -0,0 +1,155 @@
+package leave
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	"github.com/dmehra2102/hr-management-system/internal/department"
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Types of conflicts found when checking a leave request
+const (
+	ConflictOverlap  = "LEAVE_OVERLAP"
+	ConflictStaffing = "MIN_STAFFING"
+)
+
+// Conflict is a clash of a leave request with other leave, either of the same
+// employee or of too many colleagues in the same department
+type Conflict struct {
+	Type        string    `json:"type"`
+	Subject     string    `json:"subject"`
+	Description string    `json:"description"`
+	Date        time.Time `json:"date"`
+}
+
+// StaffingRule limits how many employees of a department may be on leave on the same day
+type StaffingRule struct {
+	DepartmentID          string `json:"department_id"`
+	DepartmentName        string `json:"department_name"`
+	MaxConcurrentAbsences int    `json:"max_concurrent_absences"`
+	Enforcement           string `json:"enforcement"`
+}
+
+// Blocks reports whether breaching the rule prevents leave from being approved
+func (r *StaffingRule) Blocks() bool {
+	return r.Enforcement == department.StaffingBlock
+}
+
+// Conflicts returns a conflict for every working day of leave on which the
+// employee and the colleagues on approved absences would exceed the rule
+func (r *StaffingRule) Conflicts(leave *LeaveRequest, absences []*LeaveRequest) []*Conflict {
+	var conflicts []*Conflict
+	if r.MaxConcurrentAbsences <= 0 {
+		return conflicts
+	}
+
+	for day := holiday.DateOf(leave.StartDate); !day.After(holiday.DateOf(leave.EndDate)); day = day.AddDate(0, 0, 1) {
+		if !leave.Covers(day) {
+			continue
+		}
+
+		absent := map[string]bool{leave.EmployeeID: true}
+		for _, other := range absences {
+			if other.Covers(day) {
+				absent[other.EmployeeID] = true
+			}
+		}
+
+		if len(absent) > r.MaxConcurrentAbsences {
+			conflicts = append(conflicts, &Conflict{
+				Type:    ConflictStaffing,
+				Subject: "departments/" + r.DepartmentID,
+				Description: fmt.Sprintf("%d employees of %s would be absent on %s, at most %d may be",
+					len(absent), r.DepartmentName, day.Format(time.DateOnly), r.MaxConcurrentAbsences),
+				Date: day,
+			})
+		}
+	}
+	return conflicts
+}
+
+// Covers reports whether the employee is off on day, dates excluded from the
+// leave such as weekends and holidays are not covered
+func (lr *LeaveRequest) Covers(day time.Time) bool {
+	day = holiday.DateOf(day)
+	if day.Before(holiday.DateOf(lr.StartDate)) || day.After(holiday.DateOf(lr.EndDate)) {
+		return false
+	}
+	for _, excluded := range lr.ExcludedDates {
+		if holiday.DateOf(excluded.Date).Equal(day) {
+			return false
+		}
+	}
+	return true
+}
+
+// Overlaps reports whether two leave requests book the same time. Partial days on
+// the same date only clash when they claim the same half or more than a day together.
+func (lr *LeaveRequest) Overlaps(other *LeaveRequest) bool {
+	if holiday.DateOf(lr.StartDate).After(holiday.DateOf(other.EndDate)) ||
+		holiday.DateOf(other.StartDate).After(holiday.DateOf(lr.EndDate)) {
+		return false
+	}
+	if lr.Duration == DurationFullDay || other.Duration == DurationFullDay {
+		return true
+	}
+	if lr.Duration == other.Duration && lr.Duration != DurationHourly {
+		return true
+	}
+	return RoundDays(lr.DaysRequested+other.DaysRequested) > 1
+}
+
+// overlapConflict describes the existing leave a request overlaps
+func overlapConflict(other *LeaveRequest) *Conflict {
+	return &Conflict{
+		Type:    ConflictOverlap,
+		Subject: "leaves/" + other.ID,
+		Description: fmt.Sprintf("Overlaps %s %s leave from %s to %s",
+			strings.ToLower(other.LeaveStatus), other.LeaveType,
+			other.StartDate.Format(time.DateOnly), other.EndDate.Format(time.DateOnly)),
+		Date: holiday.DateOf(other.StartDate),
+	}
+}
+
+// conflictError returns a status carrying the conflicts as PreconditionFailure details
+func conflictError(code codes.Code, message string, conflicts []*Conflict) error {
+	violations := make([]*errdetails.PreconditionFailure_Violation, len(conflicts))
+	for i, conflict := range conflicts {
+		violations[i] = &errdetails.PreconditionFailure_Violation{
+			Type:        conflict.Type,
+			Subject:     conflict.Subject,
+			Description: conflict.Description,
+		}
+	}
+
+	st := status.New(code, message)
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (c *Conflict) ToProto() *leavepb.LeaveConflict {
+	return &leavepb.LeaveConflict{
+		Type:        c.Type,
+		Subject:     c.Subject,
+		Description: c.Description,
+		Date:        timestamppb.New(c.Date),
+	}
+}
+
+func conflictsToProto(conflicts []*Conflict) []*leavepb.LeaveConflict {
+	result := make([]*leavepb.LeaveConflict, len(conflicts))
+	for i, conflict := range conflicts {
+		result[i] = conflict.ToProto()
+	}
+	return result
+}
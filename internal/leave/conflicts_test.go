@@ -0,0 +1,195 @@
+package leave
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+)
+
+func fullDays(employeeID string, start, end time.Time) *LeaveRequest {
+	return &LeaveRequest{EmployeeID: employeeID, StartDate: start, EndDate: end, Duration: DurationFullDay}
+}
+
+func partialDay(employeeID string, day time.Time, duration string, days float64) *LeaveRequest {
+	return &LeaveRequest{EmployeeID: employeeID, StartDate: day, EndDate: day, Duration: duration, DaysRequested: days}
+}
+
+func TestLeaveRequestOverlaps(t *testing.T) {
+	dec31 := date(2026, time.December, 31)
+	jan1 := date(2027, time.January, 1)
+
+	tests := []struct {
+		name  string
+		leave *LeaveRequest
+		other *LeaveRequest
+		want  bool
+	}{
+		{
+			name:  "consecutive days across the year end",
+			leave: fullDays("e1", date(2026, time.December, 28), dec31),
+			other: fullDays("e1", jan1, date(2027, time.January, 4)),
+			want:  false,
+		},
+		{
+			name:  "sharing Dec 31",
+			leave: fullDays("e1", date(2026, time.December, 28), dec31),
+			other: fullDays("e1", dec31, jan1),
+			want:  true,
+		},
+		{
+			name:  "one inside the other",
+			leave: fullDays("e1", date(2026, time.December, 21), date(2027, time.January, 8)),
+			other: fullDays("e1", jan1, jan1),
+			want:  true,
+		},
+		{
+			name:  "times of day are ignored",
+			leave: fullDays("e1", dec31.Add(9*time.Hour), dec31.Add(17*time.Hour)),
+			other: fullDays("e1", dec31.Add(20*time.Hour), jan1),
+			want:  true,
+		},
+		{
+			name:  "full day and half day",
+			leave: fullDays("e1", dec31, dec31),
+			other: partialDay("e1", dec31, DurationHalfDayPM, 0.5),
+			want:  true,
+		},
+		{
+			name:  "morning and afternoon",
+			leave: partialDay("e1", dec31, DurationHalfDayAM, 0.5),
+			other: partialDay("e1", dec31, DurationHalfDayPM, 0.5),
+			want:  false,
+		},
+		{
+			name:  "same half twice",
+			leave: partialDay("e1", dec31, DurationHalfDayAM, 0.5),
+			other: partialDay("e1", dec31, DurationHalfDayAM, 0.5),
+			want:  true,
+		},
+		{
+			name:  "hours fitting in a day",
+			leave: partialDay("e1", dec31, DurationHourly, 0.25),
+			other: partialDay("e1", dec31, DurationHourly, 0.75),
+			want:  false,
+		},
+		{
+			name:  "hours beyond a day",
+			leave: partialDay("e1", dec31, DurationHourly, 0.625),
+			other: partialDay("e1", dec31, DurationHalfDayPM, 0.5),
+			want:  true,
+		},
+		{
+			name:  "partial days on Dec 31 and Jan 1",
+			leave: partialDay("e1", dec31, DurationHalfDayAM, 0.5),
+			other: partialDay("e1", jan1, DurationHalfDayAM, 0.5),
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.leave.Overlaps(tt.other); got != tt.want {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.want)
+			}
+			if got := tt.other.Overlaps(tt.leave); got != tt.want {
+				t.Errorf("Overlaps() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStaffingRuleConflicts(t *testing.T) {
+	dec30 := date(2026, time.December, 30)
+	dec31 := date(2026, time.December, 31)
+	jan1 := date(2027, time.January, 1)
+	jan2 := date(2027, time.January, 2)
+
+	withExcluded := func(leave *LeaveRequest, days ...time.Time) *LeaveRequest {
+		for _, day := range days {
+			leave.ExcludedDates = append(leave.ExcludedDates, holiday.ExcludedDate{Date: day, Reason: holiday.ReasonHoliday})
+		}
+		return leave
+	}
+
+	tests := []struct {
+		name     string
+		max      int
+		leave    *LeaveRequest
+		absences []*LeaveRequest
+		want     []time.Time
+	}{
+		{
+			name:     "within the limit",
+			max:      2,
+			leave:    fullDays("e1", dec30, jan1),
+			absences: []*LeaveRequest{fullDays("e2", dec31, jan2)},
+		},
+		{
+			name:     "over the limit on the days shared across the year end",
+			max:      1,
+			leave:    fullDays("e1", dec30, jan1),
+			absences: []*LeaveRequest{fullDays("e2", dec31, jan2)},
+			want:     []time.Time{dec31, jan1},
+		},
+		{
+			name:  "holidays of the leave are not counted",
+			max:   1,
+			leave: withExcluded(fullDays("e1", dec31, jan1), jan1),
+			absences: []*LeaveRequest{
+				fullDays("e2", jan1, jan2),
+			},
+		},
+		{
+			name:  "holidays of a colleague are not counted",
+			max:   1,
+			leave: fullDays("e1", dec31, jan1),
+			absences: []*LeaveRequest{
+				withExcluded(fullDays("e2", dec31, jan1), dec31),
+			},
+			want: []time.Time{jan1},
+		},
+		{
+			name:  "a colleague with two absences counts once",
+			max:   2,
+			leave: fullDays("e1", dec31, dec31),
+			absences: []*LeaveRequest{
+				partialDay("e2", dec31, DurationHalfDayAM, 0.5),
+				partialDay("e2", dec31, DurationHalfDayPM, 0.5),
+			},
+		},
+		{
+			name:  "the employee's own leave does not count twice",
+			max:   1,
+			leave: fullDays("e1", dec31, jan1),
+			absences: []*LeaveRequest{
+				fullDays("e1", dec30, dec31),
+			},
+		},
+		{
+			name:     "no limit",
+			max:      0,
+			leave:    fullDays("e1", dec31, jan1),
+			absences: []*LeaveRequest{fullDays("e2", dec31, jan1), fullDays("e3", dec31, jan1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &StaffingRule{DepartmentID: "d1", DepartmentName: "Finance", MaxConcurrentAbsences: tt.max}
+			conflicts := rule.Conflicts(tt.leave, tt.absences)
+
+			var got []time.Time
+			for _, conflict := range conflicts {
+				if conflict.Type != ConflictStaffing || conflict.Subject != "departments/d1" {
+					t.Errorf("Conflicts() returned %+v, want a staffing conflict of departments/d1", conflict)
+				}
+				got = append(got, conflict.Date)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Conflicts() dates = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
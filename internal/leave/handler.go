@@ -0,0 +1,678 @@
+package leave
+
+import (
+	"context"
+	"time"
+
+	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	leavepb.UnimplementedLeaveServiceServer
+	service Service
+	logger  *logger.Logger
+}
+
+func NewHandler(service Service, logger *logger.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.HandlerLogger("leave"),
+	}
+}
+
+func (h *Handler) CreateLeaveRequest(ctx context.Context, req *leavepb.CreateLeaveRequestRequest) (*leavepb.CreateLeaveRequestResponse, error) {
+	h.logger.Info("CreateLeaveRequest called", "employee_id", req.EmployeeId)
+
+	createReq := &CreateLeaveRequestRequest{
+		EmployeeID: req.EmployeeId,
+		LeaveType:  leaveTypeFromProto(req.LeaveType),
+		StartDate:  timeFromProto(req.StartDate),
+		EndDate:    timeFromProto(req.EndDate),
+		Reason:     req.Reason,
+		Duration:   durationFromProto(req.Duration),
+		Hours:      req.Hours,
+	}
+
+	leave, err := h.service.CreateLeaveRequest(ctx, createReq)
+	if err != nil {
+		h.logger.Error("Failed to create leave request", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.CreateLeaveRequestResponse{
+		LeaveRequest: leave.ToProto(),
+		Warnings:     conflictsToProto(leave.Warnings),
+	}, nil
+}
+
+func (h *Handler) GetLeaveRequest(ctx context.Context, req *leavepb.GetLeaveRequestRequest) (*leavepb.GetLeaveRequestResponse, error) {
+	h.logger.Info("GetLeaveRequest called", "id", req.Id)
+
+	leave, err := h.service.GetLeaveRequest(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to get leave request", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.GetLeaveRequestResponse{
+		LeaveRequest: leave.ToProto(),
+	}, nil
+}
+
+func (h *Handler) UpdateLeaveRequest(ctx context.Context, req *leavepb.UpdateLeaveRequestRequest) (*leavepb.UpdateLeaveRequestResponse, error) {
+	h.logger.Info("UpdateLeaveRequest called", "id", req.Id)
+
+	updateReq := &UpdateLeaveRequestRequest{
+		LeaveType: leaveTypeFromProto(req.LeaveType),
+		StartDate: timeFromProto(req.StartDate),
+		EndDate:   timeFromProto(req.EndDate),
+		Reason:    req.Reason,
+		Duration:  durationFromProto(req.Duration),
+		Hours:     req.Hours,
+	}
+
+	leave, err := h.service.UpdateLeaveRequest(ctx, req.Id, updateReq)
+	if err != nil {
+		h.logger.Error("Failed to update leave request", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.UpdateLeaveRequestResponse{
+		LeaveRequest: leave.ToProto(),
+		Warnings:     conflictsToProto(leave.Warnings),
+	}, nil
+}
+
+func (h *Handler) DeleteLeaveRequest(ctx context.Context, req *leavepb.DeleteLeaveRequestRequest) (*emptypb.Empty, error) {
+	h.logger.Info("DeleteLeaveRequest called", "id", req.Id)
+
+	if err := h.service.DeleteLeaveRequest(ctx, req.Id); err != nil {
+		h.logger.Error("Failed to delete leave request", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListLeaveRequests(ctx context.Context, req *leavepb.ListLeaveRequestsRequest) (*leavepb.ListLeaveRequestsResponse, error) {
+	h.logger.Info("ListLeaveRequests called", "page", req.Page, "page_size", req.PageSize, "employee_id", req.EmployeeId)
+
+	listReq := &ListLeaveRequestsRequest{
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		EmployeeID: req.EmployeeId,
+		Status:     leaveStatusFromProto(req.Status),
+		LeaveType:  leaveTypeFromProto(req.LeaveType),
+	}
+
+	response, err := h.service.ListLeaveRequests(ctx, listReq)
+	if err != nil {
+		h.logger.Error("Failed to list leave requests", "error", err)
+		return nil, err
+	}
+
+	leaves := make([]*leavepb.LeaveRequest, len(response.LeaveRequests))
+	for i, leave := range response.LeaveRequests {
+		leaves[i] = leave.ToProto()
+	}
+
+	return &leavepb.ListLeaveRequestsResponse{
+		LeaveRequests: leaves,
+		TotalCount:    int32(response.TotalCount),
+		Page:          int32(response.Page),
+		PageSize:      int32(response.PageSize),
+	}, nil
+}
+
+func (h *Handler) ApproveLeaveRequest(ctx context.Context, req *leavepb.ApproveLeaveRequestRequest) (*leavepb.ApproveLeaveRequestResponse, error) {
+	h.logger.Info("ApproveLeaveRequest called", "id", req.Id, "approver_id", req.ApproverId)
+
+	approveReq := &ApproveLeaveRequestRequest{
+		ApproverID:   approverID(ctx, req.ApproverId),
+		ApproverRole: callerRole(ctx),
+		Comments:     req.Comments,
+	}
+
+	leave, err := h.service.ApproveLeaveRequest(ctx, req.Id, approveReq)
+	if err != nil {
+		h.logger.Error("Failed to approve leave request", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.ApproveLeaveRequestResponse{
+		LeaveRequest: leave.ToProto(),
+		Warnings:     conflictsToProto(leave.Warnings),
+	}, nil
+}
+
+func (h *Handler) RejectLeaveRequest(ctx context.Context, req *leavepb.RejectLeaveRequestRequest) (*leavepb.RejectLeaveRequestResponse, error) {
+	h.logger.Info("RejectLeaveRequest called", "id", req.Id, "approver_id", req.ApproverId)
+
+	rejectReq := &RejectLeaveRequestRequest{
+		ApproverID:   approverID(ctx, req.ApproverId),
+		ApproverRole: callerRole(ctx),
+		Comments:     req.Comments,
+	}
+
+	leave, err := h.service.RejectLeaveRequest(ctx, req.Id, rejectReq)
+	if err != nil {
+		h.logger.Error("Failed to reject leave request", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.RejectLeaveRequestResponse{
+		LeaveRequest: leave.ToProto(),
+	}, nil
+}
+
+func (h *Handler) CancelLeaveRequest(ctx context.Context, req *leavepb.CancelLeaveRequestRequest) (*leavepb.CancelLeaveRequestResponse, error) {
+	h.logger.Info("CancelLeaveRequest called", "id", req.Id)
+
+	leave, err := h.service.CancelLeaveRequest(ctx, req.Id, &CancelLeaveRequestRequest{
+		CancelledBy: approverID(ctx, ""),
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		h.logger.Error("Failed to cancel leave request", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.CancelLeaveRequestResponse{
+		LeaveRequest: leave.ToProto(),
+	}, nil
+}
+
+func (h *Handler) WithdrawLeaveRequest(ctx context.Context, req *leavepb.WithdrawLeaveRequestRequest) (*leavepb.WithdrawLeaveRequestResponse, error) {
+	h.logger.Info("WithdrawLeaveRequest called", "id", req.Id)
+
+	response, err := h.service.WithdrawLeaveRequest(ctx, req.Id, &WithdrawLeaveRequestRequest{
+		LastDay:     timeFromProto(req.LastDay),
+		WithdrawnBy: approverID(ctx, ""),
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		h.logger.Error("Failed to withdraw leave request", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.WithdrawLeaveRequestResponse{
+		LeaveRequest: response.LeaveRequest.ToProto(),
+		RestoredDays: response.RestoredDays,
+	}, nil
+}
+
+func (h *Handler) GetTeamCalendar(ctx context.Context, req *leavepb.GetTeamCalendarRequest) (*leavepb.GetTeamCalendarResponse, error) {
+	h.logger.Info("GetTeamCalendar called", "department_id", req.DepartmentId, "manager_id", req.ManagerId)
+
+	calendar, err := h.service.GetTeamCalendar(ctx, &TeamCalendarRequest{
+		DepartmentID: req.DepartmentId,
+		ManagerID:    req.ManagerId,
+		StartDate:    timeFromProto(req.StartDate),
+		EndDate:      timeFromProto(req.EndDate),
+		CallerID:     approverID(ctx, ""),
+	})
+	if err != nil {
+		h.logger.Error("Failed to get team calendar", "error", err)
+		return nil, err
+	}
+
+	return calendar.ToProto(), nil
+}
+
+func (h *Handler) ExportLeaveCalendar(ctx context.Context, req *leavepb.ExportLeaveCalendarRequest) (*leavepb.ExportLeaveCalendarResponse, error) {
+	h.logger.Info("ExportLeaveCalendar called", "employee_id", req.EmployeeId, "department_id", req.DepartmentId)
+
+	export, err := h.service.ExportLeaveCalendar(ctx, &TeamCalendarRequest{
+		EmployeeID:   req.EmployeeId,
+		DepartmentID: req.DepartmentId,
+		StartDate:    timeFromProto(req.StartDate),
+		EndDate:      timeFromProto(req.EndDate),
+		CallerID:     approverID(ctx, ""),
+	})
+	if err != nil {
+		h.logger.Error("Failed to export leave calendar", "error", err)
+		return nil, err
+	}
+
+	return &leavepb.ExportLeaveCalendarResponse{
+		Filename:    export.Filename,
+		ContentType: export.ContentType,
+		Content:     export.Content,
+	}, nil
+}
+
+func (h *Handler) RequestCompOffCredit(ctx context.Context, req *leavepb.RequestCompOffCreditRequest) (*leavepb.RequestCompOffCreditResponse, error) {
+	h.logger.Info("RequestCompOffCredit called", "employee_id", req.EmployeeId, "days", req.Days)
+
+	credit, err := h.service.RequestCompOffCredit(ctx, &RequestCompOffRequest{
+		EmployeeID:  req.EmployeeId,
+		WorkDate:    timeFromProto(req.WorkDate),
+		Days:        req.Days,
+		Reason:      req.Reason,
+		RequestedBy: approverID(ctx, ""),
+	})
+	if err != nil {
+		h.logger.Error("Failed to request comp-off credit", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.RequestCompOffCreditResponse{
+		Credit: credit.ToProto(),
+	}, nil
+}
+
+func (h *Handler) ListCompOffCredits(ctx context.Context, req *leavepb.ListCompOffCreditsRequest) (*leavepb.ListCompOffCreditsResponse, error) {
+	h.logger.Info("ListCompOffCredits called", "employee_id", req.EmployeeId, "status", req.Status)
+
+	response, err := h.service.ListCompOffCredits(ctx, &ListCompOffCreditsRequest{
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		EmployeeID: req.EmployeeId,
+		Status:     compOffStatusFromProto(req.Status),
+	})
+	if err != nil {
+		h.logger.Error("Failed to list comp-off credits", "error", err)
+		return nil, err
+	}
+
+	credits := make([]*leavepb.CompOffCredit, len(response.Credits))
+	for i, credit := range response.Credits {
+		credits[i] = credit.ToProto()
+	}
+
+	return &leavepb.ListCompOffCreditsResponse{
+		Credits:    credits,
+		TotalCount: int32(response.TotalCount),
+		Page:       int32(response.Page),
+		PageSize:   int32(response.PageSize),
+	}, nil
+}
+
+func (h *Handler) ApproveCompOffCredit(ctx context.Context, req *leavepb.ApproveCompOffCreditRequest) (*leavepb.ApproveCompOffCreditResponse, error) {
+	h.logger.Info("ApproveCompOffCredit called", "id", req.Id)
+
+	response, err := h.service.ApproveCompOffCredit(ctx, req.Id, &DecideCompOffRequest{
+		ApproverID: approverID(ctx, ""),
+		Comments:   req.Comments,
+	})
+	if err != nil {
+		h.logger.Error("Failed to approve comp-off credit", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.ApproveCompOffCreditResponse{
+		Credit:       response.Credit.ToProto(),
+		LeaveBalance: response.LeaveBalance.ToProto(),
+	}, nil
+}
+
+func (h *Handler) RejectCompOffCredit(ctx context.Context, req *leavepb.RejectCompOffCreditRequest) (*leavepb.RejectCompOffCreditResponse, error) {
+	h.logger.Info("RejectCompOffCredit called", "id", req.Id)
+
+	credit, err := h.service.RejectCompOffCredit(ctx, req.Id, &DecideCompOffRequest{
+		ApproverID: approverID(ctx, ""),
+		Comments:   req.Comments,
+	})
+	if err != nil {
+		h.logger.Error("Failed to reject comp-off credit", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.RejectCompOffCreditResponse{
+		Credit: credit.ToProto(),
+	}, nil
+}
+
+func (h *Handler) GetEmployeeLeaveBalance(ctx context.Context, req *leavepb.GetEmployeeLeaveBalanceRequest) (*leavepb.GetEmployeeLeaveBalanceResponse, error) {
+	h.logger.Info("GetEmployeeLeaveBalance called", "employee_id", req.EmployeeId, "year", req.Year)
+
+	response, err := h.service.GetEmployeeLeaveBalance(ctx, &GetEmployeeLeaveBalanceRequest{
+		EmployeeID: req.EmployeeId,
+		Year:       int(req.Year),
+	})
+	if err != nil {
+		h.logger.Error("Failed to get employee leave balance", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	balances := make([]*leavepb.LeaveBalance, len(response.LeaveBalances))
+	for i, balance := range response.LeaveBalances {
+		balances[i] = balance.ToProto()
+	}
+
+	return &leavepb.GetEmployeeLeaveBalanceResponse{
+		LeaveBalances: balances,
+	}, nil
+}
+
+func (h *Handler) ListLeavePolicies(ctx context.Context, req *leavepb.ListLeavePoliciesRequest) (*leavepb.ListLeavePoliciesResponse, error) {
+	h.logger.Info("ListLeavePolicies called")
+
+	policies, err := h.service.ListLeavePolicies(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list leave policies", "error", err)
+		return nil, err
+	}
+
+	result := make([]*leavepb.LeavePolicy, len(policies))
+	for i, policy := range policies {
+		result[i] = policy.ToProto()
+	}
+
+	return &leavepb.ListLeavePoliciesResponse{
+		Policies: result,
+	}, nil
+}
+
+func (h *Handler) SetLeavePolicy(ctx context.Context, req *leavepb.SetLeavePolicyRequest) (*leavepb.SetLeavePolicyResponse, error) {
+	h.logger.Info("SetLeavePolicy called", "leave_type", req.GetPolicy().GetLeaveType())
+
+	policy := &LeavePolicy{
+		LeaveType:       leaveTypeFromProto(req.GetPolicy().GetLeaveType()),
+		DaysPerYear:     req.GetPolicy().GetDaysPerYear(),
+		ProbationMonths: int(req.GetPolicy().GetProbationMonths()),
+		Active:          req.GetPolicy().GetActive(),
+
+		CarryForwardMaxDays:      req.GetPolicy().GetCarryForwardMaxDays(),
+		CarryForwardExpiryMonths: int(req.GetPolicy().GetCarryForwardExpiryMonths()),
+	}
+
+	switch req.GetPolicy().GetAccrualMethod() {
+	case leavepb.AccrualMethod_ACCRUAL_METHOD_ANNUAL:
+		policy.AccrualMethod = AccrualAnnual
+	case leavepb.AccrualMethod_ACCRUAL_METHOD_MONTHLY:
+		policy.AccrualMethod = AccrualMonthly
+	}
+
+	saved, err := h.service.SetLeavePolicy(ctx, policy)
+	if err != nil {
+		h.logger.Error("Failed to set leave policy", "error", err)
+		return nil, err
+	}
+
+	return &leavepb.SetLeavePolicyResponse{
+		Policy: saved.ToProto(),
+	}, nil
+}
+
+func (h *Handler) RunLeaveAccrual(ctx context.Context, req *leavepb.RunLeaveAccrualRequest) (*leavepb.RunLeaveAccrualResponse, error) {
+	h.logger.Info("RunLeaveAccrual called", "year", req.Year, "dry_run", req.DryRun, "employee_id", req.EmployeeId)
+
+	response, err := h.service.RunLeaveAccrual(ctx, &RunAccrualRequest{
+		Year:       int(req.Year),
+		DryRun:     req.DryRun,
+		EmployeeID: req.EmployeeId,
+	})
+	if err != nil {
+		h.logger.Error("Failed to run leave accrual", "error", err)
+		return nil, err
+	}
+
+	accruals := make([]*leavepb.LeaveAccrual, len(response.Accruals))
+	for i, accrual := range response.Accruals {
+		accruals[i] = accrual.ToProto()
+	}
+
+	return &leavepb.RunLeaveAccrualResponse{
+		Accruals:           accruals,
+		Year:               int32(response.Year),
+		AsOf:               timestamppb.New(response.AsOf),
+		DryRun:             response.DryRun,
+		EmployeesProcessed: int32(response.EmployeesProcessed),
+		EmployeesFailed:    int32(response.EmployeesFailed),
+		TotalCreditedDays:  response.TotalCreditedDays,
+	}, nil
+}
+
+func (h *Handler) CloseLeaveYear(ctx context.Context, req *leavepb.CloseLeaveYearRequest) (*leavepb.CloseLeaveYearResponse, error) {
+	h.logger.Info("CloseLeaveYear called", "year", req.Year, "dry_run", req.DryRun)
+
+	response, err := h.service.CloseLeaveYear(ctx, &CloseYearRequest{
+		Year:   int(req.Year),
+		DryRun: req.DryRun,
+	})
+	if err != nil {
+		h.logger.Error("Failed to close leave year", "error", err)
+		return nil, err
+	}
+
+	carryForwards := make([]*leavepb.LeaveCarryForward, len(response.CarryForwards))
+	for i, carryForward := range response.CarryForwards {
+		carryForwards[i] = carryForward.ToProto()
+	}
+
+	return &leavepb.CloseLeaveYearResponse{
+		CarryForwards:      carryForwards,
+		Year:               int32(response.Year),
+		DryRun:             response.DryRun,
+		BalancesClosed:     int32(response.BalancesClosed),
+		BalancesFailed:     int32(response.BalancesFailed),
+		TotalCarriedDays:   response.TotalCarriedDays,
+		TotalForfeitedDays: response.TotalForfeitedDays,
+	}, nil
+}
+
+func (h *Handler) EncashLeave(ctx context.Context, req *leavepb.EncashLeaveRequest) (*leavepb.EncashLeaveResponse, error) {
+	h.logger.Info("EncashLeave called", "employee_id", req.EmployeeId, "year", req.Year, "days", req.Days)
+
+	response, err := h.service.EncashLeave(ctx, &EncashLeaveRequest{
+		EmployeeID:  req.EmployeeId,
+		Year:        int(req.Year),
+		Days:        req.Days,
+		RequestedBy: approverID(ctx, ""),
+	})
+	if err != nil {
+		h.logger.Error("Failed to encash leave", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return &leavepb.EncashLeaveResponse{
+		LeaveBalance: response.LeaveBalance.ToProto(),
+		LineItem:     lineItemToProto(response.LineItem),
+	}, nil
+}
+
+func (h *Handler) ListApprovalRules(ctx context.Context, req *leavepb.ListApprovalRulesRequest) (*leavepb.ListApprovalRulesResponse, error) {
+	h.logger.Info("ListApprovalRules called", "leave_type", req.LeaveType)
+
+	rules, err := h.service.ListApprovalRules(ctx, leaveTypeFromProto(req.LeaveType))
+	if err != nil {
+		h.logger.Error("Failed to list approval rules", "error", err)
+		return nil, err
+	}
+
+	return &leavepb.ListApprovalRulesResponse{
+		Rules: approvalRulesToProto(rules),
+	}, nil
+}
+
+func (h *Handler) SetApprovalRules(ctx context.Context, req *leavepb.SetApprovalRulesRequest) (*leavepb.SetApprovalRulesResponse, error) {
+	h.logger.Info("SetApprovalRules called", "leave_type", req.LeaveType, "steps", len(req.Rules))
+
+	rules := make([]*ApprovalRule, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = &ApprovalRule{
+			ApproverType: approverTypeFromProto(rule.ApproverType),
+			MinDays:      rule.MinDays,
+		}
+	}
+
+	saved, err := h.service.SetApprovalRules(ctx, leaveTypeFromProto(req.LeaveType), rules)
+	if err != nil {
+		h.logger.Error("Failed to set approval rules", "error", err)
+		return nil, err
+	}
+
+	return &leavepb.SetApprovalRulesResponse{
+		Rules: approvalRulesToProto(saved),
+	}, nil
+}
+
+func (h *Handler) CreateApprovalDelegation(ctx context.Context, req *leavepb.CreateApprovalDelegationRequest) (*leavepb.CreateApprovalDelegationResponse, error) {
+	h.logger.Info("CreateApprovalDelegation called", "employee_id", req.EmployeeId, "delegate_id", req.DelegateId)
+
+	delegation, err := h.service.CreateApprovalDelegation(ctx, &CreateDelegationRequest{
+		DelegatorID: approverID(ctx, req.EmployeeId),
+		DelegateID:  req.DelegateId,
+		StartDate:   timeFromProto(req.StartDate),
+		EndDate:     timeFromProto(req.EndDate),
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		h.logger.Error("Failed to create approval delegation", "error", err)
+		return nil, err
+	}
+
+	return &leavepb.CreateApprovalDelegationResponse{
+		Delegation: delegation.ToProto(),
+	}, nil
+}
+
+func (h *Handler) ListApprovalDelegations(ctx context.Context, req *leavepb.ListApprovalDelegationsRequest) (*leavepb.ListApprovalDelegationsResponse, error) {
+	h.logger.Info("ListApprovalDelegations called", "employee_id", req.EmployeeId, "active_only", req.ActiveOnly)
+
+	delegations, err := h.service.ListApprovalDelegations(ctx, &ListDelegationsRequest{
+		EmployeeID: req.EmployeeId,
+		ActiveOnly: req.ActiveOnly,
+	})
+	if err != nil {
+		h.logger.Error("Failed to list approval delegations", "error", err)
+		return nil, err
+	}
+
+	result := make([]*leavepb.ApprovalDelegation, len(delegations))
+	for i, delegation := range delegations {
+		result[i] = delegation.ToProto()
+	}
+
+	return &leavepb.ListApprovalDelegationsResponse{
+		Delegations: result,
+	}, nil
+}
+
+func (h *Handler) DeleteApprovalDelegation(ctx context.Context, req *leavepb.DeleteApprovalDelegationRequest) (*emptypb.Empty, error) {
+	h.logger.Info("DeleteApprovalDelegation called", "id", req.Id)
+
+	if err := h.service.DeleteApprovalDelegation(ctx, req.Id); err != nil {
+		h.logger.Error("Failed to delete approval delegation", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// approverID defaults the approver to the authenticated caller
+func approverID(ctx context.Context, requested string) string {
+	if requested != "" {
+		return requested
+	}
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return claims.UserID
+	}
+	return ""
+}
+
+// callerRole returns the role of the authenticated caller
+func callerRole(ctx context.Context) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return claims.Role
+	}
+	return ""
+}
+
+// timeFromProto returns the zero time for unset timestamps
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func leaveTypeFromProto(leaveType leavepb.LeaveType) string {
+	switch leaveType {
+	case leavepb.LeaveType_LEAVE_TYPE_ANNUAL:
+		return "ANNUAL"
+	case leavepb.LeaveType_LEAVE_TYPE_SICK:
+		return "SICK"
+	case leavepb.LeaveType_LEAVE_TYPE_MATERNITY:
+		return "MATERNITY"
+	case leavepb.LeaveType_LEAVE_TYPE_PATERNITY:
+		return "PATERNITY"
+	case leavepb.LeaveType_LEAVE_TYPE_EMERGENCY:
+		return "EMERGENCY"
+	case leavepb.LeaveType_LEAVE_TYPE_PERSONAL:
+		return "PERSONAL"
+	case leavepb.LeaveType_LEAVE_TYPE_COMP_OFF:
+		return LeaveTypeCompOff
+	default:
+		return ""
+	}
+}
+
+func leaveStatusFromProto(leaveStatus leavepb.LeaveStatus) string {
+	switch leaveStatus {
+	case leavepb.LeaveStatus_LEAVE_STATUS_PENDING:
+		return "PENDING"
+	case leavepb.LeaveStatus_LEAVE_STATUS_APPROVED:
+		return "APPROVED"
+	case leavepb.LeaveStatus_LEAVE_STATUS_REJECTED:
+		return "REJECTED"
+	case leavepb.LeaveStatus_LEAVE_STATUS_CANCELLED:
+		return "CANCELLED"
+	default:
+		return ""
+	}
+}
+
+func durationFromProto(duration leavepb.LeaveDuration) string {
+	switch duration {
+	case leavepb.LeaveDuration_LEAVE_DURATION_FULL_DAY:
+		return DurationFullDay
+	case leavepb.LeaveDuration_LEAVE_DURATION_HALF_DAY_AM:
+		return DurationHalfDayAM
+	case leavepb.LeaveDuration_LEAVE_DURATION_HALF_DAY_PM:
+		return DurationHalfDayPM
+	case leavepb.LeaveDuration_LEAVE_DURATION_HOURLY:
+		return DurationHourly
+	default:
+		return ""
+	}
+}
+
+func approverTypeFromProto(approverType leavepb.ApproverType) string {
+	switch approverType {
+	case leavepb.ApproverType_APPROVER_TYPE_MANAGER:
+		return ApproverManager
+	case leavepb.ApproverType_APPROVER_TYPE_DEPARTMENT_MANAGER:
+		return ApproverDepartmentManager
+	case leavepb.ApproverType_APPROVER_TYPE_HR:
+		return ApproverHR
+	default:
+		return ""
+	}
+}
+
+func approvalRulesToProto(rules []*ApprovalRule) []*leavepb.ApprovalRule {
+	result := make([]*leavepb.ApprovalRule, len(rules))
+	for i, rule := range rules {
+		result[i] = rule.ToProto()
+	}
+	return result
+}
+
+func compOffStatusFromProto(status leavepb.CompOffStatus) string {
+	switch status {
+	case leavepb.CompOffStatus_COMP_OFF_STATUS_PENDING:
+		return CreditPending
+	case leavepb.CompOffStatus_COMP_OFF_STATUS_APPROVED:
+		return CreditApproved
+	case leavepb.CompOffStatus_COMP_OFF_STATUS_REJECTED:
+		return CreditRejected
+	default:
+		return ""
+	}
+}
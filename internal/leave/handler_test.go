@@ -0,0 +1,59 @@
+package leave
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHandlerCreateLeaveRequestArguments(t *testing.T) {
+	start := timestamppb.New(date(2025, time.March, 3))
+	end := timestamppb.New(date(2025, time.March, 7))
+
+	tests := []struct {
+		name string
+		req  *leavepb.CreateLeaveRequestRequest
+		want codes.Code
+	}{
+		{
+			name: "valid request",
+			req:  &leavepb.CreateLeaveRequestRequest{EmployeeId: "employee", LeaveType: leavepb.LeaveType_LEAVE_TYPE_ANNUAL, StartDate: start, EndDate: end},
+			want: codes.OK,
+		},
+		{
+			name: "unspecified leave type",
+			req:  &leavepb.CreateLeaveRequestRequest{EmployeeId: "employee", StartDate: start, EndDate: end},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "missing dates",
+			req:  &leavepb.CreateLeaveRequestRequest{EmployeeId: "employee", LeaveType: leavepb.LeaveType_LEAVE_TYPE_SICK},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "dates swapped",
+			req:  &leavepb.CreateLeaveRequestRequest{EmployeeId: "employee", LeaveType: leavepb.LeaveType_LEAVE_TYPE_SICK, StartDate: end, EndDate: start},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHandler(newTestService(newStubRepository()), logger.NewLogger("panic", "text"))
+
+			resp, err := handler.CreateLeaveRequest(context.Background(), tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("CreateLeaveRequest() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if err == nil && resp.LeaveRequest.LeaveType != tt.req.LeaveType {
+				t.Errorf("CreateLeaveRequest() leave type = %v, want %v", resp.LeaveRequest.LeaveType, tt.req.LeaveType)
+			}
+		})
+	}
+}
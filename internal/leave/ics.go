@@ -0,0 +1,126 @@
+package leave
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+)
+
+// ContentTypeICS is the media type of iCalendar files
+const ContentTypeICS = "text/calendar; charset=utf-8"
+
+const icsDomain = "hr-management-system"
+
+// encodeICS writes the calendar as an iCalendar (RFC 5545) file of all-day events.
+// Pending leave is tentative, holidays don't block time.
+func encodeICS(name string, calendar *TeamCalendar, now time.Time) []byte {
+	w := &icsWriter{}
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//HR Management System//Leave Calendar//EN")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:" + escapeICS(name))
+
+	for _, absence := range calendar.Absences {
+		status := "CONFIRMED"
+		if absence.LeaveStatus == "PENDING" {
+			status = "TENTATIVE"
+		}
+
+		w.line("BEGIN:VEVENT")
+		w.line("UID:leave-" + absence.ID + "@" + icsDomain)
+		w.line("DTSTAMP:" + stamp)
+		w.dates(absence.StartDate, absence.EndDate)
+		w.line("SUMMARY:" + escapeICS(absenceSummary(absence)))
+		if absence.Reason != "" {
+			w.line("DESCRIPTION:" + escapeICS(absence.Reason))
+		}
+		w.line("STATUS:" + status)
+		w.line("TRANSP:OPAQUE")
+		w.line("CATEGORIES:LEAVE")
+		w.line("END:VEVENT")
+	}
+
+	for _, h := range calendar.Holidays {
+		observed := h.Country
+		if h.Location != "" {
+			observed += ", " + h.Location
+		}
+
+		w.line("BEGIN:VEVENT")
+		w.line("UID:holiday-" + h.ID + "@" + icsDomain)
+		w.line("DTSTAMP:" + stamp)
+		w.dates(h.Date, h.Date)
+		w.line("SUMMARY:" + escapeICS(h.Name))
+		w.line("DESCRIPTION:" + escapeICS("Holiday in "+observed))
+		w.line("STATUS:CONFIRMED")
+		w.line("TRANSP:TRANSPARENT")
+		w.line("CATEGORIES:HOLIDAY")
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes()
+}
+
+// absenceSummary names the employee and the kind of leave, such as "Jane Doe - Sick leave (morning)"
+func absenceSummary(absence *LeaveRequest) string {
+	who := absence.EmployeeID
+	if absence.Employee != nil {
+		who = absence.Employee.FirstName + " " + absence.Employee.LastName
+	}
+
+	summary := fmt.Sprintf("%s - %s%s leave", who, absence.LeaveType[:1], strings.ToLower(absence.LeaveType[1:]))
+	switch absence.Duration {
+	case DurationHalfDayAM:
+		summary += " (morning)"
+	case DurationHalfDayPM:
+		summary += " (afternoon)"
+	case DurationHourly:
+		if absence.Hours != nil {
+			summary += fmt.Sprintf(" (%gh)", *absence.Hours)
+		}
+	}
+	return summary
+}
+
+type icsWriter struct {
+	buf bytes.Buffer
+}
+
+// dates writes an all-day period, the end date of iCalendar events is exclusive
+func (w *icsWriter) dates(start, end time.Time) {
+	w.line("DTSTART;VALUE=DATE:" + holiday.DateOf(start).Format("20060102"))
+	w.line("DTEND;VALUE=DATE:" + holiday.DateOf(end).AddDate(0, 0, 1).Format("20060102"))
+}
+
+// line writes a content line folded at 75 octets without splitting characters
+func (w *icsWriter) line(content string) {
+	limit := 75
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		// The leading space of continuation lines counts towards their length
+		limit = 74
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeICS(text string) string {
+	return icsEscaper.Replace(text)
+}
@@ -0,0 +1,127 @@
+package leave
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+)
+
+func TestEscapeICS(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plain text", text: "Family trip", want: "Family trip"},
+		{name: "commas and semicolons", text: "Paris, Lyon; Nice", want: `Paris\, Lyon\; Nice`},
+		{name: "backslash first", text: `C:\temp, done`, want: `C:\\temp\, done`},
+		{name: "line breaks", text: "first\r\nsecond\nthird", want: `first\nsecond\nthird`},
+		{name: "colons stay", text: "Note: back Jan 1", want: "Note: back Jan 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeICS(tt.text); got != tt.want {
+				t.Errorf("escapeICS(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestICSWriterLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		lines   int
+	}{
+		{name: "short", content: "SUMMARY:Leave", lines: 1},
+		{name: "exactly 75 octets", content: "DESCRIPTION:" + strings.Repeat("a", 63), lines: 1},
+		{name: "76 octets", content: "DESCRIPTION:" + strings.Repeat("a", 64), lines: 2},
+		{name: "several folds", content: "DESCRIPTION:" + strings.Repeat("b", 200), lines: 3},
+		{name: "multibyte characters at the fold", content: "DESCRIPTION:" + strings.Repeat("é", 60), lines: 2},
+		{name: "four byte characters", content: "SUMMARY:" + strings.Repeat("🎉", 40), lines: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &icsWriter{}
+			w.line(tt.content)
+			out := w.buf.String()
+
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("line() output %q does not end in CRLF", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			if len(lines) != tt.lines {
+				t.Errorf("line() wrote %d lines, want %d", len(lines), tt.lines)
+			}
+			for i, line := range lines {
+				if len(line) > 75 {
+					t.Errorf("line %d is %d octets long, want at most 75", i, len(line))
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d %q does not start with a space", i, line)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d %q splits a character", i, line)
+				}
+			}
+			if unfolded := strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", ""); unfolded != tt.content {
+				t.Errorf("unfolded line = %q, want %q", unfolded, tt.content)
+			}
+		})
+	}
+}
+
+func TestEncodeICS(t *testing.T) {
+	hours := 2.0
+	calendar := &TeamCalendar{
+		Absences: []*LeaveRequest{
+			{
+				ID: "l1", EmployeeID: "e1", Employee: &Employee{FirstName: "Jane", LastName: "Doe"},
+				LeaveType: "ANNUAL", LeaveStatus: "APPROVED", Duration: DurationFullDay,
+				StartDate: date(2026, time.December, 28), EndDate: date(2026, time.December, 31),
+				Reason: "Trip to Oslo, Bergen; back Jan 4",
+			},
+			{
+				ID: "l2", EmployeeID: "e2", LeaveType: "SICK", LeaveStatus: "PENDING", Duration: DurationHourly, Hours: &hours,
+				StartDate: time.Date(2026, time.December, 31, 22, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+				EndDate:   time.Date(2026, time.December, 31, 22, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+			},
+		},
+		Holidays: []*holiday.Holiday{
+			{ID: "h1", Name: "New Year", Date: date(2027, time.January, 1), Country: "NO"},
+		},
+	}
+	out := string(encodeICS("Team, Finance", calendar, time.Date(2026, time.December, 31, 12, 30, 0, 0, time.UTC)))
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "escaped calendar name", want: "X-WR-CALNAME:Team\\, Finance\r\n"},
+		{name: "stamp in UTC", want: "DTSTAMP:20261231T123000Z\r\n"},
+		{name: "leave ending on Dec 31 ends on Jan 1 exclusive", want: "UID:leave-l1@hr-management-system\r\nDTSTAMP:20261231T123000Z\r\nDTSTART;VALUE=DATE:20261228\r\nDTEND;VALUE=DATE:20270101\r\n"},
+		{name: "summary names the employee", want: "SUMMARY:Jane Doe - Annual leave\r\n"},
+		{name: "escaped reason", want: "DESCRIPTION:Trip to Oslo\\, Bergen\\; back Jan 4\r\n"},
+		{name: "dates are taken in UTC", want: "DTSTART;VALUE=DATE:20270101\r\nDTEND;VALUE=DATE:20270102\r\nSUMMARY:e2 - Sick leave (2h)\r\n"},
+		{name: "pending leave is tentative", want: "SUMMARY:e2 - Sick leave (2h)\r\nSTATUS:TENTATIVE\r\n"},
+		{name: "holiday on Jan 1", want: "UID:holiday-h1@hr-management-system\r\nDTSTAMP:20261231T123000Z\r\nDTSTART;VALUE=DATE:20270101\r\nDTEND;VALUE=DATE:20270102\r\nSUMMARY:New Year\r\nDESCRIPTION:Holiday in NO\r\nSTATUS:CONFIRMED\r\nTRANSP:TRANSPARENT\r\n"},
+		{name: "calendar is closed", want: "END:VEVENT\r\nEND:VCALENDAR\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("encodeICS() = %q, want it to contain %q", out, tt.want)
+			}
+		})
+	}
+
+	if got := strings.Count(out, "DESCRIPTION:"); got != 2 {
+		t.Errorf("encodeICS() wrote %d descriptions, want 2 for the reason and the holiday", got)
+	}
+}
@@ -2,28 +2,66 @@ package leave
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"github.com/dmehra2102/hr-management-system/internal/payroll"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
+// Durations of a leave request, anything but a full day covers a single date
+const (
+	DurationFullDay   = "FULL_DAY"
+	DurationHalfDayAM = "HALF_DAY_AM"
+	DurationHalfDayPM = "HALF_DAY_PM"
+	DurationHourly    = "HOURLY"
+)
+
 type LeaveRequest struct {
 	ID            string     `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
 	EmployeeID    string     `json:"employee_id" gorm:"not null;index"`
 	Employee      *Employee  `json:"employee,omitempty" gorm:"foreignKey:EmployeeID"`
-	LeaveType     string     `json:"leave_type" gorm:"not null;check:leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY', 'EMERGENCY', 'PERSONAL')"`
+	LeaveType     string     `json:"leave_type" gorm:"not null;check:leave_type IN ('ANNUAL','SICK','MATERNITY','PATERNITY', 'EMERGENCY', 'PERSONAL', 'COMP_OFF')"`
 	StartDate     time.Time  `json:"start_date" gorm:"not null"`
 	EndDate       time.Time  `json:"end_date" gorm:"not null"`
-	DaysRequested int        `json:"days_requested" gorm:"not null"`
+	DaysRequested float64    `json:"days_requested" gorm:"type:numeric(8,4);not null"`
+	Duration      string     `json:"duration" gorm:"default:'FULL_DAY'"`
+	Hours         *float64   `json:"hours,omitempty" gorm:"type:numeric(5,2)"` // Set for hourly leave only
 	Reason        string     `json:"reason"`
-	LeaveStatus   string     `json:"leave_status" gorm:"default:'PENDING';check:leave_status IN ('PENDING','APPROVED','REJECTED','CANCELLED')"`
+	LeaveStatus   string     `json:"leave_status" gorm:"column:status;default:'PENDING';check:status IN ('PENDING','APPROVED','REJECTED','CANCELLED')"`
 	ApproverID    *string    `json:"approver_id,omitempty"`
 	Approver      *Employee  `json:"approver,omitempty" gorm:"foreignKey:ApproverID"`
 	Comments      string     `json:"comments"`
 	ApprovedAt    *time.Time `json:"approved_at,omitempty"`
 
+	// ExcludedDates are the weekend days and holidays not counted in DaysRequested
+	ExcludedDates []holiday.ExcludedDate `json:"excluded_dates" gorm:"serializer:json"`
+	// Warnings are the staffing conflicts found when the request was saved or approved
+	Warnings []*Conflict `json:"warnings,omitempty" gorm:"-"`
+
+	// CurrentStep is the approval step awaiting a decision, 0 once the request is decided
+	CurrentStep   int             `json:"current_step" gorm:"not null;default:0"`
+	ApprovalSteps []*ApprovalStep `json:"approval_steps,omitempty" gorm:"foreignKey:LeaveID"`
+	// PendingApprovers may decide on the current step: its approver and their active
+	// delegates, or anyone with PendingApproverRole
+	PendingApprovers    []string `json:"pending_approvers,omitempty" gorm:"-"`
+	PendingApproverRole string   `json:"pending_approver_role,omitempty" gorm:"-"`
+
+	// Set when the request is cancelled before its leave starts
+	CancelledBy        *string    `json:"cancelled_by,omitempty" gorm:"type:uuid"`
+	CancelledAt        *time.Time `json:"cancelled_at,omitempty"`
+	CancellationReason string     `json:"cancellation_reason,omitempty"`
+	// Set when approved leave in progress is cut short. OriginalEndDate is the end
+	// date the leave was approved with, WithdrawnDays the days given back in total.
+	OriginalEndDate  *time.Time `json:"original_end_date,omitempty"`
+	WithdrawnDays    float64    `json:"withdrawn_days" gorm:"type:numeric(8,4);not null;default:0"`
+	WithdrawnBy      *string    `json:"withdrawn_by,omitempty" gorm:"type:uuid"`
+	WithdrawnAt      *time.Time `json:"withdrawn_at,omitempty"`
+	WithdrawalReason string     `json:"withdrawal_reason,omitempty"`
+
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
@@ -33,11 +71,22 @@ type LeaveBalance struct {
 	ID            string    `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
 	EmployeeID    string    `json:"employee_id" gorm:"not null;index"`
 	Employee      *Employee `json:"employee,omitempty" gorm:"foreignKey:EmployeeID"`
-	LeaveType     string    `json:"leave_type" gorm:"not null;check:leave_type IN ('ANNUAL','SICK','MATERNITY', 'PATERNITY', 'EMERGENCY', 'PERSONAL')"`
+	LeaveType     string    `json:"leave_type" gorm:"not null;check:leave_type IN ('ANNUAL','SICK','MATERNITY', 'PATERNITY', 'EMERGENCY', 'PERSONAL', 'COMP_OFF')"`
 	Year          int       `json:"year" gorm:"not null"`
-	TotalDays     int       `json:"total_days" gorm:"default:0"`
-	UsedDays      int       `json:"used_days" gorm:"default:0"`
-	RemainingDays int       `json:"remaining_days" gorm:"-"` // Computed field
+	TotalDays     float64   `json:"total_days" gorm:"type:numeric(8,4);default:0"`
+	UsedDays      float64   `json:"used_days" gorm:"type:numeric(8,4);default:0"`
+	RemainingDays float64   `json:"remaining_days" gorm:"-"` // Computed field
+	// AccruedDays is the part of TotalDays credited by the accrual job
+	AccruedDays float64 `json:"accrued_days" gorm:"type:numeric(8,4);default:0"`
+	// CarriedDays is the part of TotalDays brought over from the previous year,
+	// leave taken uses them first and what is left lapses on CarryExpiresOn
+	CarriedDays    float64    `json:"carried_days" gorm:"type:numeric(8,4);default:0"`
+	CarryExpiresOn *time.Time `json:"carry_expires_on,omitempty" gorm:"type:date"`
+	CarryExpiredAt *time.Time `json:"carry_expired_at,omitempty"`
+	ExpiredDays    float64    `json:"expired_days" gorm:"type:numeric(8,4);default:0"`
+	EncashedDays   float64    `json:"encashed_days" gorm:"type:numeric(8,4);default:0"`
+	// ClosedAt is set once the year-end close carried the balance forward
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
 
 	// Timestamps
 	CreatedAt time.Time      `json:"created_at"`
@@ -46,12 +95,159 @@ type LeaveBalance struct {
 }
 
 type Employee struct {
-	ID         string `json:"id" gorm:"type:uuid;primaryKey"`
-	EmployeeID string `json:"employee_id"`
-	FirstName  string `json:"first_name"`
-	LastName   string `json:"last_name"`
-	Email      string `json:"email"`
-	Status     string `json:"status"`
+	ID           string    `json:"id" gorm:"type:uuid;primaryKey"`
+	EmployeeID   string    `json:"employee_id"`
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	Email        string    `json:"email"`
+	Status       string    `json:"status"`
+	Country      string    `json:"country"`
+	DepartmentID *string   `json:"department_id,omitempty"`
+	HireDate     time.Time `json:"hire_date"`
+	Salary       float64   `json:"salary"`
+	Role         string    `json:"role"`
+	ManagerID    *string   `json:"manager_id,omitempty"`
+	// Location of the employee's department, it decides the holiday calendar
+	Location string `json:"location" gorm:"->"`
+	// DepartmentManagerID is the manager of the employee's department
+	DepartmentManagerID *string `json:"department_manager_id,omitempty" gorm:"->"`
+}
+
+// Accrual methods of a leave policy
+const (
+	AccrualAnnual  = "ANNUAL"
+	AccrualMonthly = "MONTHLY"
+)
+
+// LeavePolicy decides how many days of a leave type employees accrue and keep
+type LeavePolicy struct {
+	LeaveType       string  `json:"leave_type" gorm:"primaryKey"`
+	AccrualMethod   string  `json:"accrual_method" gorm:"not null;check:accrual_method IN ('ANNUAL','MONTHLY')"`
+	DaysPerYear     float64 `json:"days_per_year" gorm:"type:numeric(8,4);not null"`
+	ProbationMonths int     `json:"probation_months" gorm:"not null;default:0"`
+	Active          bool    `json:"active" gorm:"not null;default:true"`
+	// At most CarryForwardMaxDays unused days move to the next year, they lapse
+	// CarryForwardExpiryMonths into it or never when that is 0
+	CarryForwardMaxDays      float64 `json:"carry_forward_max_days" gorm:"type:numeric(8,4);not null;default:0"`
+	CarryForwardExpiryMonths int     `json:"carry_forward_expiry_months" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Approver types of an approval step
+const (
+	ApproverManager           = "MANAGER"
+	ApproverDepartmentManager = "DEPARTMENT_MANAGER"
+	ApproverHR                = "HR"
+)
+
+// Statuses of an approval step
+const (
+	StepPending  = "PENDING"
+	StepApproved = "APPROVED"
+	StepRejected = "REJECTED"
+	StepSkipped  = "SKIPPED"
+)
+
+// LeaveTypeCompOff is time off earned by working on a weekend or holiday, its
+// balance is credited by approved comp-off credits instead of accrual
+const LeaveTypeCompOff = "COMP_OFF"
+
+// Statuses of a comp-off credit
+const (
+	CreditPending  = "PENDING"
+	CreditApproved = "APPROVED"
+	CreditRejected = "REJECTED"
+)
+
+// CompOffCredit grants comp-off days for a weekend or holiday worked. Once approved
+// the days are in the COMP_OFF balance of the work date's year, or of a later year
+// when that one was closed before approval, BalanceID, and whatever is unused of
+// them lapses on ExpiresOn.
+type CompOffCredit struct {
+	ID          string     `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID  string     `json:"employee_id" gorm:"type:uuid;not null;index"`
+	Employee    *Employee  `json:"employee,omitempty" gorm:"foreignKey:EmployeeID"`
+	WorkDate    time.Time  `json:"work_date" gorm:"type:date;not null"`
+	Days        float64    `json:"days" gorm:"type:numeric(8,4);not null"`
+	Reason      string     `json:"reason" gorm:"not null"`
+	Status      string     `json:"status" gorm:"not null;default:'PENDING';check:status IN ('PENDING','APPROVED','REJECTED')"`
+	RequestedBy *string    `json:"requested_by,omitempty" gorm:"type:uuid"`
+	ApproverID  *string    `json:"approver_id,omitempty" gorm:"type:uuid"`
+	Comments    string     `json:"comments"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	BalanceID   *string    `json:"balance_id,omitempty" gorm:"type:uuid"`
+	ExpiresOn   *time.Time `json:"expires_on,omitempty" gorm:"type:date"`
+	ExpiredDays float64    `json:"expired_days" gorm:"type:numeric(8,4);not null;default:0"`
+	ExpiredAt   *time.Time `json:"expired_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func (CompOffCredit) TableName() string {
+	return "comp_off_credits"
+}
+
+// ApprovalRule is one step of the approval chain of a leave type. It only applies
+// to requests of more than MinDays days.
+type ApprovalRule struct {
+	LeaveType    string  `json:"leave_type" gorm:"primaryKey"`
+	StepOrder    int     `json:"step_order" gorm:"primaryKey"`
+	ApproverType string  `json:"approver_type" gorm:"not null;check:approver_type IN ('MANAGER','DEPARTMENT_MANAGER','HR')"`
+	MinDays      float64 `json:"min_days" gorm:"type:numeric(8,4);not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ApprovalStep is a decision a leave request needs, and the record of who made it
+type ApprovalStep struct {
+	ID           string  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	LeaveID      string  `json:"leave_id" gorm:"type:uuid;not null;index"`
+	StepOrder    int     `json:"step_order" gorm:"not null"`
+	ApproverType string  `json:"approver_type" gorm:"not null"`
+	ApproverID   *string `json:"approver_id,omitempty" gorm:"type:uuid"` // Empty for HR steps
+	Status       string  `json:"status" gorm:"not null;default:'PENDING';check:status IN ('PENDING','APPROVED','REJECTED','SKIPPED')"`
+	DecidedBy    *string `json:"decided_by,omitempty" gorm:"type:uuid"`
+	// OnBehalfOf is the approver a delegate decided for
+	OnBehalfOf *string    `json:"on_behalf_of,omitempty" gorm:"type:uuid"`
+	Comments   string     `json:"comments"`
+	DecidedAt  *time.Time `json:"decided_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Delegation lets the delegate decide on the approval steps of the delegator
+// between StartDate and EndDate, typically while the delegator is away
+type Delegation struct {
+	ID          string    `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	DelegatorID string    `json:"delegator_id" gorm:"type:uuid;not null;index"`
+	DelegateID  string    `json:"delegate_id" gorm:"type:uuid;not null;index"`
+	StartDate   time.Time `json:"start_date" gorm:"type:date;not null"`
+	EndDate     time.Time `json:"end_date" gorm:"type:date;not null"`
+	Reason      string    `json:"reason"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (ApprovalRule) TableName() string {
+	return "leave_approval_rules"
+}
+
+func (ApprovalStep) TableName() string {
+	return "leave_approval_steps"
+}
+
+func (Delegation) TableName() string {
+	return "leave_approval_delegations"
+}
+
+func (LeavePolicy) TableName() string {
+	return "leave_policies"
 }
 
 func (LeaveRequest) TableName() string {
@@ -64,17 +260,21 @@ func (LeaveBalance) TableName() string {
 
 type CreateLeaveRequestRequest struct {
 	EmployeeID string    `json:"employee_id" validate:"required"`
-	LeaveType  string    `json:"leave_type" validate:"required,oneof=ANNUAL SICK MATERNITY PATERNITY EMERGENCY PERSONAL"`
+	LeaveType  string    `json:"leave_type" validate:"required,oneof=ANNUAL SICK MATERNITY PATERNITY EMERGENCY PERSONAL COMP_OFF"`
 	StartDate  time.Time `json:"start_date" validate:"required"`
 	EndDate    time.Time `json:"end_date" validate:"required"`
 	Reason     string    `json:"reason,omitempty"`
+	Duration   string    `json:"duration,omitempty" validate:"omitempty,oneof=FULL_DAY HALF_DAY_AM HALF_DAY_PM HOURLY"`
+	Hours      float64   `json:"hours,omitempty"`
 }
 
 type UpdateLeaveRequestRequest struct {
-	LeaveType string    `json:"leave_type,omitempty" validate:"omitempty,oneof=ANNUAL SICK MATERNITY PATERNITY EMERGENCY PERSONAL"`
+	LeaveType string    `json:"leave_type,omitempty" validate:"omitempty,oneof=ANNUAL SICK MATERNITY PATERNITY EMERGENCY PERSONAL COMP_OFF"`
 	StartDate time.Time `json:"start_date,omitempty"`
 	EndDate   time.Time `json:"end_date,omitempty"`
 	Reason    string    `json:"reason,omitempty"`
+	Duration  string    `json:"duration,omitempty" validate:"omitempty,oneof=FULL_DAY HALF_DAY_AM HALF_DAY_PM HOURLY"`
+	Hours     float64   `json:"hours,omitempty"`
 }
 
 type ListLeaveRequestsRequest struct {
@@ -82,7 +282,7 @@ type ListLeaveRequestsRequest struct {
 	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
 	EmployeeID string `json:"employee_id,omitempty"`
 	Status     string `json:"status,omitempty" validate:"omitempty,oneof=PENDING APPROVED REJECTED CANCELLED"`
-	LeaveType  string `json:"leave_type,omitempty" validate:"omitempty, oneof=ANNUAL SICK MATERNITY PATERNITY EMERGENCY PERSONAL"`
+	LeaveType  string `json:"leave_type,omitempty" validate:"omitempty, oneof=ANNUAL SICK MATERNITY PATERNITY EMERGENCY PERSONAL COMP_OFF"`
 }
 
 type ListLeaveRequestsResponse struct {
@@ -93,13 +293,77 @@ type ListLeaveRequestsResponse struct {
 }
 
 type ApproveLeaveRequestRequest struct {
-	ApproverID string `json:"approver_id" validate:"required"`
-	Comments   string `json:"comments,omitempty"`
+	ApproverID   string `json:"approver_id" validate:"required"`
+	ApproverRole string `json:"approver_role,omitempty"`
+	Comments     string `json:"comments,omitempty"`
+	// Step and OnBehalfOf are set by the service once the approver is authorized
+	Step       int     `json:"-"`
+	OnBehalfOf *string `json:"-"`
 }
 
 type RejectLeaveRequestRequest struct {
-	ApproverID string `json:"approver_id" validate:"required"`
-	Comments   string `json:"comments" validate:"required"`
+	ApproverID   string `json:"approver_id" validate:"required"`
+	ApproverRole string `json:"approver_role,omitempty"`
+	Comments     string `json:"comments" validate:"required"`
+	// Step and OnBehalfOf are set by the service once the approver is authorized
+	Step       int     `json:"-"`
+	OnBehalfOf *string `json:"-"`
+}
+
+type CancelLeaveRequestRequest struct {
+	CancelledBy string `json:"cancelled_by" validate:"required"`
+	Reason      string `json:"reason" validate:"required"`
+}
+
+// WithdrawLeaveRequestRequest cuts approved leave short, LastDay is the last day
+// the employee stays on leave
+type WithdrawLeaveRequestRequest struct {
+	LastDay     time.Time `json:"last_day" validate:"required"`
+	WithdrawnBy string    `json:"withdrawn_by" validate:"required"`
+	Reason      string    `json:"reason" validate:"required"`
+}
+
+type WithdrawLeaveResponse struct {
+	LeaveRequest *LeaveRequest `json:"leave_request"`
+	RestoredDays float64       `json:"restored_days"`
+}
+
+type CreateDelegationRequest struct {
+	DelegatorID string    `json:"delegator_id" validate:"required"`
+	DelegateID  string    `json:"delegate_id" validate:"required"`
+	StartDate   time.Time `json:"start_date" validate:"required"`
+	EndDate     time.Time `json:"end_date" validate:"required"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+type ListDelegationsRequest struct {
+	EmployeeID string `json:"employee_id,omitempty"`
+	ActiveOnly bool   `json:"active_only,omitempty"`
+}
+
+// TeamCalendarRequest selects the employees of a calendar: one employee, the
+// employees of a department or the employees a manager manages
+type TeamCalendarRequest struct {
+	EmployeeID   string    `json:"employee_id,omitempty"`
+	DepartmentID string    `json:"department_id,omitempty"`
+	ManagerID    string    `json:"manager_id,omitempty"`
+	StartDate    time.Time `json:"start_date"`
+	EndDate      time.Time `json:"end_date"`
+	// CallerID is set by the handler, only the caller's own absences keep their reason
+	CallerID string `json:"-"`
+}
+
+// TeamCalendar holds the pending and approved leave of the employees and the
+// holidays observed where they work
+type TeamCalendar struct {
+	Absences []*LeaveRequest    `json:"absences"`
+	Holidays []*holiday.Holiday `json:"holidays"`
+}
+
+type CalendarExport struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
 }
 
 type GetEmployeeLeaveBalanceRequest struct {
@@ -111,13 +375,111 @@ type GetEmployeeLeaveBalanceResponse struct {
 	LeaveBalances []*LeaveBalance `json:"leave_balances"`
 }
 
+type RunAccrualRequest struct {
+	Year       int    `json:"year"`
+	DryRun     bool   `json:"dry_run"`
+	EmployeeID string `json:"employee_id,omitempty"`
+}
+
+// Accrual is the outcome of accruing one leave type for one employee
+type Accrual struct {
+	EmployeeID            string  `json:"employee_id"`
+	LeaveType             string  `json:"leave_type"`
+	Year                  int     `json:"year"`
+	EntitledDays          float64 `json:"entitled_days"`
+	PreviouslyAccruedDays float64 `json:"previously_accrued_days"`
+	CreditedDays          float64 `json:"credited_days"`
+}
+
+type RunAccrualResponse struct {
+	Accruals           []*Accrual `json:"accruals"`
+	Year               int        `json:"year"`
+	AsOf               time.Time  `json:"as_of"`
+	DryRun             bool       `json:"dry_run"`
+	EmployeesProcessed int        `json:"employees_processed"`
+	EmployeesFailed    int        `json:"employees_failed"`
+	TotalCreditedDays  float64    `json:"total_credited_days"`
+}
+
+type CloseYearRequest struct {
+	Year   int  `json:"year"`
+	DryRun bool `json:"dry_run"`
+}
+
+// CarryForward is the outcome of closing the year of one leave balance
+type CarryForward struct {
+	EmployeeID    string     `json:"employee_id"`
+	LeaveType     string     `json:"leave_type"`
+	Year          int        `json:"year"`
+	UnusedDays    float64    `json:"unused_days"`
+	CarriedDays   float64    `json:"carried_days"`
+	ForfeitedDays float64    `json:"forfeited_days"`
+	ExpiresOn     *time.Time `json:"expires_on,omitempty"`
+}
+
+type CloseYearResponse struct {
+	CarryForwards      []*CarryForward `json:"carry_forwards"`
+	Year               int             `json:"year"`
+	DryRun             bool            `json:"dry_run"`
+	BalancesClosed     int             `json:"balances_closed"`
+	BalancesFailed     int             `json:"balances_failed"`
+	TotalCarriedDays   float64         `json:"total_carried_days"`
+	TotalForfeitedDays float64         `json:"total_forfeited_days"`
+}
+
+type EncashLeaveRequest struct {
+	EmployeeID  string  `json:"employee_id" validate:"required"`
+	Year        int     `json:"year,omitempty"`
+	Days        float64 `json:"days" validate:"required"`
+	RequestedBy string  `json:"requested_by,omitempty"`
+}
+
+type RequestCompOffRequest struct {
+	EmployeeID  string    `json:"employee_id" validate:"required"`
+	WorkDate    time.Time `json:"work_date" validate:"required"`
+	Days        float64   `json:"days" validate:"required,gt=0,lte=1"`
+	Reason      string    `json:"reason" validate:"required"`
+	RequestedBy string    `json:"requested_by" validate:"required"`
+}
+
+type ListCompOffCreditsRequest struct {
+	Page       int    `json:"page" validate:"min=1"`
+	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
+	EmployeeID string `json:"employee_id,omitempty"`
+	Status     string `json:"status,omitempty" validate:"omitempty,oneof=PENDING APPROVED REJECTED"`
+}
+
+type ListCompOffCreditsResponse struct {
+	Credits    []*CompOffCredit `json:"credits"`
+	TotalCount int64            `json:"total_count"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"page_size"`
+}
+
+type DecideCompOffRequest struct {
+	ApproverID string `json:"approver_id" validate:"required"`
+	Comments   string `json:"comments"`
+	// ExpiresOn is set by the service from the expiry window when approving
+	ExpiresOn time.Time `json:"-"`
+}
+
+type ApproveCompOffResponse struct {
+	Credit       *CompOffCredit `json:"credit"`
+	LeaveBalance *LeaveBalance  `json:"leave_balance"`
+}
+
+type EncashLeaveResponse struct {
+	LeaveBalance *LeaveBalance     `json:"leave_balance"`
+	LineItem     *payroll.LineItem `json:"line_item"`
+}
+
 func (lr *LeaveRequest) ToProto() *leavepb.LeaveRequest {
 	leave := &leavepb.LeaveRequest{
 		Id:            lr.ID,
 		EmployeeId:    lr.EmployeeID,
 		StartDate:     timestamppb.New(lr.StartDate),
 		EndDate:       timestamppb.New(lr.EndDate),
-		DaysRequested: int32(lr.DaysRequested),
+		DaysRequested: lr.DaysRequested,
 		Reason:        lr.Reason,
 		Comments:      lr.Comments,
 		CreatedAt:     timestamppb.New(lr.CreatedAt),
@@ -142,22 +504,59 @@ func (lr *LeaveRequest) ToProto() *leavepb.LeaveRequest {
 		leave.ApprovedAt = timestamppb.New(*lr.ApprovedAt)
 	}
 
-	// Set leave type
-	switch lr.LeaveType {
-	case "ANNUAL":
-		leave.LeaveType = leavepb.LeaveType_LEAVE_TYPE_ANNUAL
-	case "SICK":
-		leave.LeaveType = leavepb.LeaveType_LEAVE_TYPE_SICK
-	case "MATERNITY":
-		leave.LeaveType = leavepb.LeaveType_LEAVE_TYPE_MATERNITY
-	case "PATERNITY":
-		leave.LeaveType = leavepb.LeaveType_LEAVE_TYPE_PATERNITY
-	case "EMERGENCY":
-		leave.LeaveType = leavepb.LeaveType_LEAVE_TYPE_EMERGENCY
-	case "PERSONAL":
-		leave.LeaveType = leavepb.LeaveType_LEAVE_TYPE_PERSONAL
+	for _, excluded := range lr.ExcludedDates {
+		leave.ExcludedDates = append(leave.ExcludedDates, &leavepb.ExcludedDate{
+			Date:   timestamppb.New(excluded.Date),
+			Reason: excluded.Reason,
+			Name:   excluded.Name,
+		})
+	}
+
+	leave.LeaveType = leaveTypeToProto(lr.LeaveType)
+
+	leave.CurrentStep = int32(lr.CurrentStep)
+	leave.PendingApproverIds = lr.PendingApprovers
+	leave.PendingApproverRole = lr.PendingApproverRole
+	for _, step := range lr.ApprovalSteps {
+		leave.ApprovalSteps = append(leave.ApprovalSteps, step.ToProto())
+	}
+
+	if lr.Hours != nil {
+		leave.Hours = *lr.Hours
+	}
+
+	if lr.CancelledBy != nil {
+		leave.CancelledBy = *lr.CancelledBy
+	}
+	if lr.CancelledAt != nil {
+		leave.CancelledAt = timestamppb.New(*lr.CancelledAt)
+	}
+	leave.CancellationReason = lr.CancellationReason
+
+	if lr.OriginalEndDate != nil {
+		leave.OriginalEndDate = timestamppb.New(*lr.OriginalEndDate)
+	}
+	leave.WithdrawnDays = lr.WithdrawnDays
+	if lr.WithdrawnBy != nil {
+		leave.WithdrawnBy = *lr.WithdrawnBy
+	}
+	if lr.WithdrawnAt != nil {
+		leave.WithdrawnAt = timestamppb.New(*lr.WithdrawnAt)
+	}
+	leave.WithdrawalReason = lr.WithdrawalReason
+
+	// Set duration
+	switch lr.Duration {
+	case DurationFullDay:
+		leave.Duration = leavepb.LeaveDuration_LEAVE_DURATION_FULL_DAY
+	case DurationHalfDayAM:
+		leave.Duration = leavepb.LeaveDuration_LEAVE_DURATION_HALF_DAY_AM
+	case DurationHalfDayPM:
+		leave.Duration = leavepb.LeaveDuration_LEAVE_DURATION_HALF_DAY_PM
+	case DurationHourly:
+		leave.Duration = leavepb.LeaveDuration_LEAVE_DURATION_HOURLY
 	default:
-		leave.LeaveType = leavepb.LeaveType_LEAVE_TYPE_UNSPECIFIED
+		leave.Duration = leavepb.LeaveDuration_LEAVE_DURATION_UNSPECIFIED
 	}
 
 	// Set status
@@ -181,45 +580,253 @@ func (lr *LeaveRequest) ToProto() *leavepb.LeaveRequest {
 func (lb *LeaveBalance) ToProto() *leavepb.LeaveBalance {
 	balance := &leavepb.LeaveBalance{
 		EmployeeId:    lb.EmployeeID,
-		TotalDays:     int32(lb.TotalDays),
-		UsedDays:      int32(lb.UsedDays),
-		RemainingDays: int32(lb.GetRemainingDays()),
+		TotalDays:     lb.TotalDays,
+		UsedDays:      lb.UsedDays,
+		RemainingDays: lb.GetRemainingDays(),
 		Year:          int32(lb.Year),
+		AccruedDays:   lb.AccruedDays,
+		CarriedDays:   lb.CarriedDays,
+		ExpiredDays:   lb.ExpiredDays,
+		EncashedDays:  lb.EncashedDays,
+		LeaveType:     leaveTypeToProto(lb.LeaveType),
+	}
+
+	if lb.CarryExpiresOn != nil {
+		balance.CarryExpiresOn = timestamppb.New(*lb.CarryExpiresOn)
+	}
+
+	return balance
+}
+
+func (p *LeavePolicy) ToProto() *leavepb.LeavePolicy {
+	policy := &leavepb.LeavePolicy{
+		LeaveType:       leaveTypeToProto(p.LeaveType),
+		DaysPerYear:     p.DaysPerYear,
+		ProbationMonths: int32(p.ProbationMonths),
+		Active:          p.Active,
+
+		CarryForwardMaxDays:      p.CarryForwardMaxDays,
+		CarryForwardExpiryMonths: int32(p.CarryForwardExpiryMonths),
 	}
 
-	// Set leave type
-	switch lb.LeaveType {
+	switch p.AccrualMethod {
+	case AccrualAnnual:
+		policy.AccrualMethod = leavepb.AccrualMethod_ACCRUAL_METHOD_ANNUAL
+	case AccrualMonthly:
+		policy.AccrualMethod = leavepb.AccrualMethod_ACCRUAL_METHOD_MONTHLY
+	default:
+		policy.AccrualMethod = leavepb.AccrualMethod_ACCRUAL_METHOD_UNSPECIFIED
+	}
+
+	return policy
+}
+
+func (a *Accrual) ToProto() *leavepb.LeaveAccrual {
+	return &leavepb.LeaveAccrual{
+		EmployeeId:            a.EmployeeID,
+		LeaveType:             leaveTypeToProto(a.LeaveType),
+		Year:                  int32(a.Year),
+		EntitledDays:          a.EntitledDays,
+		PreviouslyAccruedDays: a.PreviouslyAccruedDays,
+		CreditedDays:          a.CreditedDays,
+	}
+}
+
+func (st *ApprovalStep) ToProto() *leavepb.LeaveApprovalStep {
+	step := &leavepb.LeaveApprovalStep{
+		Step:         int32(st.StepOrder),
+		ApproverType: approverTypeToProto(st.ApproverType),
+		Comments:     st.Comments,
+	}
+	if st.ApproverID != nil {
+		step.ApproverId = *st.ApproverID
+	}
+	if st.DecidedBy != nil {
+		step.DecidedBy = *st.DecidedBy
+	}
+	if st.OnBehalfOf != nil {
+		step.OnBehalfOf = *st.OnBehalfOf
+	}
+	if st.DecidedAt != nil {
+		step.DecidedAt = timestamppb.New(*st.DecidedAt)
+	}
+
+	switch st.Status {
+	case StepPending:
+		step.Status = leavepb.ApprovalStepStatus_APPROVAL_STEP_STATUS_PENDING
+	case StepApproved:
+		step.Status = leavepb.ApprovalStepStatus_APPROVAL_STEP_STATUS_APPROVED
+	case StepRejected:
+		step.Status = leavepb.ApprovalStepStatus_APPROVAL_STEP_STATUS_REJECTED
+	case StepSkipped:
+		step.Status = leavepb.ApprovalStepStatus_APPROVAL_STEP_STATUS_SKIPPED
+	default:
+		step.Status = leavepb.ApprovalStepStatus_APPROVAL_STEP_STATUS_UNSPECIFIED
+	}
+
+	return step
+}
+
+func (r *ApprovalRule) ToProto() *leavepb.ApprovalRule {
+	return &leavepb.ApprovalRule{
+		Step:         int32(r.StepOrder),
+		ApproverType: approverTypeToProto(r.ApproverType),
+		MinDays:      r.MinDays,
+	}
+}
+
+func (d *Delegation) ToProto() *leavepb.ApprovalDelegation {
+	return &leavepb.ApprovalDelegation{
+		Id:         d.ID,
+		EmployeeId: d.DelegatorID,
+		DelegateId: d.DelegateID,
+		StartDate:  timestamppb.New(d.StartDate),
+		EndDate:    timestamppb.New(d.EndDate),
+		Reason:     d.Reason,
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+	}
+}
+
+func (c *CompOffCredit) ToProto() *leavepb.CompOffCredit {
+	credit := &leavepb.CompOffCredit{
+		Id:          c.ID,
+		EmployeeId:  c.EmployeeID,
+		WorkDate:    timestamppb.New(c.WorkDate),
+		Days:        c.Days,
+		Reason:      c.Reason,
+		Comments:    c.Comments,
+		ExpiredDays: c.ExpiredDays,
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+	}
+	if c.Employee != nil {
+		credit.EmployeeName = c.Employee.FirstName + " " + c.Employee.LastName
+	}
+	if c.RequestedBy != nil {
+		credit.RequestedBy = *c.RequestedBy
+	}
+	if c.ApproverID != nil {
+		credit.ApproverId = *c.ApproverID
+	}
+	if c.DecidedAt != nil {
+		credit.DecidedAt = timestamppb.New(*c.DecidedAt)
+	}
+	if c.ExpiresOn != nil {
+		credit.ExpiresOn = timestamppb.New(*c.ExpiresOn)
+	}
+	if c.ExpiredAt != nil {
+		credit.ExpiredAt = timestamppb.New(*c.ExpiredAt)
+	}
+
+	switch c.Status {
+	case CreditPending:
+		credit.Status = leavepb.CompOffStatus_COMP_OFF_STATUS_PENDING
+	case CreditApproved:
+		credit.Status = leavepb.CompOffStatus_COMP_OFF_STATUS_APPROVED
+	case CreditRejected:
+		credit.Status = leavepb.CompOffStatus_COMP_OFF_STATUS_REJECTED
+	}
+	return credit
+}
+
+func approverTypeToProto(approverType string) leavepb.ApproverType {
+	switch approverType {
+	case ApproverManager:
+		return leavepb.ApproverType_APPROVER_TYPE_MANAGER
+	case ApproverDepartmentManager:
+		return leavepb.ApproverType_APPROVER_TYPE_DEPARTMENT_MANAGER
+	case ApproverHR:
+		return leavepb.ApproverType_APPROVER_TYPE_HR
+	default:
+		return leavepb.ApproverType_APPROVER_TYPE_UNSPECIFIED
+	}
+}
+
+func (c *TeamCalendar) ToProto() *leavepb.GetTeamCalendarResponse {
+	response := &leavepb.GetTeamCalendarResponse{
+		Absences: make([]*leavepb.LeaveRequest, len(c.Absences)),
+		Holidays: make([]*leavepb.CalendarHoliday, len(c.Holidays)),
+	}
+	for i, absence := range c.Absences {
+		response.Absences[i] = absence.ToProto()
+	}
+	for i, h := range c.Holidays {
+		response.Holidays[i] = &leavepb.CalendarHoliday{
+			Date:     timestamppb.New(h.Date),
+			Name:     h.Name,
+			Country:  h.Country,
+			Location: h.Location,
+		}
+	}
+	return response
+}
+
+func (c *CarryForward) ToProto() *leavepb.LeaveCarryForward {
+	carryForward := &leavepb.LeaveCarryForward{
+		EmployeeId:    c.EmployeeID,
+		LeaveType:     leaveTypeToProto(c.LeaveType),
+		Year:          int32(c.Year),
+		UnusedDays:    c.UnusedDays,
+		CarriedDays:   c.CarriedDays,
+		ForfeitedDays: c.ForfeitedDays,
+	}
+	if c.ExpiresOn != nil {
+		carryForward.ExpiresOn = timestamppb.New(*c.ExpiresOn)
+	}
+	return carryForward
+}
+
+func lineItemToProto(item *payroll.LineItem) *leavepb.PayrollLineItem {
+	return &leavepb.PayrollLineItem{
+		Id:          item.ID,
+		EmployeeId:  item.EmployeeID,
+		ItemType:    item.ItemType,
+		Description: item.Description,
+		Quantity:    item.Quantity,
+		Rate:        item.Rate,
+		Amount:      item.Amount,
+		CreatedAt:   timestamppb.New(item.CreatedAt),
+	}
+}
+
+func leaveTypeToProto(leaveType string) leavepb.LeaveType {
+	switch leaveType {
 	case "ANNUAL":
-		balance.LeaveType = leavepb.LeaveType_LEAVE_TYPE_ANNUAL
+		return leavepb.LeaveType_LEAVE_TYPE_ANNUAL
 	case "SICK":
-		balance.LeaveType = leavepb.LeaveType_LEAVE_TYPE_SICK
+		return leavepb.LeaveType_LEAVE_TYPE_SICK
 	case "MATERNITY":
-		balance.LeaveType = leavepb.LeaveType_LEAVE_TYPE_MATERNITY
+		return leavepb.LeaveType_LEAVE_TYPE_MATERNITY
 	case "PATERNITY":
-		balance.LeaveType = leavepb.LeaveType_LEAVE_TYPE_PATERNITY
+		return leavepb.LeaveType_LEAVE_TYPE_PATERNITY
 	case "EMERGENCY":
-		balance.LeaveType = leavepb.LeaveType_LEAVE_TYPE_EMERGENCY
+		return leavepb.LeaveType_LEAVE_TYPE_EMERGENCY
 	case "PERSONAL":
-		balance.LeaveType = leavepb.LeaveType_LEAVE_TYPE_PERSONAL
+		return leavepb.LeaveType_LEAVE_TYPE_PERSONAL
+	case LeaveTypeCompOff:
+		return leavepb.LeaveType_LEAVE_TYPE_COMP_OFF
 	default:
-		balance.LeaveType = leavepb.LeaveType_LEAVE_TYPE_UNSPECIFIED
+		return leavepb.LeaveType_LEAVE_TYPE_UNSPECIFIED
 	}
-
-	return balance
 }
 
+// FromCreateRequest leaves DaysRequested to the service, which counts working days
 func FromCreateRequest(req *CreateLeaveRequestRequest) *LeaveRequest {
-	daysRequested := calculateDaysRequested(req.StartDate, req.EndDate)
-
-	return &LeaveRequest{
-		EmployeeID:    req.EmployeeID,
-		LeaveType:     req.LeaveType,
-		StartDate:     req.StartDate,
-		EndDate:       req.EndDate,
-		DaysRequested: daysRequested,
-		Reason:        req.Reason,
-		LeaveStatus:   "PENDING",
+	leave := &LeaveRequest{
+		EmployeeID:  req.EmployeeID,
+		LeaveType:   req.LeaveType,
+		StartDate:   holiday.DateOf(req.StartDate),
+		EndDate:     holiday.DateOf(req.EndDate),
+		Reason:      req.Reason,
+		Duration:    req.Duration,
+		LeaveStatus: "PENDING",
+	}
+	if leave.Duration == "" {
+		leave.Duration = DurationFullDay
 	}
+	if leave.Duration == DurationHourly {
+		leave.Hours = &req.Hours
+	}
+	return leave
 }
 
 func (lr *LeaveRequest) ApplyUpdate(req *UpdateLeaveRequestRequest) {
@@ -227,24 +834,30 @@ func (lr *LeaveRequest) ApplyUpdate(req *UpdateLeaveRequestRequest) {
 		lr.LeaveType = req.LeaveType
 	}
 	if !req.StartDate.IsZero() {
-		lr.StartDate = req.StartDate
+		lr.StartDate = holiday.DateOf(req.StartDate)
 	}
 	if !req.EndDate.IsZero() {
-		lr.EndDate = req.EndDate
+		lr.EndDate = holiday.DateOf(req.EndDate)
 	}
 	if req.Reason != "" {
 		lr.Reason = req.Reason
 	}
-
-	// Recalculate days if dates changed
-	if !req.StartDate.IsZero() || !req.EndDate.IsZero() {
-		lr.DaysRequested = calculateDaysRequested(lr.StartDate, lr.EndDate)
+	if req.Duration != "" {
+		lr.Duration = req.Duration
+	}
+	if req.Hours != 0 {
+		lr.Hours = &req.Hours
+	}
+	if lr.Duration != DurationHourly {
+		lr.Hours = nil
+	} else if lr.Hours == nil {
+		lr.Hours = new(float64)
 	}
 }
 
-// GetRemainingDays calculates and returns remaining days
-func (lb *LeaveBalance) GetRemainingDays() int {
-	remaining := lb.TotalDays - lb.UsedDays
+// GetRemainingDays calculates and returns remaining days, expired and encashed days are gone
+func (lb *LeaveBalance) GetRemainingDays() float64 {
+	remaining := RoundDays(lb.TotalDays - lb.UsedDays - lb.ExpiredDays - lb.EncashedDays)
 	if remaining < 0 {
 		return 0
 	}
@@ -252,19 +865,14 @@ func (lb *LeaveBalance) GetRemainingDays() int {
 }
 
 // HasSufficientBalance checks if there are sufficient days for a request
-func (lb *LeaveBalance) HasSufficientBalance(requestedDays int) bool {
-	return lb.GetRemainingDays() >= requestedDays
+func (lb *LeaveBalance) HasSufficientBalance(requestedDays float64) bool {
+	return lb.GetRemainingDays() >= RoundDays(requestedDays)
 }
 
-// calculateDaysRequested calculates the number of days between start and end date
-func calculateDaysRequested(startDate, endDate time.Time) int {
-	// Simple calculation - in a real system, you'd exclude weekends and holidays
-	duration := endDate.Sub(startDate)
-	days := int(duration.Hours()/24) + 1 // Include both start and end dates
-	if days < 0 {
-		return 0
-	}
-	return days
+// RoundDays rounds to the 4 decimals days are stored with, so sums of
+// hourly leave don't drift from what the database holds
+func RoundDays(days float64) float64 {
+	return math.Round(days*10000) / 10000
 }
 
 func (lr *LeaveRequest) GetEmployeeName() string {
@@ -281,10 +889,25 @@ func (lr *LeaveRequest) GetApproverName() string {
 	return ""
 }
 
+// hideReasons clears the free text the employee and their approvers wrote, before
+// the leave is shown to colleagues
+func (lr *LeaveRequest) hideReasons() {
+	lr.Reason = ""
+	lr.Comments = ""
+	lr.CancellationReason = ""
+	lr.WithdrawalReason = ""
+	for _, step := range lr.ApprovalSteps {
+		step.Comments = ""
+	}
+}
+
 // GetDuration returns the duration of the leave in a human-readable format
 func (lr *LeaveRequest) GetDuration() string {
+	if lr.Duration == DurationHourly && lr.Hours != nil {
+		return fmt.Sprintf("%g hours", *lr.Hours)
+	}
 	if lr.DaysRequested == 1 {
 		return "1 day"
 	}
-	return fmt.Sprintf("%d days", lr.DaysRequested)
+	return fmt.Sprintf("%g days", lr.DaysRequested)
 }
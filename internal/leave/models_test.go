@@ -0,0 +1,93 @@
+package leave
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromCreateRequest(t *testing.T) {
+	start := time.Date(2025, time.March, 3, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		req      CreateLeaveRequestRequest
+		duration string
+		hours    *float64
+	}{
+		{
+			name:     "duration defaults to a full day",
+			req:      CreateLeaveRequestRequest{StartDate: start, EndDate: start},
+			duration: DurationFullDay,
+		},
+		{
+			name:     "half day keeps no hours",
+			req:      CreateLeaveRequestRequest{StartDate: start, EndDate: start, Duration: DurationHalfDayAM, Hours: 3},
+			duration: DurationHalfDayAM,
+		},
+		{
+			name:     "hourly keeps the hours",
+			req:      CreateLeaveRequestRequest{StartDate: start, EndDate: start, Duration: DurationHourly, Hours: 3},
+			duration: DurationHourly,
+			hours:    ptr(3.0),
+		},
+		{
+			name:     "hourly without hours",
+			req:      CreateLeaveRequestRequest{StartDate: start, EndDate: start, Duration: DurationHourly},
+			duration: DurationHourly,
+			hours:    ptr(0.0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leave := FromCreateRequest(&tt.req)
+
+			if leave.Duration != tt.duration {
+				t.Errorf("Duration = %s, want %s", leave.Duration, tt.duration)
+			}
+			if (leave.Hours == nil) != (tt.hours == nil) || (leave.Hours != nil && *leave.Hours != *tt.hours) {
+				t.Errorf("Hours = %v, want %v", leave.Hours, tt.hours)
+			}
+			if want := date(2025, time.March, 3); !leave.StartDate.Equal(want) || !leave.EndDate.Equal(want) {
+				t.Errorf("dates = %v to %v, want the date %v without the time", leave.StartDate, leave.EndDate, want)
+			}
+			if leave.LeaveStatus != "PENDING" {
+				t.Errorf("LeaveStatus = %s, want PENDING", leave.LeaveStatus)
+			}
+		})
+	}
+}
+
+func TestRoundDays(t *testing.T) {
+	tests := []struct {
+		days float64
+		want float64
+	}{
+		{days: 1.0 / 3, want: 0.3333},
+		{days: 2.0 / 3, want: 0.6667},
+		{days: 0.1 + 0.2, want: 0.3},
+		{days: 2.5, want: 2.5},
+	}
+
+	for _, tt := range tests {
+		if got := RoundDays(tt.days); got != tt.want {
+			t.Errorf("RoundDays(%v) = %v, want %v", tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestHasSufficientBalance(t *testing.T) {
+	// Three hourly leaves of a third of a day each use exactly one day
+	balance := &LeaveBalance{TotalDays: 2, UsedDays: RoundDays(3 * RoundDays(1.0/3))}
+
+	if !balance.HasSufficientBalance(1.0001) {
+		t.Errorf("HasSufficientBalance(1.0001) = false with %v days remaining", balance.GetRemainingDays())
+	}
+	if balance.HasSufficientBalance(1.0002) {
+		t.Errorf("HasSufficientBalance(1.0002) = true with %v days remaining", balance.GetRemainingDays())
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -2,10 +2,30 @@ package leave
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
+	"github.com/dmehra2102/hr-management-system/internal/payroll"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrLeaveNotFound       = errors.New("leave request not found")
+	ErrLeaveNotPending     = errors.New("leave request is not pending")
+	ErrBalanceNotFound     = errors.New("leave balance not found")
+	ErrInsufficientBalance = errors.New("insufficient leave balance")
+	ErrEmployeeNotFound    = errors.New("employee not found")
+	ErrBalanceClosed       = errors.New("leave balance is already closed")
+	ErrStepChanged         = errors.New("leave request is awaiting another approval step")
+	ErrDelegationNotFound  = errors.New("approval delegation not found")
+	ErrLeaveNotCancellable = errors.New("only pending or approved leave can be cancelled")
+	ErrLeaveNotApproved    = errors.New("leave request is not approved")
+	ErrLeaveChanged        = errors.New("leave request was changed by someone else")
+	ErrCompOffNotFound     = errors.New("comp-off credit not found")
+	ErrCompOffNotPending   = errors.New("comp-off credit is not pending")
 )
 
 type Repository interface {
@@ -14,10 +34,64 @@ type Repository interface {
 	GetByEmployeeID(ctx context.Context, employeeID string) (*LeaveRequest, error)
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, req *ListLeaveRequestsRequest) (*ListLeaveRequestsResponse, error)
-	Update(ctx context.Context, id string, req *UpdateLeaveRequestRequest) error
+	Update(ctx context.Context, leave *LeaveRequest) error
 	ApproveLeave(ctx context.Context, id string, req *ApproveLeaveRequestRequest) error
 	RejectLeave(ctx context.Context, id string, req *RejectLeaveRequestRequest) error
+	// CancelLeave cancels pending or approved leave and gives the days of approved leave back to the balance
+	CancelLeave(ctx context.Context, id string, req *CancelLeaveRequestRequest) error
+	// WithdrawLeave shortens approved leave to the end date and days of withdrawn and
+	// gives the days no longer taken back to the balance, returning how many
+	WithdrawLeave(ctx context.Context, withdrawn *LeaveRequest, req *WithdrawLeaveRequestRequest) (float64, error)
 	LeaveBalance(ctx context.Context, req *GetEmployeeLeaveBalanceRequest) (*GetEmployeeLeaveBalanceResponse, error)
+	GetEmployee(ctx context.Context, id string) (*Employee, error)
+	ListPolicies(ctx context.Context) ([]*LeavePolicy, error)
+	SavePolicy(ctx context.Context, policy *LeavePolicy) error
+	// ListAccrualEmployees returns the employees accruing leave, all of them when employeeID is empty
+	ListAccrualEmployees(ctx context.Context, employeeID string) ([]*Employee, error)
+	GetBalance(ctx context.Context, employeeID, leaveType string, year int) (*LeaveBalance, error)
+	// ApplyAccrual creates the balance if needed and credits the days entitled beyond those
+	// accrued before, ErrBalanceClosed once the year was closed
+	ApplyAccrual(ctx context.Context, employeeID, leaveType string, year int, entitledDays float64) (*Accrual, error)
+	// FindOverlapping returns the pending and approved leave of the employee within start and end, except excludeID
+	FindOverlapping(ctx context.Context, employeeID string, start, end time.Time, excludeID string) ([]*LeaveRequest, error)
+	// GetStaffingRule returns the staffing rule of the department, nil when the department does not exist
+	GetStaffingRule(ctx context.Context, departmentID string) (*StaffingRule, error)
+	// ListDepartmentAbsences returns the approved leave within start and end of the department's other employees
+	ListDepartmentAbsences(ctx context.Context, departmentID string, start, end time.Time, excludeEmployeeID string) ([]*LeaveRequest, error)
+	// ListApprovalRules returns the approval chain of the leave type, of every type when it is empty
+	ListApprovalRules(ctx context.Context, leaveType string) ([]*ApprovalRule, error)
+	// SetApprovalRules replaces the approval chain of the leave type
+	SetApprovalRules(ctx context.Context, leaveType string, rules []*ApprovalRule) error
+	// ActiveDelegates maps each of the delegators to the delegates acting for them on day
+	ActiveDelegates(ctx context.Context, delegatorIDs []string, day time.Time) (map[string][]string, error)
+	CreateDelegation(ctx context.Context, delegation *Delegation) error
+	GetDelegation(ctx context.Context, id string) (*Delegation, error)
+	// ListDelegations returns the delegations the employee gave or received, all of them when employeeID is empty
+	ListDelegations(ctx context.Context, req *ListDelegationsRequest) ([]*Delegation, error)
+	DeleteDelegation(ctx context.Context, id string) error
+	// ListTeamMembers returns the employees the calendar request selects
+	ListTeamMembers(ctx context.Context, req *TeamCalendarRequest) ([]*Employee, error)
+	// ListTeamAbsences returns the pending and approved leave of the employees within start and end
+	ListTeamAbsences(ctx context.Context, employeeIDs []string, start, end time.Time) ([]*LeaveRequest, error)
+	CreateCompOffCredit(ctx context.Context, credit *CompOffCredit) error
+	GetCompOffCredit(ctx context.Context, id string) (*CompOffCredit, error)
+	// FindCompOffCredit returns the pending or approved credit of the employee for the work date, nil when there is none
+	FindCompOffCredit(ctx context.Context, employeeID string, workDate time.Time) (*CompOffCredit, error)
+	ListCompOffCredits(ctx context.Context, req *ListCompOffCreditsRequest) (*ListCompOffCreditsResponse, error)
+	// ApproveCompOffCredit approves the credit and adds its days to the oldest open COMP_OFF
+	// balance from the work date's year on
+	ApproveCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*LeaveBalance, error)
+	RejectCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) error
+	// ExpireCompOffCredits lapses the unused days of the approved credits expiring by today
+	ExpireCompOffCredits(ctx context.Context, today time.Time) (int64, error)
+	// ListOpenBalances returns the balances of year the year-end close has not carried forward yet
+	ListOpenBalances(ctx context.Context, year int) ([]*LeaveBalance, error)
+	// CloseBalance carries the unused days the policy allows into next year's balance and closes the balance
+	CloseBalance(ctx context.Context, id string, policy *LeavePolicy) (*CarryForward, error)
+	// ExpireCarriedDays lapses the unused carried days of the balances whose carry expires by today
+	ExpireCarriedDays(ctx context.Context, today time.Time) (int64, error)
+	// Encash takes days off the annual leave balance and records them as a payroll line item
+	Encash(ctx context.Context, req *EncashLeaveRequest, dailyRate float64) (*LeaveBalance, *payroll.LineItem, error)
 }
 
 type repository struct {
@@ -30,7 +104,7 @@ func NewRepository(db *gorm.DB) Repository {
 
 func (r *repository) Create(ctx context.Context, leave *LeaveRequest) error {
 	if err := r.db.WithContext(ctx).Create(leave).Error; err != nil {
-		return fmt.Errorf("failed to create employee: %w", err)
+		return fmt.Errorf("failed to create leave request: %w", err)
 	}
 	return nil
 }
@@ -40,12 +114,13 @@ func (r *repository) GetByID(ctx context.Context, id string) (*LeaveRequest, err
 	err := r.db.WithContext(ctx).
 		Preload("Approver").
 		Preload("Employee").
+		Preload("ApprovalSteps", orderedSteps).
 		Where("id=?", id).
 		First(&leaveRequest).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("leave by id (%s) not found", id)
+			return nil, fmt.Errorf("leave by id (%s): %w", id, ErrLeaveNotFound)
 		}
 		return nil, fmt.Errorf("failed to get leave by ID %s: %w", id, err)
 	}
@@ -57,12 +132,13 @@ func (r *repository) GetByEmployeeID(ctx context.Context, employeeID string) (*L
 	err := r.db.WithContext(ctx).
 		Preload("Approver").
 		Preload("Employee").
+		Preload("ApprovalSteps", orderedSteps).
 		Where("employee_id=?", employeeID).
 		First(&leaveRequest).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("leave by employee ID (%s) not found", employeeID)
+			return nil, fmt.Errorf("leave by employee ID (%s): %w", employeeID, ErrLeaveNotFound)
 		}
 		return nil, fmt.Errorf("fialed to get leave by employee ID %s : %w", employeeID, err)
 	}
@@ -70,8 +146,12 @@ func (r *repository) GetByEmployeeID(ctx context.Context, employeeID string) (*L
 }
 
 func (r *repository) Delete(ctx context.Context, id string) error {
-	if err := r.db.WithContext(ctx).Where("id = ? AND leave_status = 'PENDING'", id).Delete(&LeaveRequest{}).Error; err != nil {
-		return fmt.Errorf("failed to delete leave request with id %s : %w", id, err)
+	result := r.db.WithContext(ctx).Where("id = ? AND status = 'PENDING'", id).Delete(&LeaveRequest{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete leave request with id %s : %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return r.pendingError(ctx, id)
 	}
 	return nil
 }
@@ -80,7 +160,7 @@ func (r *repository) List(ctx context.Context, req *ListLeaveRequestsRequest) (*
 	var leavesBalance []*LeaveRequest
 	var totalCount int64
 
-	query := r.db.WithContext(ctx).Model(&LeaveRequest{}).Preload("Approver").Preload("Employee")
+	query := r.db.WithContext(ctx).Model(&LeaveRequest{}).Preload("Approver").Preload("Employee").Preload("ApprovalSteps", orderedSteps)
 
 	if req.EmployeeID != "" {
 		query = query.Where("employee_id = ?", req.EmployeeID)
@@ -89,7 +169,7 @@ func (r *repository) List(ctx context.Context, req *ListLeaveRequestsRequest) (*
 		query = query.Where("leave_type = ?", req.LeaveType)
 	}
 	if req.Status != "" {
-		query = query.Where("leave_status = ?", req.Status)
+		query = query.Where("status = ?", req.Status)
 	}
 
 	if err := query.Count(&totalCount).Error; err != nil {
@@ -109,67 +189,89 @@ func (r *repository) List(ctx context.Context, req *ListLeaveRequestsRequest) (*
 	}, nil
 }
 
-func (r *repository) Update(ctx context.Context, id string, req *UpdateLeaveRequestRequest) error {
-	updateData := map[string]any{}
-
-	if req.LeaveType != "" {
-		updateData["leave_type"] = req.LeaveType
-	}
-	if !req.StartDate.IsZero() {
-		updateData["start_date"] = req.StartDate
-	}
-	if !req.EndDate.IsZero() {
-		updateData["end_date"] = req.EndDate
-	}
-	if req.Reason != "" {
-		updateData["reason"] = req.Reason
-	}
-
-	if len(updateData) == 0 {
-		return fmt.Errorf("no fields to update")
-	}
-
-	if err := r.db.WithContext(ctx).
-		Model(&LeaveRequest{}).
-		Where("id = ?", id).
-		Updates(updateData).Error; err != nil {
-		return fmt.Errorf("failed to update leave request: %w", err)
-	}
+func (r *repository) Update(ctx context.Context, leave *LeaveRequest) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(leave).
+			Where("status = 'PENDING'").
+			Select("leave_type", "start_date", "end_date", "days_requested", "duration", "hours", "reason", "excluded_dates", "current_step").
+			Updates(leave)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update leave request: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return r.pendingError(ctx, leave.ID)
+		}
 
-	return nil
+		// A changed request goes through its approval chain again
+		if err := tx.Where("leave_id = ?", leave.ID).Delete(&ApprovalStep{}).Error; err != nil {
+			return fmt.Errorf("failed to reset approval steps: %w", err)
+		}
+		for _, step := range leave.ApprovalSteps {
+			step.LeaveID = leave.ID
+		}
+		if len(leave.ApprovalSteps) > 0 {
+			if err := tx.Create(&leave.ApprovalSteps).Error; err != nil {
+				return fmt.Errorf("failed to create approval steps: %w", err)
+			}
+		}
+		return nil
+	})
 }
 
 func (r *repository) ApproveLeave(ctx context.Context, id string, req *ApproveLeaveRequestRequest) error {
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var leave LeaveRequest
-		if err := tx.First(&leave, "id = ?", id).Error; err != nil {
-			return fmt.Errorf("leave request not found: %w", err)
+		leave, err := lockPending(tx, id)
+		if err != nil {
+			return err
 		}
 
-		if leave.LeaveStatus != "PENDING" {
-			return fmt.Errorf("cannot approve leave with status: %s", leave.LeaveStatus)
+		now := time.Now()
+		step, err := decideStep(tx, leave, req.Step, StepApproved, req.ApproverID, req.OnBehalfOf, req.Comments, now)
+		if err != nil {
+			return err
 		}
 
-		now := time.Now()
-		if err := tx.Model(&leave).Updates(map[string]any{
-			"leave_status": "APPROVED",
+		// Hand the request to the next step, only the last one approves it
+		var next ApprovalStep
+		err = tx.Where("leave_id = ? AND step_order > ? AND status = 'PENDING'", leave.ID, step.StepOrder).
+			Order("step_order").
+			First(&next).Error
+		if err == nil {
+			if err := tx.Model(leave).Update("current_step", next.StepOrder).Error; err != nil {
+				return fmt.Errorf("failed to advance approval step: %w", err)
+			}
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get next approval step: %w", err)
+		}
+
+		if err := tx.Model(leave).Updates(map[string]any{
+			"status":       "APPROVED",
 			"approver_id":  req.ApproverID,
 			"comments":     req.Comments,
 			"approved_at":  &now,
+			"current_step": 0,
 		}).Error; err != nil {
 			return fmt.Errorf("failed to approve leave: %w", err)
 		}
 
 		// Update LeaveBalance
-		var balance LeaveBalance
-		if err := tx.Where("employee_id = ? AND leave_type = ? AND year = ?", leave.EmployeeID, leave.LeaveType, leave.StartDate.Year()).First(&balance).Error; err != nil {
-			return fmt.Errorf("failed to fetch leave balance: %w", err)
+		balance, err := lockBalance(tx, leave.EmployeeID, leave.LeaveType, leave.StartDate.Year())
+		if err != nil {
+			return err
 		}
 
-		balance.UsedDays += leave.DaysRequested
-		balance.TotalDays = max(balance.TotalDays, balance.UsedDays)
+		// The unused days of a closed year were already carried forward
+		if balance.ClosedAt != nil {
+			return ErrBalanceClosed
+		}
+		if !balance.HasSufficientBalance(leave.DaysRequested) {
+			return ErrInsufficientBalance
+		}
+		balance.UsedDays = RoundDays(balance.UsedDays + leave.DaysRequested)
 
-		if err := tx.Save(&balance).Error; err != nil {
+		if err := tx.Save(balance).Error; err != nil {
 			return fmt.Errorf("failed to update leave balance: %w", err)
 		}
 
@@ -181,21 +283,29 @@ func (r *repository) ApproveLeave(ctx context.Context, id string, req *ApproveLe
 
 func (r *repository) RejectLeave(ctx context.Context, id string, req *RejectLeaveRequestRequest) error {
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var leave LeaveRequest
-		if err := tx.First(&leave, "id = ?", id).Error; err != nil {
-			return fmt.Errorf("leave request not found: %w", err)
+		leave, err := lockPending(tx, id)
+		if err != nil {
+			return err
 		}
 
-		if leave.LeaveStatus != "PENDING" {
-			return fmt.Errorf("cannot reject leave with status: %s", leave.LeaveStatus)
+		now := time.Now()
+		step, err := decideStep(tx, leave, req.Step, StepRejected, req.ApproverID, req.OnBehalfOf, req.Comments, now)
+		if err != nil {
+			return err
 		}
 
-		now := time.Now()
-		if err := tx.Model(&leave).Updates(map[string]any{
-			"leave_status": "REJECTED",
+		if err := tx.Model(&ApprovalStep{}).
+			Where("leave_id = ? AND step_order > ? AND status = 'PENDING'", leave.ID, step.StepOrder).
+			Update("status", StepSkipped).Error; err != nil {
+			return fmt.Errorf("failed to skip remaining approval steps: %w", err)
+		}
+
+		if err := tx.Model(leave).Updates(map[string]any{
+			"status":       "REJECTED",
 			"approver_id":  req.ApproverID,
 			"comments":     req.Comments,
 			"approved_at":  &now,
+			"current_step": 0,
 		}).Error; err != nil {
 			return fmt.Errorf("failed to reject leave: %w", err)
 		}
@@ -206,6 +316,87 @@ func (r *repository) RejectLeave(ctx context.Context, id string, req *RejectLeav
 	return err
 }
 
+func (r *repository) CancelLeave(ctx context.Context, id string, req *CancelLeaveRequestRequest) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		leave, err := lockLeave(tx, id)
+		if err != nil {
+			return err
+		}
+
+		switch leave.LeaveStatus {
+		case "APPROVED":
+			if err := restoreBalance(tx, leave, leave.DaysRequested); err != nil {
+				return err
+			}
+		case "PENDING":
+			if err := tx.Model(&ApprovalStep{}).
+				Where("leave_id = ? AND status = 'PENDING'", leave.ID).
+				Update("status", StepSkipped).Error; err != nil {
+				return fmt.Errorf("failed to skip remaining approval steps: %w", err)
+			}
+		default:
+			return fmt.Errorf("cannot cancel leave with status %s: %w", leave.LeaveStatus, ErrLeaveNotCancellable)
+		}
+
+		if err := tx.Model(leave).Updates(map[string]any{
+			"status":              "CANCELLED",
+			"cancelled_by":        req.CancelledBy,
+			"cancelled_at":        time.Now(),
+			"cancellation_reason": req.Reason,
+			"current_step":        0,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to cancel leave: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *repository) WithdrawLeave(ctx context.Context, withdrawn *LeaveRequest, req *WithdrawLeaveRequestRequest) (float64, error) {
+	var restored float64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		leave, err := lockLeave(tx, withdrawn.ID)
+		if err != nil {
+			return err
+		}
+		if leave.LeaveStatus != "APPROVED" {
+			return fmt.Errorf("cannot withdraw leave with status %s: %w", leave.LeaveStatus, ErrLeaveNotApproved)
+		}
+		// The days were counted against the end date read before the lock
+		if !withdrawn.EndDate.Before(leave.EndDate) || withdrawn.DaysRequested >= leave.DaysRequested {
+			return ErrLeaveChanged
+		}
+
+		restored = RoundDays(leave.DaysRequested - withdrawn.DaysRequested)
+		if err := restoreBalance(tx, leave, restored); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if leave.OriginalEndDate == nil {
+			leave.OriginalEndDate = &leave.EndDate
+		}
+		leave.EndDate = withdrawn.EndDate
+		leave.DaysRequested = withdrawn.DaysRequested
+		leave.ExcludedDates = withdrawn.ExcludedDates
+		leave.WithdrawnDays = RoundDays(leave.WithdrawnDays + restored)
+		leave.WithdrawnBy = &req.WithdrawnBy
+		leave.WithdrawnAt = &now
+		leave.WithdrawalReason = req.Reason
+
+		if err := tx.Model(leave).
+			Select("end_date", "days_requested", "excluded_dates", "original_end_date", "withdrawn_days", "withdrawn_by", "withdrawn_at", "withdrawal_reason").
+			Updates(leave).Error; err != nil {
+			return fmt.Errorf("failed to withdraw leave: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return restored, nil
+}
+
 func (r *repository) LeaveBalance(ctx context.Context, req *GetEmployeeLeaveBalanceRequest) (*GetEmployeeLeaveBalanceResponse, error) {
 	query := r.db.WithContext(ctx).Model(&LeaveBalance{}).Where("employee_id = ?", req.EmployeeID)
 
@@ -224,3 +415,755 @@ func (r *repository) LeaveBalance(ctx context.Context, req *GetEmployeeLeaveBala
 
 	return &GetEmployeeLeaveBalanceResponse{LeaveBalances: balances}, nil
 }
+
+func (r *repository) GetEmployee(ctx context.Context, id string) (*Employee, error) {
+	var employee Employee
+	err := r.db.WithContext(ctx).
+		Table("employees").
+		Select("employees.*, COALESCE(departments.location, '') AS location, departments.manager_id AS department_manager_id").
+		Joins("LEFT JOIN departments ON departments.id = employees.department_id").
+		Where("employees.id = ? AND employees.deleted_at IS NULL", id).
+		Take(&employee).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEmployeeNotFound
+		}
+		return nil, fmt.Errorf("failed to get employee %s: %w", id, err)
+	}
+	return &employee, nil
+}
+
+func (r *repository) ListTeamMembers(ctx context.Context, req *TeamCalendarRequest) ([]*Employee, error) {
+	query := r.db.WithContext(ctx).
+		Table("employees").
+		Select("employees.*, COALESCE(departments.location, '') AS location, departments.manager_id AS department_manager_id").
+		Joins("LEFT JOIN departments ON departments.id = employees.department_id").
+		Where("employees.deleted_at IS NULL")
+
+	switch {
+	case req.EmployeeID != "":
+		query = query.Where("employees.id = ?", req.EmployeeID)
+	case req.DepartmentID != "":
+		query = query.Where("employees.department_id = ?", req.DepartmentID)
+	case req.ManagerID != "":
+		// Same reporting line as employee.Repository.IsManagedBy
+		query = query.Where("employees.manager_id = ? OR departments.manager_id = ?", req.ManagerID, req.ManagerID)
+	}
+
+	var employees []*Employee
+	if err := query.Order("employees.first_name, employees.last_name").Find(&employees).Error; err != nil {
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+	return employees, nil
+}
+
+func (r *repository) ListTeamAbsences(ctx context.Context, employeeIDs []string, start, end time.Time) ([]*LeaveRequest, error) {
+	var absences []*LeaveRequest
+	if len(employeeIDs) == 0 {
+		return absences, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Preload("Employee").
+		Where("employee_id IN ? AND status IN ('PENDING','APPROVED')", employeeIDs).
+		Where("start_date <= ? AND end_date >= ?", end, start).
+		Order("start_date, end_date").
+		Find(&absences).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team absences: %w", err)
+	}
+	return absences, nil
+}
+
+func (r *repository) ListPolicies(ctx context.Context) ([]*LeavePolicy, error) {
+	var policies []*LeavePolicy
+	if err := r.db.WithContext(ctx).Order("leave_type").Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to list leave policies: %w", err)
+	}
+	return policies, nil
+}
+
+func (r *repository) SavePolicy(ctx context.Context, policy *LeavePolicy) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "leave_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"accrual_method", "days_per_year", "probation_months", "active", "carry_forward_max_days", "carry_forward_expiry_months"}),
+	}).Create(policy).Error; err != nil {
+		return fmt.Errorf("failed to save leave policy %s: %w", policy.LeaveType, err)
+	}
+	return nil
+}
+
+func (r *repository) ListAccrualEmployees(ctx context.Context, employeeID string) ([]*Employee, error) {
+	query := r.db.WithContext(ctx).
+		Where("status <> 'TERMINATED' AND deleted_at IS NULL")
+	if employeeID != "" {
+		query = query.Where("id = ?", employeeID)
+	}
+
+	var employees []*Employee
+	if err := query.Order("hire_date").Find(&employees).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employees for accrual: %w", err)
+	}
+	return employees, nil
+}
+
+func (r *repository) GetBalance(ctx context.Context, employeeID, leaveType string, year int) (*LeaveBalance, error) {
+	var balance LeaveBalance
+	err := r.db.WithContext(ctx).
+		Where("employee_id = ? AND leave_type = ? AND year = ?", employeeID, leaveType, year).
+		First(&balance).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBalanceNotFound
+		}
+		return nil, fmt.Errorf("failed to get leave balance: %w", err)
+	}
+	return &balance, nil
+}
+
+func (r *repository) ApplyAccrual(ctx context.Context, employeeID, leaveType string, year int, entitledDays float64) (*Accrual, error) {
+	accrual := &Accrual{
+		EmployeeID:   employeeID,
+		LeaveType:    leaveType,
+		Year:         year,
+		EntitledDays: entitledDays,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&LeaveBalance{
+			EmployeeID: employeeID,
+			LeaveType:  leaveType,
+			Year:       year,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to create leave balance: %w", err)
+		}
+
+		var balance LeaveBalance
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("employee_id = ? AND leave_type = ? AND year = ?", employeeID, leaveType, year).
+			First(&balance).Error; err != nil {
+			return fmt.Errorf("failed to lock leave balance: %w", err)
+		}
+		// The year end job already carried the unused days of a closed year forward
+		if balance.ClosedAt != nil {
+			return ErrBalanceClosed
+		}
+
+		accrual.PreviouslyAccruedDays = balance.AccruedDays
+		accrual.CreditedDays = creditFor(balance.AccruedDays, entitledDays)
+		if accrual.CreditedDays == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&balance).Updates(map[string]any{
+			"total_days":   RoundDays(balance.TotalDays + accrual.CreditedDays),
+			"accrued_days": entitledDays,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to credit leave balance: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accrual, nil
+}
+
+func (r *repository) ListApprovalRules(ctx context.Context, leaveType string) ([]*ApprovalRule, error) {
+	query := r.db.WithContext(ctx)
+	if leaveType != "" {
+		query = query.Where("leave_type = ?", leaveType)
+	}
+
+	var rules []*ApprovalRule
+	if err := query.Order("leave_type, step_order").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("failed to list approval rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (r *repository) SetApprovalRules(ctx context.Context, leaveType string, rules []*ApprovalRule) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("leave_type = ?", leaveType).Delete(&ApprovalRule{}).Error; err != nil {
+			return fmt.Errorf("failed to delete approval rules of %s: %w", leaveType, err)
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		if err := tx.Create(&rules).Error; err != nil {
+			return fmt.Errorf("failed to save approval rules of %s: %w", leaveType, err)
+		}
+		return nil
+	})
+}
+
+func (r *repository) ActiveDelegates(ctx context.Context, delegatorIDs []string, day time.Time) (map[string][]string, error) {
+	delegates := make(map[string][]string, len(delegatorIDs))
+	if len(delegatorIDs) == 0 {
+		return delegates, nil
+	}
+
+	var delegations []*Delegation
+	if err := r.db.WithContext(ctx).
+		Where("delegator_id IN ? AND start_date <= ? AND end_date >= ?", delegatorIDs, day, day).
+		Order("created_at").
+		Find(&delegations).Error; err != nil {
+		return nil, fmt.Errorf("failed to get active delegations: %w", err)
+	}
+
+	for _, delegation := range delegations {
+		delegates[delegation.DelegatorID] = append(delegates[delegation.DelegatorID], delegation.DelegateID)
+	}
+	return delegates, nil
+}
+
+func (r *repository) CreateDelegation(ctx context.Context, delegation *Delegation) error {
+	if err := r.db.WithContext(ctx).Create(delegation).Error; err != nil {
+		return fmt.Errorf("failed to create approval delegation: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetDelegation(ctx context.Context, id string) (*Delegation, error) {
+	var delegation Delegation
+	if err := r.db.WithContext(ctx).First(&delegation, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDelegationNotFound
+		}
+		return nil, fmt.Errorf("failed to get approval delegation %s: %w", id, err)
+	}
+	return &delegation, nil
+}
+
+func (r *repository) ListDelegations(ctx context.Context, req *ListDelegationsRequest) ([]*Delegation, error) {
+	query := r.db.WithContext(ctx)
+	if req.EmployeeID != "" {
+		query = query.Where("delegator_id = ? OR delegate_id = ?", req.EmployeeID, req.EmployeeID)
+	}
+	if req.ActiveOnly {
+		query = query.Where("end_date >= CURRENT_DATE")
+	}
+
+	var delegations []*Delegation
+	if err := query.Order("start_date DESC").Find(&delegations).Error; err != nil {
+		return nil, fmt.Errorf("failed to list approval delegations: %w", err)
+	}
+	return delegations, nil
+}
+
+func (r *repository) DeleteDelegation(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&Delegation{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete approval delegation %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrDelegationNotFound
+	}
+	return nil
+}
+
+func (r *repository) FindOverlapping(ctx context.Context, employeeID string, start, end time.Time, excludeID string) ([]*LeaveRequest, error) {
+	query := r.db.WithContext(ctx).
+		Where("employee_id = ? AND status IN ('PENDING', 'APPROVED')", employeeID).
+		Where("start_date <= ? AND end_date >= ?", end, start)
+	if excludeID != "" {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	var leaves []*LeaveRequest
+	if err := query.Order("start_date").Find(&leaves).Error; err != nil {
+		return nil, fmt.Errorf("failed to find overlapping leave: %w", err)
+	}
+	return leaves, nil
+}
+
+func (r *repository) GetStaffingRule(ctx context.Context, departmentID string) (*StaffingRule, error) {
+	var rules []*StaffingRule
+	err := r.db.WithContext(ctx).
+		Table("departments").
+		Select("id AS department_id, name AS department_name, max_concurrent_absences, staffing_enforcement AS enforcement").
+		Where("id = ? AND deleted_at IS NULL", departmentID).
+		Limit(1).
+		Find(&rules).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staffing rule of department %s: %w", departmentID, err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return rules[0], nil
+}
+
+func (r *repository) ListDepartmentAbsences(ctx context.Context, departmentID string, start, end time.Time, excludeEmployeeID string) ([]*LeaveRequest, error) {
+	var leaves []*LeaveRequest
+	err := r.db.WithContext(ctx).
+		Joins("JOIN employees ON employees.id = leaves.employee_id AND employees.deleted_at IS NULL").
+		Where("employees.department_id = ? AND leaves.employee_id <> ?", departmentID, excludeEmployeeID).
+		Where("leaves.status = 'APPROVED' AND leaves.start_date <= ? AND leaves.end_date >= ?", end, start).
+		Find(&leaves).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list absences of department %s: %w", departmentID, err)
+	}
+	return leaves, nil
+}
+
+func (r *repository) CreateCompOffCredit(ctx context.Context, credit *CompOffCredit) error {
+	if err := r.db.WithContext(ctx).Create(credit).Error; err != nil {
+		return fmt.Errorf("failed to create comp-off credit: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetCompOffCredit(ctx context.Context, id string) (*CompOffCredit, error) {
+	var credit CompOffCredit
+	if err := r.db.WithContext(ctx).Preload("Employee").Where("id = ?", id).First(&credit).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("comp-off credit %s: %w", id, ErrCompOffNotFound)
+		}
+		return nil, fmt.Errorf("failed to get comp-off credit %s: %w", id, err)
+	}
+	return &credit, nil
+}
+
+func (r *repository) FindCompOffCredit(ctx context.Context, employeeID string, workDate time.Time) (*CompOffCredit, error) {
+	var credits []*CompOffCredit
+	err := r.db.WithContext(ctx).
+		Where("employee_id = ? AND work_date = ? AND status <> ?", employeeID, workDate, CreditRejected).
+		Limit(1).
+		Find(&credits).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comp-off credit: %w", err)
+	}
+	if len(credits) == 0 {
+		return nil, nil
+	}
+	return credits[0], nil
+}
+
+func (r *repository) ListCompOffCredits(ctx context.Context, req *ListCompOffCreditsRequest) (*ListCompOffCreditsResponse, error) {
+	var credits []*CompOffCredit
+	var totalCount int64
+
+	query := r.db.WithContext(ctx).Model(&CompOffCredit{}).Preload("Employee")
+	if req.EmployeeID != "" {
+		query = query.Where("employee_id = ?", req.EmployeeID)
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, fmt.Errorf("failed to count comp-off credits: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	if err := query.Offset(offset).Limit(req.PageSize).Order("work_date DESC").Find(&credits).Error; err != nil {
+		return nil, fmt.Errorf("failed to list comp-off credits: %w", err)
+	}
+
+	return &ListCompOffCreditsResponse{
+		Credits:    credits,
+		TotalCount: totalCount,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}, nil
+}
+
+func (r *repository) ApproveCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*LeaveBalance, error) {
+	var balance *LeaveBalance
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		credit, err := lockPendingCredit(tx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		balance, err = openCompOffBalance(tx, credit.EmployeeID, credit.WorkDate.Year(), now.Year())
+		if err != nil {
+			return err
+		}
+
+		balance.TotalDays = RoundDays(balance.TotalDays + credit.Days)
+		if err := tx.Model(balance).Update("total_days", balance.TotalDays).Error; err != nil {
+			return fmt.Errorf("failed to credit leave balance: %w", err)
+		}
+
+		if err := tx.Model(credit).Updates(map[string]any{
+			"status":      CreditApproved,
+			"approver_id": req.ApproverID,
+			"comments":    req.Comments,
+			"decided_at":  now,
+			"balance_id":  balance.ID,
+			"expires_on":  req.ExpiresOn,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to approve comp-off credit: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balance.RemainingDays = balance.GetRemainingDays()
+	return balance, nil
+}
+
+func (r *repository) RejectCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		credit, err := lockPendingCredit(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(credit).Updates(map[string]any{
+			"status":      CreditRejected,
+			"approver_id": req.ApproverID,
+			"comments":    req.Comments,
+			"decided_at":  time.Now(),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to reject comp-off credit: %w", err)
+		}
+		return nil
+	})
+}
+
+// ExpireCompOffCredits lapses credits one by one in the order they expire. Leave
+// taken uses carried days first and then the credits expiring first, so the
+// remaining days of a balance belong to the credits expiring last and a credit
+// lapses with what is left once the credits expiring after it are covered.
+// Credits of closed balances were carried forward and lapse with the carried days.
+func (r *repository) ExpireCompOffCredits(ctx context.Context, today time.Time) (int64, error) {
+	var credits []*CompOffCredit
+	err := r.db.WithContext(ctx).
+		Joins("JOIN leave_balances ON leave_balances.id = comp_off_credits.balance_id").
+		Where("comp_off_credits.status = ? AND comp_off_credits.expired_at IS NULL", CreditApproved).
+		Where("comp_off_credits.expires_on <= ? AND leave_balances.closed_at IS NULL", today).
+		Order("comp_off_credits.expires_on, comp_off_credits.id").
+		Find(&credits).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expiring comp-off credits: %w", err)
+	}
+
+	var expired int64
+	for _, credit := range credits {
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var balance LeaveBalance
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&balance, "id = ?", *credit.BalanceID).Error; err != nil {
+				return fmt.Errorf("failed to fetch leave balance: %w", err)
+			}
+
+			var later float64
+			if err := tx.Model(&CompOffCredit{}).
+				Where("balance_id = ? AND status = ? AND expired_at IS NULL AND id <> ?", balance.ID, CreditApproved, credit.ID).
+				Where("expires_on > ? OR (expires_on = ? AND id > ?)", credit.ExpiresOn, credit.ExpiresOn, credit.ID).
+				Select("COALESCE(SUM(days), 0)").
+				Scan(&later).Error; err != nil {
+				return fmt.Errorf("failed to sum later comp-off credits: %w", err)
+			}
+
+			unused := RoundDays(math.Min(math.Max(balance.GetRemainingDays()-later, 0), credit.Days))
+			if unused > 0 {
+				if err := tx.Model(&balance).Update("expired_days", RoundDays(balance.ExpiredDays+unused)).Error; err != nil {
+					return fmt.Errorf("failed to expire comp-off days: %w", err)
+				}
+			}
+
+			return tx.Model(credit).Updates(map[string]any{
+				"expired_days": unused,
+				"expired_at":   time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return expired, fmt.Errorf("failed to expire comp-off credit %s: %w", credit.ID, err)
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+func (r *repository) ListOpenBalances(ctx context.Context, year int) ([]*LeaveBalance, error) {
+	var balances []*LeaveBalance
+	if err := r.db.WithContext(ctx).
+		Where("year = ? AND closed_at IS NULL", year).
+		Order("employee_id, leave_type").
+		Find(&balances).Error; err != nil {
+		return nil, fmt.Errorf("failed to list open leave balances: %w", err)
+	}
+	return balances, nil
+}
+
+func (r *repository) CloseBalance(ctx context.Context, id string, policy *LeavePolicy) (*CarryForward, error) {
+	var carryForward *CarryForward
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var balance LeaveBalance
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&balance, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrBalanceNotFound
+			}
+			return fmt.Errorf("failed to lock leave balance: %w", err)
+		}
+		if balance.ClosedAt != nil {
+			return ErrBalanceClosed
+		}
+
+		carryForward = policy.CarryForward(&balance)
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&LeaveBalance{
+			EmployeeID: balance.EmployeeID,
+			LeaveType:  balance.LeaveType,
+			Year:       balance.Year + 1,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to create next year's leave balance: %w", err)
+		}
+
+		var next LeaveBalance
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("employee_id = ? AND leave_type = ? AND year = ?", balance.EmployeeID, balance.LeaveType, balance.Year+1).
+			First(&next).Error; err != nil {
+			return fmt.Errorf("failed to lock next year's leave balance: %w", err)
+		}
+
+		if err := tx.Model(&next).Updates(map[string]any{
+			"total_days":       RoundDays(next.TotalDays + carryForward.CarriedDays),
+			"carried_days":     carryForward.CarriedDays,
+			"carry_expires_on": carryForward.ExpiresOn,
+			"carry_expired_at": nil,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to carry leave forward: %w", err)
+		}
+
+		if err := tx.Model(&balance).Update("closed_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to close leave balance: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return carryForward, nil
+}
+
+func (r *repository) ExpireCarriedDays(ctx context.Context, today time.Time) (int64, error) {
+	// Leave taken uses carried days first, so only those beyond the used days lapse
+	result := r.db.WithContext(ctx).
+		Model(&LeaveBalance{}).
+		Where("carry_expires_on <= ? AND carry_expired_at IS NULL", today).
+		Updates(map[string]any{
+			"expired_days":     gorm.Expr("expired_days + GREATEST(0, LEAST(carried_days - used_days, total_days - used_days - expired_days - encashed_days))"),
+			"carry_expired_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to expire carried leave: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *repository) Encash(ctx context.Context, req *EncashLeaveRequest, dailyRate float64) (*LeaveBalance, *payroll.LineItem, error) {
+	var balance LeaveBalance
+	var item *payroll.LineItem
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("employee_id = ? AND leave_type = 'ANNUAL' AND year = ?", req.EmployeeID, req.Year).
+			First(&balance).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrBalanceNotFound
+			}
+			return fmt.Errorf("failed to fetch leave balance: %w", err)
+		}
+
+		if balance.ClosedAt != nil {
+			return ErrBalanceClosed
+		}
+		if !balance.HasSufficientBalance(req.Days) {
+			return ErrInsufficientBalance
+		}
+
+		balance.EncashedDays = RoundDays(balance.EncashedDays + req.Days)
+		if err := tx.Model(&balance).Update("encashed_days", balance.EncashedDays).Error; err != nil {
+			return fmt.Errorf("failed to encash leave balance: %w", err)
+		}
+
+		item = &payroll.LineItem{
+			EmployeeID:  req.EmployeeID,
+			ItemType:    payroll.ItemLeaveEncashment,
+			Description: fmt.Sprintf("Encashment of %g annual leave days of %d", req.Days, req.Year),
+			Quantity:    req.Days,
+			Rate:        dailyRate,
+			Amount:      math.Round(req.Days*dailyRate*100) / 100,
+			ReferenceID: &balance.ID,
+		}
+		if req.RequestedBy != "" {
+			item.CreatedBy = &req.RequestedBy
+		}
+		if err := tx.Create(item).Error; err != nil {
+			return fmt.Errorf("failed to create payroll line item: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balance.RemainingDays = balance.GetRemainingDays()
+	return &balance, item, nil
+}
+
+// lockLeave loads the leave request for update
+func lockLeave(tx *gorm.DB, id string) (*LeaveRequest, error) {
+	var leave LeaveRequest
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&leave, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLeaveNotFound
+		}
+		return nil, fmt.Errorf("failed to get leave request %s: %w", id, err)
+	}
+	return &leave, nil
+}
+
+// lockPending loads the leave request for update and makes sure it still awaits a decision
+func lockPending(tx *gorm.DB, id string) (*LeaveRequest, error) {
+	leave, err := lockLeave(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if leave.LeaveStatus != "PENDING" {
+		return nil, fmt.Errorf("cannot change leave with status %s: %w", leave.LeaveStatus, ErrLeaveNotPending)
+	}
+	return leave, nil
+}
+
+// lockPendingCredit loads the comp-off credit for update and makes sure it still awaits a decision
+func lockPendingCredit(tx *gorm.DB, id string) (*CompOffCredit, error) {
+	var credit CompOffCredit
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&credit, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCompOffNotFound
+		}
+		return nil, fmt.Errorf("failed to get comp-off credit %s: %w", id, err)
+	}
+
+	if credit.Status != CreditPending {
+		return nil, fmt.Errorf("cannot decide on comp-off credit with status %s: %w", credit.Status, ErrCompOffNotPending)
+	}
+	return &credit, nil
+}
+
+// restoreBalance gives days of approved leave back to the balance they were taken from
+func restoreBalance(tx *gorm.DB, leave *LeaveRequest, days float64) error {
+	balance, err := lockBalance(tx, leave.EmployeeID, leave.LeaveType, leave.StartDate.Year())
+	if err != nil {
+		return err
+	}
+
+	// Days given back after the year was carried forward would be lost
+	if balance.ClosedAt != nil {
+		return ErrBalanceClosed
+	}
+
+	usedDays := RoundDays(math.Max(balance.UsedDays-days, 0))
+	if err := tx.Model(balance).Update("used_days", usedDays).Error; err != nil {
+		return fmt.Errorf("failed to restore leave balance: %w", err)
+	}
+	return nil
+}
+
+// openCompOffBalance locks the oldest COMP_OFF balance of the employee from the
+// year of the work date up to the current year that is not closed yet. Credits for
+// work late in a year that was closed before they were approved go to the current
+// year, whose balance is created when missing.
+func openCompOffBalance(tx *gorm.DB, employeeID string, workYear, currentYear int) (*LeaveBalance, error) {
+	for year := workYear; year < currentYear; year++ {
+		balance, err := lockBalance(tx, employeeID, LeaveTypeCompOff, year)
+		if errors.Is(err, ErrBalanceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if balance.ClosedAt == nil {
+			return balance, nil
+		}
+	}
+
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&LeaveBalance{
+		EmployeeID: employeeID,
+		LeaveType:  LeaveTypeCompOff,
+		Year:       currentYear,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to create leave balance: %w", err)
+	}
+
+	balance, err := lockBalance(tx, employeeID, LeaveTypeCompOff, currentYear)
+	if err != nil {
+		return nil, err
+	}
+	if balance.ClosedAt != nil {
+		return nil, ErrBalanceClosed
+	}
+	return balance, nil
+}
+
+// lockBalance loads the leave balance of the employee for update
+func lockBalance(tx *gorm.DB, employeeID, leaveType string, year int) (*LeaveBalance, error) {
+	var balance LeaveBalance
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("employee_id = ? AND leave_type = ? AND year = ?", employeeID, leaveType, year).
+		First(&balance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBalanceNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch leave balance: %w", err)
+	}
+	return &balance, nil
+}
+
+// decideStep records the decision on the current approval step of the leave, which
+// must still be the step the decision was authorized for
+func decideStep(tx *gorm.DB, leave *LeaveRequest, stepOrder int, decision, deciderID string, onBehalfOf *string, comments string, now time.Time) (*ApprovalStep, error) {
+	if leave.CurrentStep != stepOrder {
+		return nil, ErrStepChanged
+	}
+
+	var step ApprovalStep
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("leave_id = ? AND step_order = ? AND status = 'PENDING'", leave.ID, stepOrder).
+		First(&step).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStepChanged
+		}
+		return nil, fmt.Errorf("failed to get approval step: %w", err)
+	}
+
+	if err := tx.Model(&step).Updates(map[string]any{
+		"status":       decision,
+		"decided_by":   deciderID,
+		"on_behalf_of": onBehalfOf,
+		"comments":     comments,
+		"decided_at":   &now,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to record approval decision: %w", err)
+	}
+	return &step, nil
+}
+
+func orderedSteps(db *gorm.DB) *gorm.DB {
+	return db.Order("step_order")
+}
+
+// pendingError explains why a statement restricted to pending requests matched no row
+func (r *repository) pendingError(ctx context.Context, id string) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&LeaveRequest{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check leave request %s: %w", id, err)
+	}
+	if count == 0 {
+		return ErrLeaveNotFound
+	}
+	return ErrLeaveNotPending
+}
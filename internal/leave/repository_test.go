@@ -0,0 +1,549 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/database/dbtest"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"gorm.io/gorm"
+)
+
+func createTestEmployee(t *testing.T, db *gorm.DB) *employee.Employee {
+	t.Helper()
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	emp := &employee.Employee{
+		EmployeeID: "EMP-" + suffix,
+		FirstName:  "Test",
+		LastName:   "Employee",
+		Email:      suffix + "@example.com",
+		HireDate:   date(2020, time.January, 6),
+		Status:     "ACTIVE",
+		Role:       "EMPLOYEE",
+	}
+	if err := employee.NewRepository(db).Create(context.Background(), emp); err != nil {
+		t.Fatalf("failed to create employee: %v", err)
+	}
+	return emp
+}
+
+func createClosedBalance(t *testing.T, db *gorm.DB, employeeID string, year int) *LeaveBalance {
+	t.Helper()
+
+	closedAt := time.Now()
+	balance := &LeaveBalance{
+		EmployeeID:  employeeID,
+		LeaveType:   "ANNUAL",
+		Year:        year,
+		TotalDays:   10,
+		AccruedDays: 10,
+		ClosedAt:    &closedAt,
+	}
+	if err := db.Create(balance).Error; err != nil {
+		t.Fatalf("failed to create leave balance: %v", err)
+	}
+	return balance
+}
+
+func createBalance(t *testing.T, db *gorm.DB, employeeID string, year int, usedDays float64) *LeaveBalance {
+	t.Helper()
+
+	balance := &LeaveBalance{
+		EmployeeID:  employeeID,
+		LeaveType:   "ANNUAL",
+		Year:        year,
+		TotalDays:   20,
+		AccruedDays: 20,
+		UsedDays:    usedDays,
+	}
+	if err := db.Create(balance).Error; err != nil {
+		t.Fatalf("failed to create leave balance: %v", err)
+	}
+	return balance
+}
+
+// createApprovedLeave creates leave of the employee approved for the working days Mar 2 to Mar 6 2026
+func createApprovedLeave(t *testing.T, repo Repository, employeeID string) *LeaveRequest {
+	t.Helper()
+
+	leave := &LeaveRequest{
+		EmployeeID:    employeeID,
+		LeaveType:     "ANNUAL",
+		StartDate:     date(2026, time.March, 2),
+		EndDate:       date(2026, time.March, 6),
+		DaysRequested: 5,
+		Duration:      DurationFullDay,
+		LeaveStatus:   "APPROVED",
+	}
+	if err := repo.Create(context.Background(), leave); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return leave
+}
+
+func TestApproveLeaveClosedYearIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	approver := createTestEmployee(t, db)
+	balance := createClosedBalance(t, db, emp.ID, 2024)
+
+	leave := &LeaveRequest{
+		EmployeeID:    emp.ID,
+		LeaveType:     "ANNUAL",
+		StartDate:     date(2024, time.June, 3),
+		EndDate:       date(2024, time.June, 3),
+		DaysRequested: 1,
+		Duration:      DurationFullDay,
+		LeaveStatus:   "PENDING",
+		CurrentStep:   1,
+		ApprovalSteps: []*ApprovalStep{{StepOrder: 1, ApproverType: ApproverHR, Status: StepPending}},
+	}
+	if err := repo.Create(ctx, leave); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	err := repo.ApproveLeave(ctx, leave.ID, &ApproveLeaveRequestRequest{ApproverID: approver.ID, Step: 1})
+	if !errors.Is(err, ErrBalanceClosed) {
+		t.Fatalf("ApproveLeave() error = %v, want %v", err, ErrBalanceClosed)
+	}
+
+	stored, err := repo.GetByID(ctx, leave.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if stored.LeaveStatus != "PENDING" {
+		t.Errorf("status = %s after the refused approval, want PENDING", stored.LeaveStatus)
+	}
+
+	after, err := repo.GetBalance(ctx, emp.ID, "ANNUAL", 2024)
+	if err != nil {
+		t.Fatalf("GetBalance() error = %v", err)
+	}
+	if after.UsedDays != balance.UsedDays {
+		t.Errorf("UsedDays = %v, want %v", after.UsedDays, balance.UsedDays)
+	}
+}
+
+func TestApproveLeaveStepsIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	manager := createTestEmployee(t, db)
+	head := createTestEmployee(t, db)
+	deputy := createTestEmployee(t, db)
+	hr := createTestEmployee(t, db)
+
+	balance := &LeaveBalance{EmployeeID: emp.ID, LeaveType: "ANNUAL", Year: 2026, TotalDays: 20, AccruedDays: 20}
+	if err := db.Create(balance).Error; err != nil {
+		t.Fatalf("failed to create leave balance: %v", err)
+	}
+
+	leave := &LeaveRequest{
+		EmployeeID:    emp.ID,
+		LeaveType:     "ANNUAL",
+		StartDate:     date(2026, time.March, 2),
+		EndDate:       date(2026, time.March, 17),
+		DaysRequested: 12,
+		Duration:      DurationFullDay,
+		LeaveStatus:   "PENDING",
+		CurrentStep:   1,
+		ApprovalSteps: []*ApprovalStep{
+			{StepOrder: 1, ApproverType: ApproverManager, ApproverID: &manager.ID, Status: StepPending},
+			{StepOrder: 2, ApproverType: ApproverDepartmentManager, ApproverID: &head.ID, Status: StepPending},
+			{StepOrder: 3, ApproverType: ApproverHR, Status: StepPending},
+		},
+	}
+	if err := repo.Create(ctx, leave); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// A decision on a step that is not pending yet is refused
+	err := repo.ApproveLeave(ctx, leave.ID, &ApproveLeaveRequestRequest{ApproverID: head.ID, Step: 2})
+	if !errors.Is(err, ErrStepChanged) {
+		t.Fatalf("ApproveLeave() of step 2 error = %v, want %v", err, ErrStepChanged)
+	}
+
+	decisions := []struct {
+		req      *ApproveLeaveRequestRequest
+		wantStep int
+		want     string
+	}{
+		{req: &ApproveLeaveRequestRequest{ApproverID: manager.ID, Step: 1, Comments: "fine by me"}, wantStep: 2, want: "PENDING"},
+		{req: &ApproveLeaveRequestRequest{ApproverID: deputy.ID, Step: 2, OnBehalfOf: &head.ID}, wantStep: 3, want: "PENDING"},
+		{req: &ApproveLeaveRequestRequest{ApproverID: hr.ID, Step: 3}, wantStep: 0, want: "APPROVED"},
+	}
+	for _, d := range decisions {
+		if err := repo.ApproveLeave(ctx, leave.ID, d.req); err != nil {
+			t.Fatalf("ApproveLeave() of step %d error = %v", d.req.Step, err)
+		}
+
+		stored, err := repo.GetByID(ctx, leave.ID)
+		if err != nil {
+			t.Fatalf("GetByID() error = %v", err)
+		}
+		if stored.CurrentStep != d.wantStep || stored.LeaveStatus != d.want {
+			t.Fatalf("after step %d the request is %s at step %d, want %s at step %d",
+				d.req.Step, stored.LeaveStatus, stored.CurrentStep, d.want, d.wantStep)
+		}
+
+		step := stored.ApprovalSteps[d.req.Step-1]
+		if step.Status != StepApproved || step.DecidedBy == nil || *step.DecidedBy != d.req.ApproverID || step.DecidedAt == nil {
+			t.Errorf("step %d = %s by %v at %v, want APPROVED by %s", step.StepOrder, step.Status, step.DecidedBy, step.DecidedAt, d.req.ApproverID)
+		}
+		if !reflect.DeepEqual(step.OnBehalfOf, d.req.OnBehalfOf) {
+			t.Errorf("step %d on behalf of = %v, want %v", step.StepOrder, step.OnBehalfOf, d.req.OnBehalfOf)
+		}
+	}
+
+	after, err := repo.GetBalance(ctx, emp.ID, "ANNUAL", 2026)
+	if err != nil {
+		t.Fatalf("GetBalance() error = %v", err)
+	}
+	if after.UsedDays != 12 {
+		t.Errorf("UsedDays = %v once the last step approved, want 12", after.UsedDays)
+	}
+}
+
+func TestApplyAccrualClosedYearIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	createClosedBalance(t, db, emp.ID, 2024)
+
+	if _, err := repo.ApplyAccrual(ctx, emp.ID, "ANNUAL", 2024, 15); !errors.Is(err, ErrBalanceClosed) {
+		t.Fatalf("ApplyAccrual() error = %v, want %v", err, ErrBalanceClosed)
+	}
+
+	balance, err := repo.GetBalance(ctx, emp.ID, "ANNUAL", 2024)
+	if err != nil {
+		t.Fatalf("GetBalance() error = %v", err)
+	}
+	if balance.TotalDays != 10 || balance.AccruedDays != 10 {
+		t.Errorf("balance = %v total and %v accrued days, want the 10 days it was closed with", balance.TotalDays, balance.AccruedDays)
+	}
+}
+
+func TestCancelLeaveIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	createBalance(t, db, emp.ID, 2026, 7)
+
+	approved := createApprovedLeave(t, repo, emp.ID)
+	if err := repo.CancelLeave(ctx, approved.ID, &CancelLeaveRequestRequest{CancelledBy: emp.ID, Reason: "plans changed"}); err != nil {
+		t.Fatalf("CancelLeave() error = %v", err)
+	}
+
+	balance, err := repo.GetBalance(ctx, emp.ID, "ANNUAL", 2026)
+	if err != nil {
+		t.Fatalf("GetBalance() error = %v", err)
+	}
+	if balance.UsedDays != 2 {
+		t.Errorf("UsedDays = %v after cancelling 5 approved days, want 2", balance.UsedDays)
+	}
+
+	stored, err := repo.GetByID(ctx, approved.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if stored.LeaveStatus != "CANCELLED" || stored.CancelledBy == nil || *stored.CancelledBy != emp.ID || stored.CancelledAt == nil {
+		t.Errorf("leave = %s cancelled by %v at %v, want CANCELLED by %s", stored.LeaveStatus, stored.CancelledBy, stored.CancelledAt, emp.ID)
+	}
+
+	err = repo.CancelLeave(ctx, approved.ID, &CancelLeaveRequestRequest{CancelledBy: emp.ID, Reason: "again"})
+	if !errors.Is(err, ErrLeaveNotCancellable) {
+		t.Errorf("CancelLeave() of cancelled leave error = %v, want %v", err, ErrLeaveNotCancellable)
+	}
+
+	pending := &LeaveRequest{
+		EmployeeID:    emp.ID,
+		LeaveType:     "ANNUAL",
+		StartDate:     date(2026, time.April, 6),
+		EndDate:       date(2026, time.April, 6),
+		DaysRequested: 1,
+		Duration:      DurationFullDay,
+		LeaveStatus:   "PENDING",
+		CurrentStep:   1,
+		ApprovalSteps: []*ApprovalStep{{StepOrder: 1, ApproverType: ApproverHR, Status: StepPending}},
+	}
+	if err := repo.Create(ctx, pending); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := repo.CancelLeave(ctx, pending.ID, &CancelLeaveRequestRequest{CancelledBy: emp.ID, Reason: "plans changed"}); err != nil {
+		t.Fatalf("CancelLeave() of pending leave error = %v", err)
+	}
+
+	stored, err = repo.GetByID(ctx, pending.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if stored.CurrentStep != 0 || stored.ApprovalSteps[0].Status != StepSkipped {
+		t.Errorf("pending leave at step %d with step status %s, want step 0 and %s", stored.CurrentStep, stored.ApprovalSteps[0].Status, StepSkipped)
+	}
+
+	balance, err = repo.GetBalance(ctx, emp.ID, "ANNUAL", 2026)
+	if err != nil {
+		t.Fatalf("GetBalance() error = %v", err)
+	}
+	if balance.UsedDays != 2 {
+		t.Errorf("UsedDays = %v after cancelling pending leave, want it unchanged at 2", balance.UsedDays)
+	}
+}
+
+func TestCancelLeaveClosedYearIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	createClosedBalance(t, db, emp.ID, 2026)
+
+	leave := createApprovedLeave(t, repo, emp.ID)
+	err := repo.CancelLeave(ctx, leave.ID, &CancelLeaveRequestRequest{CancelledBy: emp.ID, Reason: "plans changed"})
+	if !errors.Is(err, ErrBalanceClosed) {
+		t.Fatalf("CancelLeave() error = %v, want %v", err, ErrBalanceClosed)
+	}
+
+	stored, err := repo.GetByID(ctx, leave.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if stored.LeaveStatus != "APPROVED" {
+		t.Errorf("status = %s after the refused cancellation, want APPROVED", stored.LeaveStatus)
+	}
+}
+
+func TestWithdrawLeaveIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	createBalance(t, db, emp.ID, 2026, 5)
+	leave := createApprovedLeave(t, repo, emp.ID)
+
+	withdrawals := []struct {
+		lastDay       time.Time
+		days          float64
+		wantRestored  float64
+		wantUsed      float64
+		wantWithdrawn float64
+	}{
+		{lastDay: date(2026, time.March, 4), days: 3, wantRestored: 2, wantUsed: 3, wantWithdrawn: 2},
+		{lastDay: date(2026, time.March, 2), days: 1, wantRestored: 2, wantUsed: 1, wantWithdrawn: 4},
+	}
+	for _, w := range withdrawals {
+		withdrawn := &LeaveRequest{ID: leave.ID, EndDate: w.lastDay, DaysRequested: w.days}
+		restored, err := repo.WithdrawLeave(ctx, withdrawn, &WithdrawLeaveRequestRequest{LastDay: w.lastDay, WithdrawnBy: emp.ID, Reason: "back early"})
+		if err != nil {
+			t.Fatalf("WithdrawLeave() to %v error = %v", w.lastDay, err)
+		}
+		if restored != w.wantRestored {
+			t.Errorf("WithdrawLeave() to %v restored %v days, want %v", w.lastDay, restored, w.wantRestored)
+		}
+
+		balance, err := repo.GetBalance(ctx, emp.ID, "ANNUAL", 2026)
+		if err != nil {
+			t.Fatalf("GetBalance() error = %v", err)
+		}
+		if balance.UsedDays != w.wantUsed {
+			t.Errorf("UsedDays = %v after withdrawing to %v, want %v", balance.UsedDays, w.lastDay, w.wantUsed)
+		}
+
+		stored, err := repo.GetByID(ctx, leave.ID)
+		if err != nil {
+			t.Fatalf("GetByID() error = %v", err)
+		}
+		if !stored.EndDate.Equal(w.lastDay) || stored.DaysRequested != w.days || stored.WithdrawnDays != w.wantWithdrawn {
+			t.Errorf("leave ends %v with %v days and %v withdrawn, want %v with %v days and %v withdrawn",
+				stored.EndDate, stored.DaysRequested, stored.WithdrawnDays, w.lastDay, w.days, w.wantWithdrawn)
+		}
+		// The original end date is kept from the first withdrawal
+		if stored.OriginalEndDate == nil || !stored.OriginalEndDate.Equal(date(2026, time.March, 6)) {
+			t.Errorf("OriginalEndDate = %v, want %v", stored.OriginalEndDate, date(2026, time.March, 6))
+		}
+	}
+
+	// A withdrawal counted against an end date that changed since is refused
+	stale := &LeaveRequest{ID: leave.ID, EndDate: date(2026, time.March, 3), DaysRequested: 2}
+	_, err := repo.WithdrawLeave(ctx, stale, &WithdrawLeaveRequestRequest{LastDay: stale.EndDate, WithdrawnBy: emp.ID, Reason: "back early"})
+	if !errors.Is(err, ErrLeaveChanged) {
+		t.Errorf("WithdrawLeave() of stale days error = %v, want %v", err, ErrLeaveChanged)
+	}
+}
+
+func createCompOffCredit(t *testing.T, repo Repository, employeeID string, workDate time.Time) *CompOffCredit {
+	t.Helper()
+
+	credit := &CompOffCredit{EmployeeID: employeeID, WorkDate: workDate, Days: 1, Reason: "release weekend", Status: CreditPending}
+	if err := repo.CreateCompOffCredit(context.Background(), credit); err != nil {
+		t.Fatalf("CreateCompOffCredit() error = %v", err)
+	}
+	return credit
+}
+
+func TestApproveCompOffCreditAfterYearEndIntegration(t *testing.T) {
+	year := time.Now().Year()
+
+	tests := []struct {
+		name       string
+		closedAt   *time.Time
+		wantYear   int
+		wantClosed bool
+	}{
+		{name: "year of the work date still open", wantYear: year - 1},
+		{name: "year of the work date closed", closedAt: ptr(time.Now()), wantYear: year, wantClosed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.Open(t)
+			ctx := context.Background()
+			repo := NewRepository(db)
+			emp := createTestEmployee(t, db)
+			hr := createTestEmployee(t, db)
+
+			previous := &LeaveBalance{EmployeeID: emp.ID, LeaveType: LeaveTypeCompOff, Year: year - 1, ClosedAt: tt.closedAt}
+			if err := db.Create(previous).Error; err != nil {
+				t.Fatalf("failed to create leave balance: %v", err)
+			}
+
+			credit := createCompOffCredit(t, repo, emp.ID, date(year-1, time.December, 27))
+			expiresOn := date(year, time.January, 26)
+			balance, err := repo.ApproveCompOffCredit(ctx, credit.ID, &DecideCompOffRequest{ApproverID: hr.ID, ExpiresOn: expiresOn})
+			if err != nil {
+				t.Fatalf("ApproveCompOffCredit() error = %v", err)
+			}
+			if balance.Year != tt.wantYear || balance.TotalDays != 1 || balance.RemainingDays != 1 {
+				t.Errorf("credited balance of %d with %v total and %v remaining days, want %d with 1 and 1",
+					balance.Year, balance.TotalDays, balance.RemainingDays, tt.wantYear)
+			}
+
+			stored, err := repo.GetCompOffCredit(ctx, credit.ID)
+			if err != nil {
+				t.Fatalf("GetCompOffCredit() error = %v", err)
+			}
+			if stored.Status != CreditApproved || stored.BalanceID == nil || *stored.BalanceID != balance.ID {
+				t.Errorf("credit = %s into balance %v, want APPROVED into %s", stored.Status, stored.BalanceID, balance.ID)
+			}
+			if stored.ExpiresOn == nil || !stored.ExpiresOn.Equal(expiresOn) {
+				t.Errorf("ExpiresOn = %v, want %v", stored.ExpiresOn, expiresOn)
+			}
+
+			// A closed year keeps the total it was carried forward with
+			closed, err := repo.GetBalance(ctx, emp.ID, LeaveTypeCompOff, year-1)
+			if err != nil {
+				t.Fatalf("GetBalance() error = %v", err)
+			}
+			if tt.wantClosed && closed.TotalDays != 0 {
+				t.Errorf("closed balance total = %v, want 0", closed.TotalDays)
+			}
+
+			_, err = repo.ApproveCompOffCredit(ctx, credit.ID, &DecideCompOffRequest{ApproverID: hr.ID, ExpiresOn: expiresOn})
+			if !errors.Is(err, ErrCompOffNotPending) {
+				t.Errorf("ApproveCompOffCredit() again error = %v, want %v", err, ErrCompOffNotPending)
+			}
+		})
+	}
+}
+
+func TestExpireCompOffCreditsIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	hr := createTestEmployee(t, db)
+
+	// Half a day of the two credited is used, it comes out of the credit expiring first
+	balance := &LeaveBalance{EmployeeID: emp.ID, LeaveType: LeaveTypeCompOff, Year: 2026, UsedDays: 0.5}
+	if err := db.Create(balance).Error; err != nil {
+		t.Fatalf("failed to create leave balance: %v", err)
+	}
+	first := createCompOffCredit(t, repo, emp.ID, date(2026, time.February, 1))
+	second := createCompOffCredit(t, repo, emp.ID, date(2026, time.March, 1))
+	for _, credit := range []*CompOffCredit{first, second} {
+		expiresOn := credit.WorkDate.AddDate(0, 0, 30)
+		if _, err := repo.ApproveCompOffCredit(ctx, credit.ID, &DecideCompOffRequest{ApproverID: hr.ID, ExpiresOn: expiresOn}); err != nil {
+			t.Fatalf("ApproveCompOffCredit() error = %v", err)
+		}
+	}
+
+	runs := []struct {
+		today         time.Time
+		wantExpired   int64
+		wantCredit    *CompOffCredit
+		wantLapsed    float64
+		wantBalance   float64
+		wantRemaining float64
+	}{
+		{today: date(2026, time.February, 28), wantExpired: 0, wantBalance: 0, wantRemaining: 1.5},
+		{today: date(2026, time.March, 3), wantExpired: 1, wantCredit: first, wantLapsed: 0.5, wantBalance: 0.5, wantRemaining: 1},
+		{today: date(2026, time.March, 3), wantExpired: 0, wantBalance: 0.5, wantRemaining: 1},
+		{today: date(2026, time.March, 31), wantExpired: 1, wantCredit: second, wantLapsed: 1, wantBalance: 1.5, wantRemaining: 0},
+	}
+	for _, run := range runs {
+		expired, err := repo.ExpireCompOffCredits(ctx, run.today)
+		if err != nil {
+			t.Fatalf("ExpireCompOffCredits(%v) error = %v", run.today, err)
+		}
+		if expired != run.wantExpired {
+			t.Errorf("ExpireCompOffCredits(%v) = %d credits, want %d", run.today, expired, run.wantExpired)
+		}
+
+		if run.wantCredit != nil {
+			credit, err := repo.GetCompOffCredit(ctx, run.wantCredit.ID)
+			if err != nil {
+				t.Fatalf("GetCompOffCredit() error = %v", err)
+			}
+			if credit.ExpiredAt == nil || credit.ExpiredDays != run.wantLapsed {
+				t.Errorf("credit of %v lapsed %v days at %v, want %v", credit.WorkDate, credit.ExpiredDays, credit.ExpiredAt, run.wantLapsed)
+			}
+		}
+
+		after, err := repo.GetBalance(ctx, emp.ID, LeaveTypeCompOff, 2026)
+		if err != nil {
+			t.Fatalf("GetBalance() error = %v", err)
+		}
+		if after.ExpiredDays != run.wantBalance || after.GetRemainingDays() != run.wantRemaining {
+			t.Errorf("on %v the balance has %v expired and %v remaining days, want %v and %v",
+				run.today, after.ExpiredDays, after.GetRemainingDays(), run.wantBalance, run.wantRemaining)
+		}
+	}
+}
+
+func TestExpireCompOffCreditsClosedBalanceIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	emp := createTestEmployee(t, db)
+	hr := createTestEmployee(t, db)
+
+	balance := &LeaveBalance{EmployeeID: emp.ID, LeaveType: LeaveTypeCompOff, Year: 2025}
+	if err := db.Create(balance).Error; err != nil {
+		t.Fatalf("failed to create leave balance: %v", err)
+	}
+	credit := createCompOffCredit(t, repo, emp.ID, date(2025, time.December, 6))
+	if _, err := repo.ApproveCompOffCredit(ctx, credit.ID, &DecideCompOffRequest{ApproverID: hr.ID, ExpiresOn: date(2026, time.January, 5)}); err != nil {
+		t.Fatalf("ApproveCompOffCredit() error = %v", err)
+	}
+	// The year-end close carried the credited day forward, it lapses with the carried days
+	if err := db.Model(balance).Update("closed_at", time.Now()).Error; err != nil {
+		t.Fatalf("failed to close leave balance: %v", err)
+	}
+
+	expired, err := repo.ExpireCompOffCredits(ctx, date(2026, time.February, 1))
+	if err != nil {
+		t.Fatalf("ExpireCompOffCredits() error = %v", err)
+	}
+	if expired != 0 {
+		t.Errorf("ExpireCompOffCredits() = %d credits of a closed balance, want 0", expired)
+	}
+}
@@ -0,0 +1,1194 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service interface {
+	GetLeaveRequest(ctx context.Context, id string) (*LeaveRequest, error)
+	DeleteLeaveRequest(ctx context.Context, id string) error
+	ListLeaveRequests(ctx context.Context, req *ListLeaveRequestsRequest) (*ListLeaveRequestsResponse, error)
+	CreateLeaveRequest(ctx context.Context, req *CreateLeaveRequestRequest) (*LeaveRequest, error)
+	UpdateLeaveRequest(ctx context.Context, id string, req *UpdateLeaveRequestRequest) (*LeaveRequest, error)
+	ApproveLeaveRequest(ctx context.Context, id string, req *ApproveLeaveRequestRequest) (*LeaveRequest, error)
+	RejectLeaveRequest(ctx context.Context, id string, req *RejectLeaveRequestRequest) (*LeaveRequest, error)
+	CancelLeaveRequest(ctx context.Context, id string, req *CancelLeaveRequestRequest) (*LeaveRequest, error)
+	WithdrawLeaveRequest(ctx context.Context, id string, req *WithdrawLeaveRequestRequest) (*WithdrawLeaveResponse, error)
+	GetTeamCalendar(ctx context.Context, req *TeamCalendarRequest) (*TeamCalendar, error)
+	ExportLeaveCalendar(ctx context.Context, req *TeamCalendarRequest) (*CalendarExport, error)
+	RequestCompOffCredit(ctx context.Context, req *RequestCompOffRequest) (*CompOffCredit, error)
+	ListCompOffCredits(ctx context.Context, req *ListCompOffCreditsRequest) (*ListCompOffCreditsResponse, error)
+	ApproveCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*ApproveCompOffResponse, error)
+	RejectCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*CompOffCredit, error)
+	GetEmployeeLeaveBalance(ctx context.Context, req *GetEmployeeLeaveBalanceRequest) (*GetEmployeeLeaveBalanceResponse, error)
+	ListLeavePolicies(ctx context.Context) ([]*LeavePolicy, error)
+	SetLeavePolicy(ctx context.Context, policy *LeavePolicy) (*LeavePolicy, error)
+	RunLeaveAccrual(ctx context.Context, req *RunAccrualRequest) (*RunAccrualResponse, error)
+	CloseLeaveYear(ctx context.Context, req *CloseYearRequest) (*CloseYearResponse, error)
+	EncashLeave(ctx context.Context, req *EncashLeaveRequest) (*EncashLeaveResponse, error)
+	ListApprovalRules(ctx context.Context, leaveType string) ([]*ApprovalRule, error)
+	SetApprovalRules(ctx context.Context, leaveType string, rules []*ApprovalRule) ([]*ApprovalRule, error)
+	CreateApprovalDelegation(ctx context.Context, req *CreateDelegationRequest) (*Delegation, error)
+	ListApprovalDelegations(ctx context.Context, req *ListDelegationsRequest) ([]*Delegation, error)
+	DeleteApprovalDelegation(ctx context.Context, id string) error
+}
+
+// Policy holds the organisation-wide leave settings
+type Policy struct {
+	// WorkingHoursPerDay converts hourly leave into days
+	WorkingHoursPerDay float64
+	// WorkingDaysPerYear divides the annual salary into the daily rate encashed leave is paid at
+	WorkingDaysPerYear float64
+	// CompOffExpiryDays is how long after the day worked a comp-off credit can be used
+	CompOffExpiryDays int
+}
+
+type service struct {
+	repo     Repository
+	calendar *holiday.Calendar
+	accrual  *AccrualEngine
+	yearEnd  *YearEndJob
+	policy   Policy
+	logger   *logger.Logger
+}
+
+func NewService(repo Repository, calendar *holiday.Calendar, accrual *AccrualEngine, yearEnd *YearEndJob, policy Policy, logger *logger.Logger) Service {
+	return &service{
+		repo:     repo,
+		calendar: calendar,
+		accrual:  accrual,
+		yearEnd:  yearEnd,
+		policy:   policy,
+		logger:   logger.ServiceLogger("leave"),
+	}
+}
+
+func (s *service) GetLeaveRequest(ctx context.Context, id string) (*LeaveRequest, error) {
+	s.logger.Info("Getting leave request", "id", id)
+
+	leave, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get leave request", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get leave request")
+	}
+
+	s.setPendingApprovers(ctx, leave)
+	return leave, nil
+}
+
+func (s *service) DeleteLeaveRequest(ctx context.Context, id string) error {
+	s.logger.Info("Deleting leave request", "id", id)
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.logger.Error("Failed to delete leave request", "id", id, "error", err)
+		return statusFromError(err, "Failed to delete leave request")
+	}
+
+	s.logger.Info("Leave request deleted successfully", "id", id)
+	return nil
+}
+
+func (s *service) ListLeaveRequests(ctx context.Context, req *ListLeaveRequestsRequest) (*ListLeaveRequestsResponse, error) {
+	s.logger.Info("Listing leave requests", "page", req.Page, "page_size", req.PageSize, "employee_id", req.EmployeeID)
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	response, err := s.repo.List(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list leave requests", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list leave requests")
+	}
+
+	s.setPendingApprovers(ctx, response.LeaveRequests...)
+
+	s.logger.Info("Successfully listed leave requests", "count", len(response.LeaveRequests), "total", response.TotalCount)
+	return response, nil
+}
+
+func (s *service) CreateLeaveRequest(ctx context.Context, req *CreateLeaveRequestRequest) (*LeaveRequest, error) {
+	s.logger.Info("Creating leave request", "employee_id", req.EmployeeID, "leave_type", req.LeaveType)
+
+	if req.EmployeeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+	if req.LeaveType == "" {
+		return nil, status.Error(codes.InvalidArgument, "Leave type is required")
+	}
+	if err := validateLeaveDates(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+	employee, err := s.checkEmployee(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	leave := FromCreateRequest(req)
+	if err := s.countWorkingDays(ctx, leave, employee); err != nil {
+		return nil, err
+	}
+	if err := s.checkOverlap(ctx, leave); err != nil {
+		return nil, err
+	}
+	warnings, _, err := s.staffingConflicts(ctx, leave, employee)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.startApproval(ctx, leave, employee); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, leave); err != nil {
+		s.logger.Error("Failed to create leave request", "employee_id", req.EmployeeID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create leave request")
+	}
+
+	s.logger.Info("Leave request created successfully", "id", leave.ID, "employee_id", leave.EmployeeID)
+	created := s.reload(ctx, leave)
+	created.Warnings = warnings
+	return created, nil
+}
+
+func (s *service) UpdateLeaveRequest(ctx context.Context, id string, req *UpdateLeaveRequestRequest) (*LeaveRequest, error) {
+	s.logger.Info("Updating leave request", "id", id)
+
+	leave, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get leave request for update", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get leave request")
+	}
+
+	if leave.LeaveStatus != "PENDING" {
+		return nil, status.Error(codes.FailedPrecondition, "Only pending leave requests can be updated")
+	}
+
+	leave.ApplyUpdate(req)
+	if err := validateLeaveDates(leave.StartDate, leave.EndDate); err != nil {
+		return nil, err
+	}
+	employee, err := s.checkEmployee(ctx, leave.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.countWorkingDays(ctx, leave, employee); err != nil {
+		return nil, err
+	}
+	if err := s.checkOverlap(ctx, leave); err != nil {
+		return nil, err
+	}
+	warnings, _, err := s.staffingConflicts(ctx, leave, employee)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.startApproval(ctx, leave, employee); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, leave); err != nil {
+		s.logger.Error("Failed to update leave request", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to update leave request")
+	}
+
+	s.logger.Info("Leave request updated successfully", "id", id)
+	updated := s.reload(ctx, leave)
+	updated.Warnings = warnings
+	return updated, nil
+}
+
+func (s *service) ApproveLeaveRequest(ctx context.Context, id string, req *ApproveLeaveRequestRequest) (*LeaveRequest, error) {
+	s.logger.Info("Approving leave request", "id", id, "approver_id", req.ApproverID)
+
+	if req.ApproverID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Approver ID is required")
+	}
+
+	leave, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get leave request for approval", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get leave request")
+	}
+	if leave.LeaveStatus != "PENDING" {
+		return nil, statusFromError(ErrLeaveNotPending, "Failed to approve leave request")
+	}
+	req.Step = leave.CurrentStep
+	req.OnBehalfOf, err = s.authorizeStep(ctx, leave, req.ApproverID, req.ApproverRole)
+	if err != nil {
+		return nil, err
+	}
+	employee, err := s.repo.GetEmployee(ctx, leave.EmployeeID)
+	if err != nil {
+		s.logger.Error("Failed to get employee for approval", "employee_id", leave.EmployeeID, "error", err)
+		return nil, statusFromError(err, "Failed to get employee")
+	}
+
+	warnings, blocked, err := s.staffingConflicts(ctx, leave, employee)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		s.logger.Warn("Leave approval blocked by staffing rule", "id", id, "conflicts", len(warnings))
+		return nil, conflictError(codes.FailedPrecondition, "Approving this leave would leave the department understaffed", warnings)
+	}
+
+	if err := s.repo.ApproveLeave(ctx, id, req); err != nil {
+		s.logger.Error("Failed to approve leave request", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to approve leave request")
+	}
+
+	s.logger.Info("Leave approval step decided", "id", id, "step", req.Step, "approver_id", req.ApproverID)
+	approved, err := s.GetLeaveRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	approved.Warnings = warnings
+	return approved, nil
+}
+
+func (s *service) RejectLeaveRequest(ctx context.Context, id string, req *RejectLeaveRequestRequest) (*LeaveRequest, error) {
+	s.logger.Info("Rejecting leave request", "id", id, "approver_id", req.ApproverID)
+
+	if req.ApproverID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Approver ID is required")
+	}
+	if req.Comments == "" {
+		return nil, status.Error(codes.InvalidArgument, "Comments are required when rejecting a leave request")
+	}
+
+	leave, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get leave request for rejection", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get leave request")
+	}
+	if leave.LeaveStatus != "PENDING" {
+		return nil, statusFromError(ErrLeaveNotPending, "Failed to reject leave request")
+	}
+	req.Step = leave.CurrentStep
+	req.OnBehalfOf, err = s.authorizeStep(ctx, leave, req.ApproverID, req.ApproverRole)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RejectLeave(ctx, id, req); err != nil {
+		s.logger.Error("Failed to reject leave request", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to reject leave request")
+	}
+
+	s.logger.Info("Leave request rejected successfully", "id", id, "approver_id", req.ApproverID)
+	return s.GetLeaveRequest(ctx, id)
+}
+
+// CancelLeaveRequest cancels pending or approved leave that has not started yet,
+// the days of approved leave go back to the balance
+func (s *service) CancelLeaveRequest(ctx context.Context, id string, req *CancelLeaveRequestRequest) (*LeaveRequest, error) {
+	s.logger.Info("Cancelling leave request", "id", id, "cancelled_by", req.CancelledBy)
+
+	if req.CancelledBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "Cancelled by is required")
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason is required when cancelling a leave request")
+	}
+
+	leave, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get leave request for cancellation", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get leave request")
+	}
+	if !holiday.DateOf(leave.StartDate).After(holiday.DateOf(time.Now())) {
+		return nil, status.Error(codes.FailedPrecondition, "Leave that has started cannot be cancelled, withdraw the remaining days instead")
+	}
+
+	if err := s.repo.CancelLeave(ctx, id, req); err != nil {
+		s.logger.Error("Failed to cancel leave request", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to cancel leave request")
+	}
+
+	s.logger.Info("Leave request cancelled successfully", "id", id, "previous_status", leave.LeaveStatus)
+	return s.GetLeaveRequest(ctx, id)
+}
+
+// WithdrawLeaveRequest cuts approved leave in progress short after req.LastDay and
+// gives the working days no longer taken back to the balance
+func (s *service) WithdrawLeaveRequest(ctx context.Context, id string, req *WithdrawLeaveRequestRequest) (*WithdrawLeaveResponse, error) {
+	s.logger.Info("Withdrawing leave request", "id", id, "last_day", req.LastDay, "withdrawn_by", req.WithdrawnBy)
+
+	if req.WithdrawnBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "Withdrawn by is required")
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason is required when withdrawing leave")
+	}
+	if req.LastDay.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "Last day of leave is required")
+	}
+
+	leave, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get leave request for withdrawal", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get leave request")
+	}
+	if leave.LeaveStatus != "APPROVED" {
+		return nil, statusFromError(ErrLeaveNotApproved, "Failed to withdraw leave request")
+	}
+
+	today := holiday.DateOf(time.Now())
+	lastDay := holiday.DateOf(req.LastDay)
+	start, end := holiday.DateOf(leave.StartDate), holiday.DateOf(leave.EndDate)
+	if start.After(today) {
+		return nil, status.Error(codes.FailedPrecondition, "Leave has not started yet, cancel it instead")
+	}
+	if end.Before(today) {
+		return nil, status.Error(codes.FailedPrecondition, "Leave is already over")
+	}
+	// Days before today were taken and cannot be given back
+	if lastDay.Before(start) || lastDay.Before(today.AddDate(0, 0, -1)) {
+		return nil, status.Error(codes.InvalidArgument, "Last day cannot be before the start of the leave or yesterday")
+	}
+	if !lastDay.Before(end) {
+		return nil, status.Error(codes.InvalidArgument, "Last day must be before the end of the leave")
+	}
+
+	employee, err := s.repo.GetEmployee(ctx, leave.EmployeeID)
+	if err != nil {
+		s.logger.Error("Failed to get employee for withdrawal", "employee_id", leave.EmployeeID, "error", err)
+		return nil, statusFromError(err, "Failed to get employee")
+	}
+
+	withdrawn := &LeaveRequest{
+		ID:         leave.ID,
+		EmployeeID: leave.EmployeeID,
+		StartDate:  start,
+		EndDate:    lastDay,
+		Duration:   leave.Duration,
+		Hours:      leave.Hours,
+	}
+	if err := s.countWorkingDays(ctx, withdrawn, employee); err != nil {
+		return nil, err
+	}
+	if withdrawn.DaysRequested >= leave.DaysRequested {
+		return nil, status.Error(codes.FailedPrecondition, "No working days are left to withdraw after the last day")
+	}
+
+	restored, err := s.repo.WithdrawLeave(ctx, withdrawn, req)
+	if err != nil {
+		s.logger.Error("Failed to withdraw leave request", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to withdraw leave request")
+	}
+
+	s.logger.Info("Leave request withdrawn successfully", "id", id, "restored_days", restored)
+	leave, err = s.GetLeaveRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &WithdrawLeaveResponse{LeaveRequest: leave, RestoredDays: restored}, nil
+}
+
+func (s *service) GetTeamCalendar(ctx context.Context, req *TeamCalendarRequest) (*TeamCalendar, error) {
+	s.logger.Info("Getting team calendar", "department_id", req.DepartmentID, "manager_id", req.ManagerID, "start_date", req.StartDate, "end_date", req.EndDate)
+
+	if (req.DepartmentID == "") == (req.ManagerID == "") {
+		return nil, status.Error(codes.InvalidArgument, "Either a department ID or a manager ID is required")
+	}
+	if err := validateCalendarRange(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+
+	return s.teamCalendar(ctx, req)
+}
+
+// ExportLeaveCalendar returns the calendar of an employee or a department as an
+// iCalendar file, covering this year and the next unless dates are given
+func (s *service) ExportLeaveCalendar(ctx context.Context, req *TeamCalendarRequest) (*CalendarExport, error) {
+	s.logger.Info("Exporting leave calendar", "employee_id", req.EmployeeID, "department_id", req.DepartmentID)
+
+	if (req.EmployeeID == "") == (req.DepartmentID == "") {
+		return nil, status.Error(codes.InvalidArgument, "Either an employee ID or a department ID is required")
+	}
+
+	now := time.Now()
+	if req.StartDate.IsZero() {
+		req.StartDate = time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if req.EndDate.IsZero() {
+		req.EndDate = time.Date(now.Year()+1, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+	if err := validateCalendarRange(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+
+	var name, filename string
+	if req.EmployeeID != "" {
+		employee, err := s.repo.GetEmployee(ctx, req.EmployeeID)
+		if err != nil {
+			s.logger.Warn("Employee for leave calendar not found", "employee_id", req.EmployeeID, "error", err)
+			return nil, statusFromError(err, "Failed to get employee")
+		}
+		name = "Leave of " + employee.FirstName + " " + employee.LastName
+		filename = "leave-" + employee.ID + ".ics"
+	} else {
+		rule, err := s.repo.GetStaffingRule(ctx, req.DepartmentID)
+		if err != nil {
+			s.logger.Error("Failed to get department", "department_id", req.DepartmentID, "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get department")
+		}
+		if rule == nil {
+			return nil, status.Error(codes.NotFound, "Department not found")
+		}
+		name = "Leave in " + rule.DepartmentName
+		filename = "leave-department-" + rule.DepartmentID + ".ics"
+	}
+
+	calendar, err := s.teamCalendar(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Leave calendar exported", "absences", len(calendar.Absences), "holidays", len(calendar.Holidays))
+	return &CalendarExport{
+		Filename:    filename,
+		ContentType: ContentTypeICS,
+		Content:     encodeICS(name, calendar, now),
+	}, nil
+}
+
+// teamCalendar collects the absences of the employees the request selects and the
+// holidays of every country and location they work in
+func (s *service) teamCalendar(ctx context.Context, req *TeamCalendarRequest) (*TeamCalendar, error) {
+	members, err := s.repo.ListTeamMembers(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list team members", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get team calendar")
+	}
+
+	employeeIDs := make([]string, len(members))
+	for i, member := range members {
+		employeeIDs[i] = member.ID
+	}
+
+	absences, err := s.repo.ListTeamAbsences(ctx, employeeIDs, holiday.DateOf(req.StartDate), holiday.DateOf(req.EndDate))
+	if err != nil {
+		s.logger.Error("Failed to list team absences", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get team calendar")
+	}
+
+	// Reasons such as the cause of sick leave are for the employee and their approvers
+	for _, absence := range absences {
+		if absence.EmployeeID != req.CallerID {
+			absence.hideReasons()
+		}
+	}
+
+	calendar := &TeamCalendar{Absences: absences, Holidays: []*holiday.Holiday{}}
+	seenCalendars := map[string]bool{}
+	seenHolidays := map[string]bool{}
+	for _, member := range members {
+		key := holiday.NormalizeCountry(member.Country) + "/" + strings.ToLower(strings.TrimSpace(member.Location))
+		if seenCalendars[key] {
+			continue
+		}
+		seenCalendars[key] = true
+
+		holidays, err := s.calendar.Holidays(ctx, member.Country, member.Location, req.StartDate, req.EndDate)
+		if err != nil {
+			s.logger.Error("Failed to list holidays", "country", member.Country, "location", member.Location, "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get team calendar")
+		}
+		for _, h := range holidays {
+			if !seenHolidays[h.ID] {
+				seenHolidays[h.ID] = true
+				calendar.Holidays = append(calendar.Holidays, h)
+			}
+		}
+	}
+
+	sort.SliceStable(calendar.Holidays, func(i, j int) bool {
+		return calendar.Holidays[i].Date.Before(calendar.Holidays[j].Date)
+	})
+	return calendar, nil
+}
+
+// RequestCompOffCredit records a manager's request to credit an employee for a
+// weekend or holiday worked, HR approves it
+func (s *service) RequestCompOffCredit(ctx context.Context, req *RequestCompOffRequest) (*CompOffCredit, error) {
+	s.logger.Info("Requesting comp-off credit", "employee_id", req.EmployeeID, "work_date", req.WorkDate, "requested_by", req.RequestedBy)
+
+	if req.EmployeeID == "" || req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID and requester are required")
+	}
+	if req.EmployeeID == req.RequestedBy {
+		return nil, status.Error(codes.InvalidArgument, "Comp-off cannot be requested for yourself")
+	}
+	if req.Days != 0.5 && req.Days != 1 {
+		return nil, status.Error(codes.InvalidArgument, "Comp-off is credited in half or whole days")
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason is required")
+	}
+	if req.WorkDate.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "Work date is required")
+	}
+
+	today := holiday.DateOf(time.Now())
+	workDate := holiday.DateOf(req.WorkDate)
+	if workDate.After(today) {
+		return nil, status.Error(codes.InvalidArgument, "Comp-off can only be credited for days already worked")
+	}
+	if !s.compOffExpiry(workDate).After(today) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Comp-off must be credited within %d days of the day worked", s.policy.CompOffExpiryDays)
+	}
+
+	employee, err := s.checkEmployee(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	workingDays, err := s.calendar.WorkingDays(ctx, employee.Country, employee.Location, workDate, workDate)
+	if err != nil {
+		s.logger.Error("Failed to check work date", "employee_id", employee.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to check work date")
+	}
+	if workingDays.Days > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Comp-off is only earned on weekends and holidays")
+	}
+
+	existing, err := s.repo.FindCompOffCredit(ctx, employee.ID, workDate)
+	if err != nil {
+		s.logger.Error("Failed to check for existing comp-off credit", "employee_id", employee.ID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to request comp-off credit")
+	}
+	if existing != nil {
+		return nil, status.Error(codes.AlreadyExists, "Comp-off was already requested for this day")
+	}
+
+	credit := &CompOffCredit{
+		EmployeeID:  employee.ID,
+		WorkDate:    workDate,
+		Days:        req.Days,
+		Reason:      req.Reason,
+		Status:      CreditPending,
+		RequestedBy: &req.RequestedBy,
+	}
+	if err := s.repo.CreateCompOffCredit(ctx, credit); err != nil {
+		s.logger.Error("Failed to create comp-off credit", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to request comp-off credit")
+	}
+
+	s.logger.Info("Comp-off credit requested successfully", "id", credit.ID)
+	return s.getCompOffCredit(ctx, credit.ID)
+}
+
+func (s *service) ListCompOffCredits(ctx context.Context, req *ListCompOffCreditsRequest) (*ListCompOffCreditsResponse, error) {
+	s.logger.Info("Listing comp-off credits", "employee_id", req.EmployeeID, "status", req.Status)
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	response, err := s.repo.ListCompOffCredits(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list comp-off credits", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list comp-off credits")
+	}
+	return response, nil
+}
+
+// ApproveCompOffCredit adds the days of the credit to the employee's COMP_OFF
+// balance, they can be used until the expiry window after the day worked ends
+func (s *service) ApproveCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*ApproveCompOffResponse, error) {
+	s.logger.Info("Approving comp-off credit", "id", id, "approver_id", req.ApproverID)
+
+	credit, err := s.decidableCredit(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ExpiresOn = s.compOffExpiry(credit.WorkDate)
+	if !req.ExpiresOn.After(holiday.DateOf(time.Now())) {
+		return nil, status.Error(codes.FailedPrecondition, "Comp-off credit expired before it was approved")
+	}
+
+	balance, err := s.repo.ApproveCompOffCredit(ctx, id, req)
+	if err != nil {
+		s.logger.Error("Failed to approve comp-off credit", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to approve comp-off credit")
+	}
+
+	s.logger.Info("Comp-off credit approved successfully", "id", id, "days", credit.Days, "expires_on", req.ExpiresOn)
+	credit, err = s.getCompOffCredit(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveCompOffResponse{Credit: credit, LeaveBalance: balance}, nil
+}
+
+func (s *service) RejectCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*CompOffCredit, error) {
+	s.logger.Info("Rejecting comp-off credit", "id", id, "approver_id", req.ApproverID)
+
+	if req.Comments == "" {
+		return nil, status.Error(codes.InvalidArgument, "Comments are required when rejecting a comp-off credit")
+	}
+	if _, err := s.decidableCredit(ctx, id, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RejectCompOffCredit(ctx, id, req); err != nil {
+		s.logger.Error("Failed to reject comp-off credit", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to reject comp-off credit")
+	}
+
+	s.logger.Info("Comp-off credit rejected successfully", "id", id)
+	return s.getCompOffCredit(ctx, id)
+}
+
+// decidableCredit loads a pending credit the approver may decide on, neither the
+// employee credited nor the manager who requested it can
+func (s *service) decidableCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*CompOffCredit, error) {
+	if req.ApproverID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Approver ID is required")
+	}
+
+	credit, err := s.getCompOffCredit(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if credit.Status != CreditPending {
+		return nil, statusFromError(ErrCompOffNotPending, "Failed to decide on comp-off credit")
+	}
+	if credit.EmployeeID == req.ApproverID || (credit.RequestedBy != nil && *credit.RequestedBy == req.ApproverID) {
+		return nil, status.Error(codes.PermissionDenied, "Comp-off credits must be decided by someone other than the employee or the requester")
+	}
+	return credit, nil
+}
+
+func (s *service) getCompOffCredit(ctx context.Context, id string) (*CompOffCredit, error) {
+	credit, err := s.repo.GetCompOffCredit(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get comp-off credit", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get comp-off credit")
+	}
+	return credit, nil
+}
+
+// compOffExpiry returns the date unused comp-off earned on workDate lapses on
+func (s *service) compOffExpiry(workDate time.Time) time.Time {
+	return holiday.DateOf(workDate).AddDate(0, 0, s.policy.CompOffExpiryDays)
+}
+
+func (s *service) GetEmployeeLeaveBalance(ctx context.Context, req *GetEmployeeLeaveBalanceRequest) (*GetEmployeeLeaveBalanceResponse, error) {
+	s.logger.Info("Getting employee leave balance", "employee_id", req.EmployeeID, "year", req.Year)
+
+	if req.EmployeeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+
+	response, err := s.repo.LeaveBalance(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to get employee leave balance", "employee_id", req.EmployeeID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get leave balance")
+	}
+
+	return response, nil
+}
+
+func (s *service) ListLeavePolicies(ctx context.Context) ([]*LeavePolicy, error) {
+	s.logger.Info("Listing leave policies")
+
+	policies, err := s.repo.ListPolicies(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list leave policies", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list leave policies")
+	}
+	return policies, nil
+}
+
+func (s *service) SetLeavePolicy(ctx context.Context, policy *LeavePolicy) (*LeavePolicy, error) {
+	s.logger.Info("Setting leave policy", "leave_type", policy.LeaveType, "accrual_method", policy.AccrualMethod, "days_per_year", policy.DaysPerYear)
+
+	if policy.LeaveType == "" {
+		return nil, status.Error(codes.InvalidArgument, "Leave type is required")
+	}
+	if policy.AccrualMethod != AccrualAnnual && policy.AccrualMethod != AccrualMonthly {
+		return nil, status.Error(codes.InvalidArgument, "Accrual method must be ANNUAL or MONTHLY")
+	}
+	if policy.DaysPerYear < 0 || policy.DaysPerYear > 366 {
+		return nil, status.Error(codes.InvalidArgument, "Days per year must be between 0 and 366")
+	}
+	if policy.ProbationMonths < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Probation months cannot be negative")
+	}
+	if policy.CarryForwardMaxDays < 0 || policy.CarryForwardMaxDays > 366 {
+		return nil, status.Error(codes.InvalidArgument, "Carry-forward cap must be between 0 and 366 days")
+	}
+	// Carried days must lapse before the year they were carried into is closed
+	if policy.CarryForwardExpiryMonths < 0 || policy.CarryForwardExpiryMonths > 12 {
+		return nil, status.Error(codes.InvalidArgument, "Carry-forward expiry must be between 0 and 12 months")
+	}
+
+	if err := s.repo.SavePolicy(ctx, policy); err != nil {
+		s.logger.Error("Failed to save leave policy", "leave_type", policy.LeaveType, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to save leave policy")
+	}
+
+	s.logger.Info("Leave policy saved successfully", "leave_type", policy.LeaveType)
+	return policy, nil
+}
+
+func (s *service) RunLeaveAccrual(ctx context.Context, req *RunAccrualRequest) (*RunAccrualResponse, error) {
+	s.logger.Info("Running leave accrual", "year", req.Year, "dry_run", req.DryRun, "employee_id", req.EmployeeID)
+
+	response, err := s.accrual.Run(ctx, req, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to run leave accrual", "year", req.Year, "error", err)
+		if errors.Is(err, ErrAccrualFutureYear) {
+			return nil, status.Error(codes.InvalidArgument, "Cannot accrue leave for a future year")
+		}
+		return nil, status.Error(codes.Internal, "Failed to run leave accrual")
+	}
+
+	return response, nil
+}
+
+func (s *service) CloseLeaveYear(ctx context.Context, req *CloseYearRequest) (*CloseYearResponse, error) {
+	s.logger.Info("Closing leave year", "year", req.Year, "dry_run", req.DryRun)
+
+	response, err := s.yearEnd.Close(ctx, req, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to close leave year", "year", req.Year, "error", err)
+		if errors.Is(err, ErrYearNotOver) {
+			return nil, status.Error(codes.FailedPrecondition, "Only past leave years can be closed, use a dry run to preview the current year")
+		}
+		return nil, status.Error(codes.Internal, "Failed to close leave year")
+	}
+
+	return response, nil
+}
+
+func (s *service) EncashLeave(ctx context.Context, req *EncashLeaveRequest) (*EncashLeaveResponse, error) {
+	s.logger.Info("Encashing leave", "employee_id", req.EmployeeID, "year", req.Year, "days", req.Days)
+
+	if req.EmployeeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+	if req.Days <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Days to encash must be positive")
+	}
+	if req.Year == 0 {
+		req.Year = time.Now().Year()
+	}
+	req.Days = RoundDays(req.Days)
+
+	employee, err := s.checkEmployee(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee.Salary <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Employee has no salary to derive a daily rate from")
+	}
+
+	dailyRate := math.Round(employee.Salary/s.policy.WorkingDaysPerYear*100) / 100
+
+	balance, item, err := s.repo.Encash(ctx, req, dailyRate)
+	if err != nil {
+		s.logger.Error("Failed to encash leave", "employee_id", req.EmployeeID, "year", req.Year, "error", err)
+		return nil, statusFromError(err, "Failed to encash leave")
+	}
+
+	s.logger.Info("Leave encashed successfully", "employee_id", req.EmployeeID, "days", req.Days, "amount", item.Amount)
+	return &EncashLeaveResponse{
+		LeaveBalance: balance,
+		LineItem:     item,
+	}, nil
+}
+
+func (s *service) ListApprovalRules(ctx context.Context, leaveType string) ([]*ApprovalRule, error) {
+	s.logger.Info("Listing approval rules", "leave_type", leaveType)
+
+	rules, err := s.repo.ListApprovalRules(ctx, leaveType)
+	if err != nil {
+		s.logger.Error("Failed to list approval rules", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list approval rules")
+	}
+	return rules, nil
+}
+
+// SetApprovalRules replaces the approval chain of a leave type, the steps are numbered in the order given
+func (s *service) SetApprovalRules(ctx context.Context, leaveType string, rules []*ApprovalRule) ([]*ApprovalRule, error) {
+	s.logger.Info("Setting approval rules", "leave_type", leaveType, "steps", len(rules))
+
+	if leaveType == "" {
+		return nil, status.Error(codes.InvalidArgument, "Leave type is required")
+	}
+	for i, rule := range rules {
+		switch rule.ApproverType {
+		case ApproverManager, ApproverDepartmentManager, ApproverHR:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Step %d needs an approver type", i+1)
+		}
+		if rule.MinDays < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Minimum days of step %d cannot be negative", i+1)
+		}
+		rule.LeaveType = leaveType
+		rule.StepOrder = i + 1
+	}
+
+	if err := s.repo.SetApprovalRules(ctx, leaveType, rules); err != nil {
+		s.logger.Error("Failed to save approval rules", "leave_type", leaveType, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to save approval rules")
+	}
+
+	s.logger.Info("Approval rules saved successfully", "leave_type", leaveType)
+	return s.ListApprovalRules(ctx, leaveType)
+}
+
+func (s *service) CreateApprovalDelegation(ctx context.Context, req *CreateDelegationRequest) (*Delegation, error) {
+	s.logger.Info("Creating approval delegation", "delegator_id", req.DelegatorID, "delegate_id", req.DelegateID)
+
+	if req.DelegatorID == "" || req.DelegateID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID and delegate ID are required")
+	}
+	if req.DelegatorID == req.DelegateID {
+		return nil, status.Error(codes.InvalidArgument, "Approvals cannot be delegated to yourself")
+	}
+	if err := validateDates(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+	if holiday.DateOf(req.EndDate).Before(holiday.DateOf(time.Now())) {
+		return nil, status.Error(codes.InvalidArgument, "Delegation cannot end in the past")
+	}
+
+	if _, err := s.checkEmployee(ctx, req.DelegatorID); err != nil {
+		return nil, err
+	}
+	delegate, err := s.checkEmployee(ctx, req.DelegateID)
+	if err != nil {
+		return nil, err
+	}
+	// The delegate decides through ApproveLeaveRequest, which only approvers may call
+	if delegate.Role != auth.RoleManager && delegate.Role != auth.RoleHR && delegate.Role != auth.RoleAdmin {
+		return nil, status.Error(codes.FailedPrecondition, "Approvals can only be delegated to managers, HR or admins")
+	}
+
+	delegation := &Delegation{
+		DelegatorID: req.DelegatorID,
+		DelegateID:  req.DelegateID,
+		StartDate:   holiday.DateOf(req.StartDate),
+		EndDate:     holiday.DateOf(req.EndDate),
+		Reason:      req.Reason,
+	}
+	if err := s.repo.CreateDelegation(ctx, delegation); err != nil {
+		s.logger.Error("Failed to create approval delegation", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create approval delegation")
+	}
+
+	s.logger.Info("Approval delegation created successfully", "id", delegation.ID)
+	return delegation, nil
+}
+
+func (s *service) ListApprovalDelegations(ctx context.Context, req *ListDelegationsRequest) ([]*Delegation, error) {
+	s.logger.Info("Listing approval delegations", "employee_id", req.EmployeeID, "active_only", req.ActiveOnly)
+
+	delegations, err := s.repo.ListDelegations(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list approval delegations", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list approval delegations")
+	}
+	return delegations, nil
+}
+
+func (s *service) DeleteApprovalDelegation(ctx context.Context, id string) error {
+	s.logger.Info("Deleting approval delegation", "id", id)
+
+	if err := s.repo.DeleteDelegation(ctx, id); err != nil {
+		s.logger.Error("Failed to delete approval delegation", "id", id, "error", err)
+		return statusFromError(err, "Failed to delete approval delegation")
+	}
+
+	s.logger.Info("Approval delegation deleted successfully", "id", id)
+	return nil
+}
+
+// startApproval resolves the approval chain of the leave and puts it on its first step
+func (s *service) startApproval(ctx context.Context, leave *LeaveRequest, employee *Employee) error {
+	rules, err := s.repo.ListApprovalRules(ctx, leave.LeaveType)
+	if err != nil {
+		s.logger.Error("Failed to get approval rules", "leave_type", leave.LeaveType, "error", err)
+		return status.Error(codes.Internal, "Failed to resolve approval chain")
+	}
+
+	leave.ApprovalSteps = buildApprovalChain(rules, leave, employee)
+	leave.CurrentStep = leave.ApprovalSteps[0].StepOrder
+	return nil
+}
+
+// authorizeStep makes sure the approver may decide on the current step of the leave
+// and returns the approver a delegate acts for. Admins may decide on any step.
+func (s *service) authorizeStep(ctx context.Context, leave *LeaveRequest, approverID, approverRole string) (*string, error) {
+	if approverID == leave.EmployeeID {
+		return nil, status.Error(codes.PermissionDenied, "Cannot decide on your own leave request")
+	}
+
+	step := leave.PendingStep()
+	if step == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Leave request has no pending approval step")
+	}
+	if approverRole == auth.RoleAdmin {
+		return nil, nil
+	}
+
+	if step.ApproverType == ApproverHR {
+		if approverRole == auth.RoleHR {
+			return nil, nil
+		}
+	} else if step.ApproverID != nil {
+		if *step.ApproverID == approverID {
+			return nil, nil
+		}
+
+		delegates, err := s.repo.ActiveDelegates(ctx, []string{*step.ApproverID}, holiday.DateOf(time.Now()))
+		if err != nil {
+			s.logger.Error("Failed to get approval delegates", "approver_id", *step.ApproverID, "error", err)
+			return nil, status.Error(codes.Internal, "Failed to check approval delegation")
+		}
+		for _, delegate := range delegates[*step.ApproverID] {
+			if delegate == approverID {
+				return step.ApproverID, nil
+			}
+		}
+	}
+
+	s.logger.Warn("Leave decision by an approver not pending", "id", leave.ID, "step", step.StepOrder, "approver_id", approverID)
+	return nil, status.Error(codes.PermissionDenied, "Only the pending approvers of the current step can decide on this leave request")
+}
+
+// setPendingApprovers fills in who may decide on the current step of the leave requests
+func (s *service) setPendingApprovers(ctx context.Context, leaves ...*LeaveRequest) {
+	var approverIDs []string
+	for _, leave := range leaves {
+		if step := leave.PendingStep(); step != nil && step.ApproverID != nil {
+			approverIDs = append(approverIDs, *step.ApproverID)
+		}
+	}
+
+	delegates, err := s.repo.ActiveDelegates(ctx, approverIDs, holiday.DateOf(time.Now()))
+	if err != nil {
+		// The requests are still worth returning without the delegates
+		s.logger.Error("Failed to get approval delegates", "error", err)
+	}
+
+	for _, leave := range leaves {
+		step := leave.PendingStep()
+		switch {
+		case step == nil:
+		case step.ApproverType == ApproverHR:
+			leave.PendingApproverRole = auth.RoleHR
+		case step.ApproverID != nil:
+			leave.PendingApprovers = append([]string{*step.ApproverID}, delegates[*step.ApproverID]...)
+		}
+	}
+}
+
+// checkEmployee makes sure leave is only requested for employees still on the payroll
+func (s *service) checkEmployee(ctx context.Context, employeeID string) (*Employee, error) {
+	employee, err := s.repo.GetEmployee(ctx, employeeID)
+	if err != nil {
+		s.logger.Warn("Employee for leave request not found", "employee_id", employeeID, "error", err)
+		return nil, statusFromError(err, "Failed to get employee")
+	}
+
+	if employee.Status == "TERMINATED" {
+		s.logger.Warn("Leave requested for terminated employee", "employee_id", employeeID)
+		return nil, status.Error(codes.FailedPrecondition, "Cannot request leave for a terminated employee")
+	}
+	return employee, nil
+}
+
+// countWorkingDays sets DaysRequested to the working days of the leave on the
+// employee's holiday calendar and records the dates that were left out. Partial
+// days are a fraction of the single working day they fall on.
+func (s *service) countWorkingDays(ctx context.Context, leave *LeaveRequest, employee *Employee) error {
+	if leave.Duration != DurationFullDay && !leave.StartDate.Equal(leave.EndDate) {
+		return status.Error(codes.InvalidArgument, "Half-day and hourly leave must start and end on the same date")
+	}
+
+	var fraction float64
+	switch leave.Duration {
+	case DurationFullDay:
+		fraction = 1
+	case DurationHalfDayAM, DurationHalfDayPM:
+		fraction = 0.5
+	case DurationHourly:
+		hours := *leave.Hours
+		if hours <= 0 || hours != math.Trunc(hours) {
+			return status.Error(codes.InvalidArgument, "Hourly leave must be a whole number of hours")
+		}
+		if hours > s.policy.WorkingHoursPerDay {
+			return status.Errorf(codes.InvalidArgument, "Hourly leave cannot exceed the %g hour working day", s.policy.WorkingHoursPerDay)
+		}
+		fraction = hours / s.policy.WorkingHoursPerDay
+	default:
+		return status.Error(codes.InvalidArgument, "Unknown leave duration")
+	}
+
+	workingDays, err := s.calendar.WorkingDays(ctx, employee.Country, employee.Location, leave.StartDate, leave.EndDate)
+	if err != nil {
+		s.logger.Error("Failed to count working days", "employee_id", employee.ID, "error", err)
+		return status.Error(codes.Internal, "Failed to count working days")
+	}
+
+	if workingDays.Days == 0 {
+		return status.Error(codes.InvalidArgument, "Leave period does not contain any working day")
+	}
+
+	leave.DaysRequested = RoundDays(float64(workingDays.Days) * fraction)
+	leave.ExcludedDates = workingDays.Excluded
+	return nil
+}
+
+// checkOverlap rejects leave that books time the employee already requested or was granted
+func (s *service) checkOverlap(ctx context.Context, leave *LeaveRequest) error {
+	existing, err := s.repo.FindOverlapping(ctx, leave.EmployeeID, leave.StartDate, leave.EndDate, leave.ID)
+	if err != nil {
+		s.logger.Error("Failed to check for overlapping leave", "employee_id", leave.EmployeeID, "error", err)
+		return status.Error(codes.Internal, "Failed to check for overlapping leave")
+	}
+
+	var conflicts []*Conflict
+	for _, other := range existing {
+		if leave.Overlaps(other) {
+			conflicts = append(conflicts, overlapConflict(other))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		s.logger.Warn("Leave request overlaps existing leave", "employee_id", leave.EmployeeID, "conflicts", len(conflicts))
+		return conflictError(codes.FailedPrecondition, "Leave request overlaps existing leave of the employee", conflicts)
+	}
+	return nil
+}
+
+// staffingConflicts checks the leave against the staffing rule of the employee's
+// department and reports whether the conflicts found block its approval
+func (s *service) staffingConflicts(ctx context.Context, leave *LeaveRequest, employee *Employee) ([]*Conflict, bool, error) {
+	if employee.DepartmentID == nil {
+		return nil, false, nil
+	}
+
+	rule, err := s.repo.GetStaffingRule(ctx, *employee.DepartmentID)
+	if err != nil {
+		s.logger.Error("Failed to get staffing rule", "department_id", *employee.DepartmentID, "error", err)
+		return nil, false, status.Error(codes.Internal, "Failed to check department staffing")
+	}
+	if rule == nil || rule.MaxConcurrentAbsences == 0 {
+		return nil, false, nil
+	}
+
+	absences, err := s.repo.ListDepartmentAbsences(ctx, rule.DepartmentID, leave.StartDate, leave.EndDate, leave.EmployeeID)
+	if err != nil {
+		s.logger.Error("Failed to list department absences", "department_id", rule.DepartmentID, "error", err)
+		return nil, false, status.Error(codes.Internal, "Failed to check department staffing")
+	}
+
+	conflicts := rule.Conflicts(leave, absences)
+	return conflicts, len(conflicts) > 0 && rule.Blocks(), nil
+}
+
+// reload returns the leave request with its relationships, or the given one if it can't be loaded
+func (s *service) reload(ctx context.Context, leave *LeaveRequest) *LeaveRequest {
+	loaded, err := s.repo.GetByID(ctx, leave.ID)
+	if err != nil {
+		s.logger.Error("Failed to reload leave request", "id", leave.ID, "error", err)
+		return leave
+	}
+	s.setPendingApprovers(ctx, loaded)
+	return loaded
+}
+
+func validateDates(startDate, endDate time.Time) error {
+	if startDate.IsZero() || endDate.IsZero() {
+		return status.Error(codes.InvalidArgument, "Start date and end date are required")
+	}
+	if endDate.Before(startDate) {
+		return status.Error(codes.InvalidArgument, "End date cannot be before start date")
+	}
+	return nil
+}
+
+// validateLeaveDates also keeps a leave request within one year, it is charged to the balance of the year it starts in
+func validateLeaveDates(startDate, endDate time.Time) error {
+	if err := validateDates(startDate, endDate); err != nil {
+		return err
+	}
+	if holiday.DateOf(startDate).Year() != holiday.DateOf(endDate).Year() {
+		return status.Error(codes.InvalidArgument, "Leave cannot span two years, request the days of each year separately")
+	}
+	return nil
+}
+
+// validateCalendarRange requires both dates and limits calendars to two years
+func validateCalendarRange(startDate, endDate time.Time) error {
+	if err := validateDates(startDate, endDate); err != nil {
+		return err
+	}
+	if holiday.DateOf(endDate).After(holiday.DateOf(startDate).AddDate(2, 0, 0)) {
+		return status.Error(codes.InvalidArgument, "Calendar cannot span more than two years")
+	}
+	return nil
+}
+
+// statusFromError maps repository errors to gRPC status errors
+func statusFromError(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, ErrLeaveNotFound):
+		return status.Error(codes.NotFound, "Leave request not found")
+	case errors.Is(err, ErrEmployeeNotFound):
+		return status.Error(codes.NotFound, "Employee not found")
+	case errors.Is(err, ErrLeaveNotPending):
+		return status.Error(codes.FailedPrecondition, "Leave request is no longer pending")
+	case errors.Is(err, ErrBalanceNotFound):
+		return status.Error(codes.FailedPrecondition, "No leave balance allocated for this leave type and year")
+	case errors.Is(err, ErrInsufficientBalance):
+		return status.Error(codes.FailedPrecondition, "Insufficient leave balance")
+	case errors.Is(err, ErrBalanceClosed):
+		return status.Error(codes.FailedPrecondition, "Leave year is already closed")
+	case errors.Is(err, ErrStepChanged):
+		return status.Error(codes.Aborted, "Leave request moved to another approval step, reload it and try again")
+	case errors.Is(err, ErrDelegationNotFound):
+		return status.Error(codes.NotFound, "Approval delegation not found")
+	case errors.Is(err, ErrLeaveNotCancellable):
+		return status.Error(codes.FailedPrecondition, "Only pending or approved leave can be cancelled")
+	case errors.Is(err, ErrLeaveNotApproved):
+		return status.Error(codes.FailedPrecondition, "Only approved leave can be withdrawn")
+	case errors.Is(err, ErrCompOffNotFound):
+		return status.Error(codes.NotFound, "Comp-off credit not found")
+	case errors.Is(err, ErrCompOffNotPending):
+		return status.Error(codes.FailedPrecondition, "Comp-off credit is no longer pending")
+	case errors.Is(err, ErrLeaveChanged):
+		return status.Error(codes.Aborted, "Leave request was changed in the meantime, reload it and try again")
+	default:
+		return status.Error(codes.Internal, internalMessage)
+	}
+}
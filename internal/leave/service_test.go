@@ -0,0 +1,1135 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type stubRepository struct {
+	Repository
+	employees   map[string]*Employee
+	leaves      map[string]*LeaveRequest
+	rules       []*ApprovalRule
+	delegations []*Delegation
+	cancelled   []string
+	credits     map[string]*CompOffCredit
+	approved    []*DecideCompOffRequest
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{
+		employees: map[string]*Employee{
+			"employee":   {ID: "employee", Status: "ACTIVE"},
+			"terminated": {ID: "terminated", Status: "TERMINATED"},
+		},
+		leaves: make(map[string]*LeaveRequest),
+	}
+}
+
+func (r *stubRepository) GetEmployee(ctx context.Context, id string) (*Employee, error) {
+	if employee, ok := r.employees[id]; ok {
+		return employee, nil
+	}
+	return nil, ErrEmployeeNotFound
+}
+
+func (r *stubRepository) Create(ctx context.Context, leave *LeaveRequest) error {
+	leave.ID = fmt.Sprintf("leave-%d", len(r.leaves)+1)
+	r.leaves[leave.ID] = leave
+	return nil
+}
+
+func (r *stubRepository) FindOverlapping(ctx context.Context, employeeID string, start, end time.Time, excludeID string) ([]*LeaveRequest, error) {
+	var found []*LeaveRequest
+	for _, leave := range r.leaves {
+		if leave.EmployeeID != employeeID || leave.ID == excludeID || leave.StartDate.After(end) || leave.EndDate.Before(start) {
+			continue
+		}
+		if leave.LeaveStatus == "PENDING" || leave.LeaveStatus == "APPROVED" {
+			found = append(found, leave)
+		}
+	}
+	return found, nil
+}
+
+func (r *stubRepository) Update(ctx context.Context, leave *LeaveRequest) error {
+	r.leaves[leave.ID] = leave
+	return nil
+}
+
+func (r *stubRepository) GetByID(ctx context.Context, id string) (*LeaveRequest, error) {
+	if leave, ok := r.leaves[id]; ok {
+		return leave, nil
+	}
+	return nil, ErrLeaveNotFound
+}
+
+func (r *stubRepository) ListApprovalRules(ctx context.Context, leaveType string) ([]*ApprovalRule, error) {
+	return r.rules, nil
+}
+
+func (r *stubRepository) ActiveDelegates(ctx context.Context, delegatorIDs []string, day time.Time) (map[string][]string, error) {
+	delegates := make(map[string][]string)
+	for _, delegation := range r.delegations {
+		if delegation.StartDate.After(day) || delegation.EndDate.Before(day) {
+			continue
+		}
+		delegates[delegation.DelegatorID] = append(delegates[delegation.DelegatorID], delegation.DelegateID)
+	}
+	return delegates, nil
+}
+
+func (r *stubRepository) CreateDelegation(ctx context.Context, delegation *Delegation) error {
+	r.delegations = append(r.delegations, delegation)
+	return nil
+}
+
+// ApproveLeave records the decision on the step and hands the request to the next
+// one like the database repository does, without charging a balance
+func (r *stubRepository) ApproveLeave(ctx context.Context, id string, req *ApproveLeaveRequestRequest) error {
+	leave, ok := r.leaves[id]
+	if !ok {
+		return ErrLeaveNotFound
+	}
+	step := leave.PendingStep()
+	if step == nil || step.StepOrder != req.Step {
+		return ErrStepChanged
+	}
+
+	step.Status = StepApproved
+	step.DecidedBy = &req.ApproverID
+	step.OnBehalfOf = req.OnBehalfOf
+	for _, next := range leave.ApprovalSteps {
+		if next.StepOrder > step.StepOrder && next.Status == StepPending {
+			leave.CurrentStep = next.StepOrder
+			return nil
+		}
+	}
+	leave.LeaveStatus = "APPROVED"
+	leave.CurrentStep = 0
+	return nil
+}
+
+func (r *stubRepository) CancelLeave(ctx context.Context, id string, req *CancelLeaveRequestRequest) error {
+	r.cancelled = append(r.cancelled, id)
+	r.leaves[id].LeaveStatus = "CANCELLED"
+	return nil
+}
+
+func (r *stubRepository) WithdrawLeave(ctx context.Context, withdrawn *LeaveRequest, req *WithdrawLeaveRequestRequest) (float64, error) {
+	leave := r.leaves[withdrawn.ID]
+	restored := RoundDays(leave.DaysRequested - withdrawn.DaysRequested)
+	leave.EndDate = withdrawn.EndDate
+	leave.DaysRequested = withdrawn.DaysRequested
+	leave.WithdrawnDays = RoundDays(leave.WithdrawnDays + restored)
+	return restored, nil
+}
+
+func (r *stubRepository) ListTeamMembers(ctx context.Context, req *TeamCalendarRequest) ([]*Employee, error) {
+	var members []*Employee
+	for _, employee := range r.employees {
+		switch {
+		case req.EmployeeID != "" && employee.ID == req.EmployeeID,
+			req.DepartmentID != "" && employee.DepartmentID != nil && *employee.DepartmentID == req.DepartmentID,
+			req.ManagerID != "" && employee.ManagerID != nil && *employee.ManagerID == req.ManagerID:
+			members = append(members, employee)
+		}
+	}
+	return members, nil
+}
+
+func (r *stubRepository) ListTeamAbsences(ctx context.Context, employeeIDs []string, start, end time.Time) ([]*LeaveRequest, error) {
+	var absences []*LeaveRequest
+	for _, id := range employeeIDs {
+		for _, leave := range r.leaves {
+			if leave.EmployeeID == id && !leave.StartDate.After(end) && !leave.EndDate.Before(start) {
+				absences = append(absences, leave)
+			}
+		}
+	}
+	return absences, nil
+}
+
+func (r *stubRepository) FindCompOffCredit(ctx context.Context, employeeID string, workDate time.Time) (*CompOffCredit, error) {
+	for _, credit := range r.credits {
+		if credit.EmployeeID == employeeID && credit.WorkDate.Equal(workDate) && credit.Status != CreditRejected {
+			return credit, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *stubRepository) CreateCompOffCredit(ctx context.Context, credit *CompOffCredit) error {
+	if r.credits == nil {
+		r.credits = make(map[string]*CompOffCredit)
+	}
+	credit.ID = fmt.Sprintf("credit-%d", len(r.credits)+1)
+	r.credits[credit.ID] = credit
+	return nil
+}
+
+func (r *stubRepository) GetCompOffCredit(ctx context.Context, id string) (*CompOffCredit, error) {
+	if credit, ok := r.credits[id]; ok {
+		return credit, nil
+	}
+	return nil, ErrCompOffNotFound
+}
+
+func (r *stubRepository) ApproveCompOffCredit(ctx context.Context, id string, req *DecideCompOffRequest) (*LeaveBalance, error) {
+	r.approved = append(r.approved, req)
+	r.credits[id].Status = CreditApproved
+	r.credits[id].ExpiresOn = &req.ExpiresOn
+	return &LeaveBalance{EmployeeID: r.credits[id].EmployeeID, LeaveType: LeaveTypeCompOff, TotalDays: r.credits[id].Days}, nil
+}
+
+type stubHolidayRepository struct {
+	holiday.Repository
+	holidays []*holiday.Holiday
+}
+
+func (r *stubHolidayRepository) InRange(ctx context.Context, country, location string, start, end time.Time) ([]*holiday.Holiday, error) {
+	var found []*holiday.Holiday
+	for _, h := range r.holidays {
+		if !h.Date.Before(start) && !h.Date.After(end) {
+			found = append(found, h)
+		}
+	}
+	return found, nil
+}
+
+// newTestService returns a service with 8 hour working days on a calendar
+// with Saturday and Sunday weekends and the given holidays
+func newTestService(repo Repository, holidays ...*holiday.Holiday) Service {
+	return newTestServiceWithPolicy(repo, Policy{WorkingHoursPerDay: 8}, holidays...)
+}
+
+func newTestServiceWithPolicy(repo Repository, policy Policy, holidays ...*holiday.Holiday) Service {
+	calendar := holiday.NewCalendar(&stubHolidayRepository{holidays: holidays}, []time.Weekday{time.Saturday, time.Sunday})
+	log := logger.NewLogger("panic", "text")
+	return NewService(repo, calendar, NewAccrualEngine(repo, log), NewYearEndJob(repo, log), policy, log)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStatusFromError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{name: "leave not found", err: ErrLeaveNotFound, want: codes.NotFound},
+		{name: "employee not found", err: ErrEmployeeNotFound, want: codes.NotFound},
+		{name: "leave not pending", err: ErrLeaveNotPending, want: codes.FailedPrecondition},
+		{name: "balance not found", err: ErrBalanceNotFound, want: codes.FailedPrecondition},
+		{name: "insufficient balance", err: ErrInsufficientBalance, want: codes.FailedPrecondition},
+		{name: "wrapped error", err: fmt.Errorf("failed to approve leave: %w", ErrInsufficientBalance), want: codes.FailedPrecondition},
+		{name: "unknown error", err: errors.New("connection refused"), want: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := statusFromError(tt.err, "Failed to do it")
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("statusFromError() code = %v, want %v", got, tt.want)
+			}
+			if tt.want == codes.Internal && status.Convert(err).Message() != "Failed to do it" {
+				t.Errorf("statusFromError() message = %q, want the internal message", status.Convert(err).Message())
+			}
+		})
+	}
+}
+
+func TestCreateLeaveRequestValidation(t *testing.T) {
+	valid := CreateLeaveRequestRequest{
+		EmployeeID: "employee",
+		LeaveType:  "ANNUAL",
+		StartDate:  date(2025, time.March, 3),
+		EndDate:    date(2025, time.March, 7),
+	}
+
+	tests := []struct {
+		name   string
+		modify func(req *CreateLeaveRequestRequest)
+		want   codes.Code
+	}{
+		{
+			name:   "valid request",
+			modify: func(req *CreateLeaveRequestRequest) {},
+			want:   codes.OK,
+		},
+		{
+			name:   "single day",
+			modify: func(req *CreateLeaveRequestRequest) { req.EndDate = req.StartDate },
+			want:   codes.OK,
+		},
+		{
+			name:   "missing employee",
+			modify: func(req *CreateLeaveRequestRequest) { req.EmployeeID = "" },
+			want:   codes.InvalidArgument,
+		},
+		{
+			name:   "missing leave type",
+			modify: func(req *CreateLeaveRequestRequest) { req.LeaveType = "" },
+			want:   codes.InvalidArgument,
+		},
+		{
+			name:   "missing start date",
+			modify: func(req *CreateLeaveRequestRequest) { req.StartDate = time.Time{} },
+			want:   codes.InvalidArgument,
+		},
+		{
+			name:   "missing end date",
+			modify: func(req *CreateLeaveRequestRequest) { req.EndDate = time.Time{} },
+			want:   codes.InvalidArgument,
+		},
+		{
+			name:   "end before start",
+			modify: func(req *CreateLeaveRequestRequest) { req.EndDate = req.StartDate.AddDate(0, 0, -1) },
+			want:   codes.InvalidArgument,
+		},
+		{
+			name: "spanning the year end",
+			modify: func(req *CreateLeaveRequestRequest) {
+				req.StartDate = date(2025, time.December, 29)
+				req.EndDate = date(2026, time.January, 2)
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:   "unknown employee",
+			modify: func(req *CreateLeaveRequestRequest) { req.EmployeeID = "unknown" },
+			want:   codes.NotFound,
+		},
+		{
+			name:   "terminated employee",
+			modify: func(req *CreateLeaveRequestRequest) { req.EmployeeID = "terminated" },
+			want:   codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			req := valid
+			tt.modify(&req)
+
+			leave, err := newTestService(repo).CreateLeaveRequest(context.Background(), &req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("CreateLeaveRequest() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+
+			if tt.want != codes.OK {
+				if len(repo.leaves) != 0 {
+					t.Error("CreateLeaveRequest() stored an invalid request")
+				}
+				return
+			}
+			if leave.EmployeeID != req.EmployeeID || leave.LeaveStatus != "PENDING" {
+				t.Errorf("CreateLeaveRequest() = %+v, want a pending request of %s", leave, req.EmployeeID)
+			}
+		})
+	}
+}
+
+func TestCreateLeaveRequestWorkingDays(t *testing.T) {
+	holidays := []*holiday.Holiday{{Name: "Spring Holiday", Date: date(2025, time.March, 5)}}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		want     codes.Code
+		days     float64
+		excluded []holiday.ExcludedDate
+	}{
+		{
+			name:     "working week",
+			start:    date(2025, time.March, 10),
+			end:      date(2025, time.March, 14),
+			want:     codes.OK,
+			days:     5,
+			excluded: []holiday.ExcludedDate{},
+		},
+		{
+			name:  "week with a holiday and a weekend",
+			start: date(2025, time.March, 3),
+			end:   date(2025, time.March, 10),
+			want:  codes.OK,
+			days:  5,
+			excluded: []holiday.ExcludedDate{
+				{Date: date(2025, time.March, 5), Reason: holiday.ReasonHoliday, Name: "Spring Holiday"},
+				{Date: date(2025, time.March, 8), Reason: holiday.ReasonWeekend},
+				{Date: date(2025, time.March, 9), Reason: holiday.ReasonWeekend},
+			},
+		},
+		{
+			name:  "weekend only",
+			start: date(2025, time.March, 8),
+			end:   date(2025, time.March, 9),
+			want:  codes.InvalidArgument,
+		},
+		{
+			name:  "holiday only",
+			start: date(2025, time.March, 5),
+			end:   date(2025, time.March, 5),
+			want:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leave, err := newTestService(newStubRepository(), holidays...).CreateLeaveRequest(context.Background(), &CreateLeaveRequestRequest{
+				EmployeeID: "employee",
+				LeaveType:  "ANNUAL",
+				StartDate:  tt.start,
+				EndDate:    tt.end,
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("CreateLeaveRequest() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if leave.DaysRequested != tt.days {
+				t.Errorf("DaysRequested = %v, want %v", leave.DaysRequested, tt.days)
+			}
+			if !reflect.DeepEqual(leave.ExcludedDates, tt.excluded) {
+				t.Errorf("ExcludedDates = %+v, want %+v", leave.ExcludedDates, tt.excluded)
+			}
+		})
+	}
+}
+
+func TestCountWorkingDays(t *testing.T) {
+	holidays := []*holiday.Holiday{{Name: "Spring Holiday", Date: date(2025, time.March, 5)}}
+	svc := newTestServiceWithPolicy(newStubRepository(), Policy{WorkingHoursPerDay: 7.5}, holidays...).(*service)
+	monday := date(2025, time.March, 3)
+	saturday := date(2025, time.March, 8)
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		duration string
+		hours    float64
+		want     codes.Code
+		days     float64
+	}{
+		{name: "full days", start: monday, end: monday.AddDate(0, 0, 4), duration: DurationFullDay, want: codes.OK, days: 4},
+		{name: "morning half day", start: monday, end: monday, duration: DurationHalfDayAM, want: codes.OK, days: 0.5},
+		{name: "afternoon half day", start: monday, end: monday, duration: DurationHalfDayPM, want: codes.OK, days: 0.5},
+		{name: "hours dividing the day evenly", start: monday, end: monday, duration: DurationHourly, hours: 3, want: codes.OK, days: 0.4},
+		{name: "hours rounded to 4 decimals", start: monday, end: monday, duration: DurationHourly, hours: 1, want: codes.OK, days: 0.1333},
+		{name: "hours rounded up", start: monday, end: monday, duration: DurationHourly, hours: 2, want: codes.OK, days: 0.2667},
+		{name: "whole working day in hours", start: monday, end: monday, duration: DurationHourly, hours: 7, want: codes.OK, days: 0.9333},
+		{name: "zero hours", start: monday, end: monday, duration: DurationHourly, hours: 0, want: codes.InvalidArgument},
+		{name: "negative hours", start: monday, end: monday, duration: DurationHourly, hours: -2, want: codes.InvalidArgument},
+		{name: "partial hours", start: monday, end: monday, duration: DurationHourly, hours: 1.5, want: codes.InvalidArgument},
+		{name: "more hours than a working day", start: monday, end: monday, duration: DurationHourly, hours: 8, want: codes.InvalidArgument},
+		{name: "half day over two dates", start: monday, end: monday.AddDate(0, 0, 1), duration: DurationHalfDayAM, want: codes.InvalidArgument},
+		{name: "half day on a weekend", start: saturday, end: saturday, duration: DurationHalfDayPM, want: codes.InvalidArgument},
+		{name: "hours on a holiday", start: date(2025, time.March, 5), end: date(2025, time.March, 5), duration: DurationHourly, hours: 2, want: codes.InvalidArgument},
+		{name: "unknown duration", start: monday, end: monday, duration: "QUARTER_DAY", want: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leave := FromCreateRequest(&CreateLeaveRequestRequest{
+				EmployeeID: "employee",
+				LeaveType:  "ANNUAL",
+				StartDate:  tt.start,
+				EndDate:    tt.end,
+				Duration:   tt.duration,
+				Hours:      tt.hours,
+			})
+
+			err := svc.countWorkingDays(context.Background(), leave, &Employee{ID: "employee"})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("countWorkingDays() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if err == nil && leave.DaysRequested != tt.days {
+				t.Errorf("DaysRequested = %v, want %v", leave.DaysRequested, tt.days)
+			}
+		})
+	}
+}
+
+func TestUpdateLeaveRequestAcrossYearEnd(t *testing.T) {
+	tests := []struct {
+		name string
+		req  UpdateLeaveRequestRequest
+		want codes.Code
+	}{
+		{
+			name: "moved within the year",
+			req:  UpdateLeaveRequestRequest{StartDate: date(2025, time.December, 22), EndDate: date(2025, time.December, 24)},
+			want: codes.OK,
+		},
+		{
+			name: "end moved into next year",
+			req:  UpdateLeaveRequestRequest{EndDate: date(2026, time.January, 2)},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "moved over the year end",
+			req:  UpdateLeaveRequestRequest{StartDate: date(2025, time.December, 31), EndDate: date(2026, time.January, 2)},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			repo.leaves["leave"] = &LeaveRequest{
+				ID:            "leave",
+				EmployeeID:    "employee",
+				LeaveType:     "ANNUAL",
+				StartDate:     date(2025, time.December, 29),
+				EndDate:       date(2025, time.December, 31),
+				DaysRequested: 3,
+				Duration:      DurationFullDay,
+				LeaveStatus:   "PENDING",
+			}
+
+			_, err := newTestService(repo).UpdateLeaveRequest(context.Background(), "leave", &tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("UpdateLeaveRequest() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+// pendingChain returns the leave of "employee" awaiting step current of a
+// manager, department manager and HR chain
+func pendingChain(current int) *LeaveRequest {
+	leave := &LeaveRequest{
+		ID:          "leave-1",
+		EmployeeID:  "employee",
+		LeaveStatus: "PENDING",
+		CurrentStep: current,
+		ApprovalSteps: []*ApprovalStep{
+			{StepOrder: 1, ApproverType: ApproverManager, ApproverID: ptr("manager"), Status: StepPending},
+			{StepOrder: 2, ApproverType: ApproverDepartmentManager, ApproverID: ptr("head"), Status: StepPending},
+			{StepOrder: 3, ApproverType: ApproverHR, Status: StepPending},
+		},
+	}
+	for _, step := range leave.ApprovalSteps {
+		if step.StepOrder < current {
+			step.Status = StepApproved
+		}
+	}
+	return leave
+}
+
+func TestAuthorizeStep(t *testing.T) {
+	today := holiday.DateOf(time.Now())
+	repo := newStubRepository()
+	repo.delegations = []*Delegation{
+		{DelegatorID: "manager", DelegateID: "deputy", StartDate: today.AddDate(0, 0, -1), EndDate: today.AddDate(0, 0, 1)},
+		{DelegatorID: "manager", DelegateID: "former-deputy", StartDate: today.AddDate(0, 0, -10), EndDate: today.AddDate(0, 0, -1)},
+		{DelegatorID: "manager", DelegateID: "next-deputy", StartDate: today.AddDate(0, 0, 1), EndDate: today.AddDate(0, 0, 10)},
+	}
+	svc := newTestService(repo).(*service)
+
+	tests := []struct {
+		name           string
+		leave          *LeaveRequest
+		approverID     string
+		approverRole   string
+		want           codes.Code
+		wantOnBehalfOf string
+	}{
+		{name: "manager on the manager step", leave: pendingChain(1), approverID: "manager", approverRole: auth.RoleManager, want: codes.OK},
+		{name: "department manager on the manager step", leave: pendingChain(1), approverID: "head", approverRole: auth.RoleManager, want: codes.PermissionDenied},
+		{name: "department manager on their step", leave: pendingChain(2), approverID: "head", approverRole: auth.RoleManager, want: codes.OK},
+		{name: "manager on a later step", leave: pendingChain(2), approverID: "manager", approverRole: auth.RoleManager, want: codes.PermissionDenied},
+		{name: "stranger", leave: pendingChain(1), approverID: "stranger", approverRole: auth.RoleManager, want: codes.PermissionDenied},
+		{name: "own leave", leave: pendingChain(1), approverID: "employee", approverRole: auth.RoleAdmin, want: codes.PermissionDenied},
+		{name: "admin on any step", leave: pendingChain(1), approverID: "admin", approverRole: auth.RoleAdmin, want: codes.OK},
+		{name: "HR on the manager step", leave: pendingChain(1), approverID: "hr", approverRole: auth.RoleHR, want: codes.PermissionDenied},
+		{name: "HR on the HR step", leave: pendingChain(3), approverID: "hr", approverRole: auth.RoleHR, want: codes.OK},
+		{name: "manager on the HR step", leave: pendingChain(3), approverID: "manager", approverRole: auth.RoleManager, want: codes.PermissionDenied},
+		{name: "active delegate", leave: pendingChain(1), approverID: "deputy", approverRole: auth.RoleEmployee, want: codes.OK, wantOnBehalfOf: "manager"},
+		{name: "expired delegate", leave: pendingChain(1), approverID: "former-deputy", approverRole: auth.RoleEmployee, want: codes.PermissionDenied},
+		{name: "delegate before the delegation starts", leave: pendingChain(1), approverID: "next-deputy", approverRole: auth.RoleEmployee, want: codes.PermissionDenied},
+		{name: "delegate of another step", leave: pendingChain(2), approverID: "deputy", approverRole: auth.RoleEmployee, want: codes.PermissionDenied},
+		{name: "decided request", leave: pendingChain(0), approverID: "admin", approverRole: auth.RoleAdmin, want: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			onBehalfOf, err := svc.authorizeStep(context.Background(), tt.leave, tt.approverID, tt.approverRole)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("authorizeStep() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+
+			got := ""
+			if onBehalfOf != nil {
+				got = *onBehalfOf
+			}
+			if got != tt.wantOnBehalfOf {
+				t.Errorf("authorizeStep() on behalf of = %q, want %q", got, tt.wantOnBehalfOf)
+			}
+		})
+	}
+}
+
+func TestApproveLeaveRequestChain(t *testing.T) {
+	today := holiday.DateOf(time.Now())
+	repo := newStubRepository()
+	repo.employees["employee"].ManagerID = ptr("manager")
+	repo.employees["employee"].DepartmentManagerID = ptr("head")
+	repo.rules = []*ApprovalRule{
+		{StepOrder: 1, ApproverType: ApproverManager},
+		{StepOrder: 2, ApproverType: ApproverDepartmentManager},
+		{StepOrder: 3, ApproverType: ApproverHR, MinDays: 10},
+	}
+	repo.delegations = []*Delegation{
+		{DelegatorID: "head", DelegateID: "deputy", StartDate: today, EndDate: today},
+	}
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	// Mar 2 to Mar 17 2026 are 12 working days, above the HR threshold
+	leave, err := svc.CreateLeaveRequest(ctx, &CreateLeaveRequestRequest{
+		EmployeeID: "employee",
+		LeaveType:  "ANNUAL",
+		StartDate:  date(2026, time.March, 2),
+		EndDate:    date(2026, time.March, 17),
+	})
+	if err != nil {
+		t.Fatalf("CreateLeaveRequest() error = %v", err)
+	}
+	if want := []string{"1 MANAGER manager", "2 DEPARTMENT_MANAGER head", "3 HR "}; !reflect.DeepEqual(chainSteps(leave.ApprovalSteps), want) {
+		t.Fatalf("approval steps = %q, want %q", chainSteps(leave.ApprovalSteps), want)
+	}
+
+	decisions := []struct {
+		approverID   string
+		approverRole string
+		want         codes.Code
+		wantStep     int
+	}{
+		{approverID: "head", approverRole: auth.RoleManager, want: codes.PermissionDenied, wantStep: 1},
+		{approverID: "manager", approverRole: auth.RoleManager, want: codes.OK, wantStep: 2},
+		{approverID: "manager", approverRole: auth.RoleManager, want: codes.PermissionDenied, wantStep: 2},
+		{approverID: "deputy", approverRole: auth.RoleEmployee, want: codes.OK, wantStep: 3},
+		{approverID: "head", approverRole: auth.RoleManager, want: codes.PermissionDenied, wantStep: 3},
+		{approverID: "hr", approverRole: auth.RoleHR, want: codes.OK, wantStep: 0},
+		{approverID: "hr", approverRole: auth.RoleHR, want: codes.FailedPrecondition, wantStep: 0},
+	}
+	for _, d := range decisions {
+		_, err := svc.ApproveLeaveRequest(ctx, leave.ID, &ApproveLeaveRequestRequest{ApproverID: d.approverID, ApproverRole: d.approverRole})
+		if got := status.Code(err); got != d.want {
+			t.Fatalf("ApproveLeaveRequest() by %s code = %v, want %v (error %v)", d.approverID, got, d.want, err)
+		}
+		if got := repo.leaves[leave.ID].CurrentStep; got != d.wantStep {
+			t.Fatalf("current step after %s = %d, want %d", d.approverID, got, d.wantStep)
+		}
+	}
+
+	if got := repo.leaves[leave.ID].LeaveStatus; got != "APPROVED" {
+		t.Errorf("status = %s, want APPROVED", got)
+	}
+
+	history := []struct {
+		decidedBy  string
+		onBehalfOf string
+	}{
+		{decidedBy: "manager"},
+		{decidedBy: "deputy", onBehalfOf: "head"},
+		{decidedBy: "hr"},
+	}
+	for i, step := range repo.leaves[leave.ID].ApprovalSteps {
+		onBehalfOf := ""
+		if step.OnBehalfOf != nil {
+			onBehalfOf = *step.OnBehalfOf
+		}
+		if step.Status != StepApproved || step.DecidedBy == nil || *step.DecidedBy != history[i].decidedBy || onBehalfOf != history[i].onBehalfOf {
+			t.Errorf("step %d = %s by %v on behalf of %q, want APPROVED by %s on behalf of %q",
+				step.StepOrder, step.Status, step.DecidedBy, onBehalfOf, history[i].decidedBy, history[i].onBehalfOf)
+		}
+	}
+}
+
+func TestCreateApprovalDelegation(t *testing.T) {
+	year := time.Now().Year()
+
+	tests := []struct {
+		name string
+		req  *CreateDelegationRequest
+		want codes.Code
+	}{
+		{
+			name: "within the year",
+			req:  &CreateDelegationRequest{DelegatorID: "manager", DelegateID: "deputy", StartDate: date(year+1, time.December, 1), EndDate: date(year+1, time.December, 12)},
+			want: codes.OK,
+		},
+		{
+			name: "across the year end",
+			req:  &CreateDelegationRequest{DelegatorID: "manager", DelegateID: "deputy", StartDate: date(year, time.December, 20), EndDate: date(year+1, time.January, 10)},
+			want: codes.OK,
+		},
+		{
+			name: "ended in the past",
+			req:  &CreateDelegationRequest{DelegatorID: "manager", DelegateID: "deputy", StartDate: date(year-1, time.March, 2), EndDate: date(year-1, time.March, 6)},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "end before start",
+			req:  &CreateDelegationRequest{DelegatorID: "manager", DelegateID: "deputy", StartDate: date(year+1, time.March, 6), EndDate: date(year+1, time.March, 2)},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "to yourself",
+			req:  &CreateDelegationRequest{DelegatorID: "manager", DelegateID: "manager", StartDate: date(year+1, time.March, 2), EndDate: date(year+1, time.March, 6)},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "to an employee who cannot approve",
+			req:  &CreateDelegationRequest{DelegatorID: "manager", DelegateID: "employee", StartDate: date(year+1, time.March, 2), EndDate: date(year+1, time.March, 6)},
+			want: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			repo.employees["manager"] = &Employee{ID: "manager", Status: "ACTIVE", Role: auth.RoleManager}
+			repo.employees["deputy"] = &Employee{ID: "deputy", Status: "ACTIVE", Role: auth.RoleManager}
+
+			_, err := newTestService(repo).CreateApprovalDelegation(context.Background(), tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("CreateApprovalDelegation() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if saved := len(repo.delegations) == 1; saved != (tt.want == codes.OK) {
+				t.Errorf("delegation saved = %v, want %v", saved, tt.want == codes.OK)
+			}
+		})
+	}
+}
+
+func TestCancelLeaveRequest(t *testing.T) {
+	today := holiday.DateOf(time.Now())
+	valid := CancelLeaveRequestRequest{CancelledBy: "employee", Reason: "plans changed"}
+
+	tests := []struct {
+		name   string
+		start  time.Time
+		status string
+		modify func(req *CancelLeaveRequestRequest)
+		want   codes.Code
+	}{
+		{name: "approved leave starting tomorrow", start: today.AddDate(0, 0, 1), status: "APPROVED", want: codes.OK},
+		{name: "pending leave starting tomorrow", start: today.AddDate(0, 0, 1), status: "PENDING", want: codes.OK},
+		{name: "leave starting today", start: today, status: "APPROVED", want: codes.FailedPrecondition},
+		{name: "leave in progress", start: today.AddDate(0, 0, -2), status: "APPROVED", want: codes.FailedPrecondition},
+		{
+			name:   "missing reason",
+			start:  today.AddDate(0, 0, 1),
+			status: "APPROVED",
+			modify: func(req *CancelLeaveRequestRequest) { req.Reason = "" },
+			want:   codes.InvalidArgument,
+		},
+		{
+			name:   "missing canceller",
+			start:  today.AddDate(0, 0, 1),
+			status: "APPROVED",
+			modify: func(req *CancelLeaveRequestRequest) { req.CancelledBy = "" },
+			want:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			repo.leaves["leave-1"] = &LeaveRequest{
+				ID:            "leave-1",
+				EmployeeID:    "employee",
+				StartDate:     tt.start,
+				EndDate:       tt.start.AddDate(0, 0, 2),
+				DaysRequested: 3,
+				LeaveStatus:   tt.status,
+			}
+
+			req := valid
+			if tt.modify != nil {
+				tt.modify(&req)
+			}
+
+			_, err := newTestService(repo).CancelLeaveRequest(context.Background(), "leave-1", &req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("CancelLeaveRequest() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if cancelled := len(repo.cancelled) == 1; cancelled != (tt.want == codes.OK) {
+				t.Errorf("leave cancelled = %v, want %v", cancelled, tt.want == codes.OK)
+			}
+		})
+	}
+}
+
+func TestWithdrawLeaveRequest(t *testing.T) {
+	today := holiday.DateOf(time.Now())
+	valid := WithdrawLeaveRequestRequest{WithdrawnBy: "employee", Reason: "back early"}
+
+	tests := []struct {
+		name         string
+		start        time.Time
+		end          time.Time
+		status       string
+		lastDay      time.Time
+		want         codes.Code
+		wantRestored float64
+	}{
+		{
+			name:         "back tomorrow from leave in progress",
+			start:        today.AddDate(0, 0, -2),
+			end:          today.AddDate(0, 0, 4),
+			status:       "APPROVED",
+			lastDay:      today,
+			want:         codes.OK,
+			wantRestored: 4,
+		},
+		{
+			name:         "back today",
+			start:        today.AddDate(0, 0, -2),
+			end:          today.AddDate(0, 0, 4),
+			status:       "APPROVED",
+			lastDay:      today.AddDate(0, 0, -1),
+			want:         codes.OK,
+			wantRestored: 5,
+		},
+		{
+			name:    "giving back days already taken",
+			start:   today.AddDate(0, 0, -4),
+			end:     today.AddDate(0, 0, 4),
+			status:  "APPROVED",
+			lastDay: today.AddDate(0, 0, -3),
+			want:    codes.InvalidArgument,
+		},
+		{
+			name:    "last day at the end",
+			start:   today.AddDate(0, 0, -2),
+			end:     today.AddDate(0, 0, 4),
+			status:  "APPROVED",
+			lastDay: today.AddDate(0, 0, 4),
+			want:    codes.InvalidArgument,
+		},
+		{
+			name:    "leave not started",
+			start:   today.AddDate(0, 0, 1),
+			end:     today.AddDate(0, 0, 4),
+			status:  "APPROVED",
+			lastDay: today.AddDate(0, 0, 2),
+			want:    codes.FailedPrecondition,
+		},
+		{
+			name:    "leave over",
+			start:   today.AddDate(0, 0, -4),
+			end:     today.AddDate(0, 0, -1),
+			status:  "APPROVED",
+			lastDay: today.AddDate(0, 0, -2),
+			want:    codes.FailedPrecondition,
+		},
+		{
+			name:    "pending leave",
+			start:   today.AddDate(0, 0, -2),
+			end:     today.AddDate(0, 0, 4),
+			status:  "PENDING",
+			lastDay: today,
+			want:    codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			repo.leaves["leave-1"] = &LeaveRequest{
+				ID:            "leave-1",
+				EmployeeID:    "employee",
+				StartDate:     tt.start,
+				EndDate:       tt.end,
+				Duration:      DurationFullDay,
+				DaysRequested: tt.end.Sub(tt.start).Hours()/24 + 1,
+				LeaveStatus:   tt.status,
+			}
+
+			// Every day is a working day, so the days do not depend on the weekday the test runs on
+			log := logger.NewLogger("panic", "text")
+			calendar := holiday.NewCalendar(&stubHolidayRepository{}, nil)
+			svc := NewService(repo, calendar, NewAccrualEngine(repo, log), NewYearEndJob(repo, log), Policy{WorkingHoursPerDay: 8}, log)
+
+			req := valid
+			req.LastDay = tt.lastDay
+			resp, err := svc.WithdrawLeaveRequest(context.Background(), "leave-1", &req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("WithdrawLeaveRequest() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if tt.want != codes.OK {
+				return
+			}
+
+			if resp.RestoredDays != tt.wantRestored {
+				t.Errorf("RestoredDays = %v, want %v", resp.RestoredDays, tt.wantRestored)
+			}
+			if !resp.LeaveRequest.EndDate.Equal(tt.lastDay) {
+				t.Errorf("EndDate = %v, want the last day %v", resp.LeaveRequest.EndDate, tt.lastDay)
+			}
+		})
+	}
+}
+
+func TestGetTeamCalendar(t *testing.T) {
+	newRepo := func() *stubRepository {
+		repo := newStubRepository()
+		repo.employees["manager"] = &Employee{ID: "manager", Status: "ACTIVE", DepartmentID: ptr("sales")}
+		repo.employees["report"] = &Employee{ID: "report", Status: "ACTIVE", DepartmentID: ptr("sales"), ManagerID: ptr("manager")}
+		repo.leaves["manager-leave"] = &LeaveRequest{
+			ID:          "manager-leave",
+			EmployeeID:  "manager",
+			StartDate:   date(2026, time.March, 2),
+			EndDate:     date(2026, time.March, 3),
+			Reason:      "moving house",
+			LeaveStatus: "APPROVED",
+		}
+		repo.leaves["report-leave"] = &LeaveRequest{
+			ID:            "report-leave",
+			EmployeeID:    "report",
+			StartDate:     date(2026, time.March, 4),
+			EndDate:       date(2026, time.March, 4),
+			Reason:        "doctor's appointment",
+			Comments:      "get well",
+			LeaveStatus:   "APPROVED",
+			ApprovalSteps: []*ApprovalStep{{StepOrder: 1, Status: StepApproved, Comments: "hope it is nothing serious"}},
+		}
+		return repo
+	}
+
+	tests := []struct {
+		name        string
+		req         TeamCalendarRequest
+		want        codes.Code
+		wantReasons map[string]string
+	}{
+		{
+			name:        "department of the caller",
+			req:         TeamCalendarRequest{DepartmentID: "sales", CallerID: "manager"},
+			want:        codes.OK,
+			wantReasons: map[string]string{"manager-leave": "moving house", "report-leave": ""},
+		},
+		{
+			name:        "reports of the caller",
+			req:         TeamCalendarRequest{ManagerID: "manager", CallerID: "manager"},
+			want:        codes.OK,
+			wantReasons: map[string]string{"report-leave": ""},
+		},
+		{
+			name:        "calendar of a colleague",
+			req:         TeamCalendarRequest{DepartmentID: "sales", CallerID: "report"},
+			want:        codes.OK,
+			wantReasons: map[string]string{"manager-leave": "", "report-leave": "doctor's appointment"},
+		},
+		{
+			name: "neither department nor manager",
+			req:  TeamCalendarRequest{CallerID: "manager"},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "both department and manager",
+			req:  TeamCalendarRequest{DepartmentID: "sales", ManagerID: "manager", CallerID: "manager"},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			req.StartDate = date(2026, time.March, 1)
+			req.EndDate = date(2026, time.March, 31)
+
+			calendar, err := newTestService(newRepo()).GetTeamCalendar(context.Background(), &req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("GetTeamCalendar() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if tt.want != codes.OK {
+				return
+			}
+
+			reasons := make(map[string]string)
+			for _, absence := range calendar.Absences {
+				reasons[absence.ID] = absence.Reason
+				if absence.EmployeeID == req.CallerID {
+					continue
+				}
+				if absence.Comments != "" {
+					t.Errorf("absence %s comments = %q, want them hidden", absence.ID, absence.Comments)
+				}
+				for _, step := range absence.ApprovalSteps {
+					if step.Comments != "" {
+						t.Errorf("absence %s step %d comments = %q, want them hidden", absence.ID, step.StepOrder, step.Comments)
+					}
+				}
+			}
+			if !reflect.DeepEqual(reasons, tt.wantReasons) {
+				t.Errorf("absence reasons = %q, want %q", reasons, tt.wantReasons)
+			}
+		})
+	}
+}
+
+// lastWeekday returns the latest day on or before day that falls on weekday
+func TestExportLeaveCalendarRange(t *testing.T) {
+	year := time.Now().Year()
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  codes.Code
+	}{
+		{name: "this year and the next by default", want: codes.OK},
+		{name: "across the year end", start: date(year, time.December, 1), end: date(year+1, time.January, 31), want: codes.OK},
+		{name: "two full years", start: date(year, time.January, 1), end: date(year+2, time.January, 1), want: codes.OK},
+		{name: "more than two years", start: date(year, time.January, 1), end: date(year+2, time.January, 2), want: codes.InvalidArgument},
+		{name: "end before start", start: date(year, time.March, 6), end: date(year, time.March, 2), want: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			repo.leaves["employee-leave"] = &LeaveRequest{
+				ID:          "employee-leave",
+				EmployeeID:  "employee",
+				LeaveType:   "ANNUAL",
+				StartDate:   date(year, time.December, 29),
+				EndDate:     date(year, time.December, 31),
+				LeaveStatus: "APPROVED",
+			}
+
+			req := &TeamCalendarRequest{EmployeeID: "employee", CallerID: "employee", StartDate: tt.start, EndDate: tt.end}
+			export, err := newTestService(repo).ExportLeaveCalendar(context.Background(), req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("ExportLeaveCalendar() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if tt.want == codes.OK && !strings.Contains(string(export.Content), "UID:leave-employee-leave@") {
+				t.Errorf("export = %q, want the leave at the end of the year", export.Content)
+			}
+		})
+	}
+}
+
+func lastWeekday(day time.Time, weekday time.Weekday) time.Time {
+	return day.AddDate(0, 0, -int((day.Weekday()-weekday+7)%7))
+}
+
+func TestRequestCompOffCredit(t *testing.T) {
+	today := holiday.DateOf(time.Now())
+	saturday := lastWeekday(today, time.Saturday)
+	wednesday := lastWeekday(today, time.Wednesday)
+	holidays := []*holiday.Holiday{{ID: "holiday", Name: "Founders Day", Date: lastWeekday(today, time.Tuesday)}}
+	valid := RequestCompOffRequest{EmployeeID: "employee", WorkDate: saturday, Days: 1, Reason: "release weekend", RequestedBy: "manager"}
+
+	tests := []struct {
+		name   string
+		modify func(req *RequestCompOffRequest)
+		want   codes.Code
+	}{
+		{name: "weekend worked", want: codes.OK},
+		{name: "holiday worked", modify: func(req *RequestCompOffRequest) { req.WorkDate = holidays[0].Date }, want: codes.OK},
+		{name: "half day worked", modify: func(req *RequestCompOffRequest) { req.Days = 0.5 }, want: codes.OK},
+		{name: "working day", modify: func(req *RequestCompOffRequest) { req.WorkDate = wednesday }, want: codes.FailedPrecondition},
+		{name: "day not worked yet", modify: func(req *RequestCompOffRequest) { req.WorkDate = saturday.AddDate(0, 0, 7) }, want: codes.InvalidArgument},
+		{name: "outside the expiry window", modify: func(req *RequestCompOffRequest) { req.WorkDate = saturday.AddDate(0, 0, -35) }, want: codes.FailedPrecondition},
+		{name: "already requested", modify: func(req *RequestCompOffRequest) { req.WorkDate = saturday.AddDate(0, 0, -7) }, want: codes.AlreadyExists},
+		{name: "for yourself", modify: func(req *RequestCompOffRequest) { req.RequestedBy = "employee" }, want: codes.InvalidArgument},
+		{name: "quarter day", modify: func(req *RequestCompOffRequest) { req.Days = 0.25 }, want: codes.InvalidArgument},
+		{name: "missing reason", modify: func(req *RequestCompOffRequest) { req.Reason = "" }, want: codes.InvalidArgument},
+		{name: "terminated employee", modify: func(req *RequestCompOffRequest) { req.EmployeeID = "terminated" }, want: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			repo.credits = map[string]*CompOffCredit{
+				"credit-1": {ID: "credit-1", EmployeeID: "employee", WorkDate: saturday.AddDate(0, 0, -7), Days: 1, Status: CreditPending},
+			}
+			svc := newTestServiceWithPolicy(repo, Policy{WorkingHoursPerDay: 8, CompOffExpiryDays: 30}, holidays...)
+
+			req := valid
+			if tt.modify != nil {
+				tt.modify(&req)
+			}
+
+			credit, err := svc.RequestCompOffCredit(context.Background(), &req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("RequestCompOffCredit() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if tt.want != codes.OK {
+				return
+			}
+			if credit.Status != CreditPending || !credit.WorkDate.Equal(holiday.DateOf(req.WorkDate)) || credit.Days != req.Days {
+				t.Errorf("credit = %s for %v days on %v, want PENDING for %v days on %v", credit.Status, credit.Days, credit.WorkDate, req.Days, req.WorkDate)
+			}
+		})
+	}
+}
+
+func TestApproveCompOffCredit(t *testing.T) {
+	today := holiday.DateOf(time.Now())
+
+	tests := []struct {
+		name       string
+		workDate   time.Time
+		approverID string
+		status     string
+		want       codes.Code
+	}{
+		{name: "within the expiry window", workDate: today.AddDate(0, 0, -10), approverID: "hr", status: CreditPending, want: codes.OK},
+		{name: "expired before approval", workDate: today.AddDate(0, 0, -30), approverID: "hr", status: CreditPending, want: codes.FailedPrecondition},
+		{name: "approved by the employee", workDate: today.AddDate(0, 0, -10), approverID: "employee", status: CreditPending, want: codes.PermissionDenied},
+		{name: "approved by the requester", workDate: today.AddDate(0, 0, -10), approverID: "manager", status: CreditPending, want: codes.PermissionDenied},
+		{name: "already rejected", workDate: today.AddDate(0, 0, -10), approverID: "hr", status: CreditRejected, want: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository()
+			repo.credits = map[string]*CompOffCredit{
+				"credit-1": {ID: "credit-1", EmployeeID: "employee", WorkDate: tt.workDate, Days: 1, Status: tt.status, RequestedBy: ptr("manager")},
+			}
+			svc := newTestServiceWithPolicy(repo, Policy{WorkingHoursPerDay: 8, CompOffExpiryDays: 30})
+
+			resp, err := svc.ApproveCompOffCredit(context.Background(), "credit-1", &DecideCompOffRequest{ApproverID: tt.approverID})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("ApproveCompOffCredit() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if approved := len(repo.approved) == 1; approved != (tt.want == codes.OK) {
+				t.Fatalf("credit approved = %v, want %v", approved, tt.want == codes.OK)
+			}
+			if tt.want != codes.OK {
+				return
+			}
+
+			if expiresOn := tt.workDate.AddDate(0, 0, 30); !repo.approved[0].ExpiresOn.Equal(expiresOn) {
+				t.Errorf("ExpiresOn = %v, want 30 days after the day worked %v", repo.approved[0].ExpiresOn, expiresOn)
+			}
+			if resp.LeaveBalance.TotalDays != 1 {
+				t.Errorf("balance total = %v, want the credited day", resp.LeaveBalance.TotalDays)
+			}
+		})
+	}
+}
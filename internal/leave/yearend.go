@@ -0,0 +1,165 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+)
+
+var ErrYearNotOver = errors.New("cannot close a leave year before it is over")
+
+// YearEndJob closes leave years and lapses carried days once they expire.
+// Closing a balance moves the unused days the policy allows into the next
+// year and marks it closed, so every balance is carried forward only once.
+type YearEndJob struct {
+	repo   Repository
+	logger *logger.Logger
+}
+
+func NewYearEndJob(repo Repository, logger *logger.Logger) *YearEndJob {
+	return &YearEndJob{
+		repo:   repo,
+		logger: logger.ServiceLogger("leave_year_end"),
+	}
+}
+
+// Close carries the open balances of req.Year forward into the next year. The
+// previous year is closed when no year is given, the current year can only be
+// previewed with a dry run.
+func (j *YearEndJob) Close(ctx context.Context, req *CloseYearRequest, now time.Time) (*CloseYearResponse, error) {
+	today := holiday.DateOf(now)
+	if req.Year == 0 {
+		req.Year = today.Year() - 1
+	}
+	if req.Year > today.Year() || (req.Year == today.Year() && !req.DryRun) {
+		return nil, ErrYearNotOver
+	}
+
+	policies, err := j.repo.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byType := make(map[string]*LeavePolicy, len(policies))
+	for _, policy := range policies {
+		byType[policy.LeaveType] = policy
+	}
+
+	balances, err := j.repo.ListOpenBalances(ctx, req.Year)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseYearResponse{
+		CarryForwards: []*CarryForward{},
+		Year:          req.Year,
+		DryRun:        req.DryRun,
+	}
+
+	for _, balance := range balances {
+		// Leave types without a policy keep nothing
+		policy, ok := byType[balance.LeaveType]
+		if !ok {
+			policy = &LeavePolicy{LeaveType: balance.LeaveType}
+		}
+
+		var carryForward *CarryForward
+		if req.DryRun {
+			carryForward = policy.CarryForward(balance)
+		} else {
+			carryForward, err = j.repo.CloseBalance(ctx, balance.ID, policy)
+			if err != nil {
+				j.logger.Error("Failed to close leave balance", "employee_id", balance.EmployeeID, "leave_type", balance.LeaveType, "year", req.Year, "error", err)
+				response.BalancesFailed++
+				continue
+			}
+		}
+
+		response.BalancesClosed++
+		response.TotalCarriedDays = RoundDays(response.TotalCarriedDays + carryForward.CarriedDays)
+		response.TotalForfeitedDays = RoundDays(response.TotalForfeitedDays + carryForward.ForfeitedDays)
+		response.CarryForwards = append(response.CarryForwards, carryForward)
+	}
+
+	j.logger.Info("Leave year close finished",
+		"year", req.Year,
+		"dry_run", req.DryRun,
+		"balances", response.BalancesClosed,
+		"failed", response.BalancesFailed,
+		"carried_days", response.TotalCarriedDays,
+		"forfeited_days", response.TotalForfeitedDays,
+	)
+	return response, nil
+}
+
+// Expire lapses the unused carried days of every balance whose carry expired by now
+// and the unused days of the comp-off credits that expired by now
+func (j *YearEndJob) Expire(ctx context.Context, now time.Time) error {
+	expired, err := j.repo.ExpireCarriedDays(ctx, holiday.DateOf(now))
+	if err != nil {
+		return err
+	}
+	if expired > 0 {
+		j.logger.Info("Carried leave expired", "balances", expired)
+	}
+
+	credits, err := j.repo.ExpireCompOffCredits(ctx, holiday.DateOf(now))
+	if credits > 0 {
+		j.logger.Info("Comp-off credits expired", "credits", credits)
+	}
+	return err
+}
+
+// Schedule expires carried days and closes the previous year right away and then
+// every interval until ctx is done. Expiry runs first so days carried into the
+// closed year that lapse with it are not carried again.
+func (j *YearEndJob) Schedule(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		if err := j.Expire(ctx, now); err != nil {
+			j.logger.Error("Scheduled carried leave expiry failed", "error", err)
+		}
+		if _, err := j.Close(ctx, &CloseYearRequest{}, now); err != nil {
+			j.logger.Error("Scheduled leave year close failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CarryForward splits the unused days of a balance into the days moved to the
+// next year, up to the carry-forward cap, and the days forfeited
+func (p *LeavePolicy) CarryForward(balance *LeaveBalance) *CarryForward {
+	unused := balance.GetRemainingDays()
+	carried := min(unused, p.CarryForwardMaxDays)
+	if carried < 0 {
+		carried = 0
+	}
+
+	carryForward := &CarryForward{
+		EmployeeID:    balance.EmployeeID,
+		LeaveType:     balance.LeaveType,
+		Year:          balance.Year,
+		UnusedDays:    unused,
+		CarriedDays:   RoundDays(carried),
+		ForfeitedDays: RoundDays(unused - carried),
+	}
+	if carried > 0 && p.CarryForwardExpiryMonths > 0 {
+		expiresOn := time.Date(balance.Year+1, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, p.CarryForwardExpiryMonths, 0)
+		carryForward.ExpiresOn = &expiresOn
+	}
+	return carryForward
+}
@@ -0,0 +1,98 @@
+package leave
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLeavePolicyCarryForward(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    *LeavePolicy
+		balance   *LeaveBalance
+		unused    float64
+		carried   float64
+		forfeited float64
+		expiresOn *time.Time
+	}{
+		{
+			name:      "unused days above the cap are forfeited",
+			policy:    &LeavePolicy{CarryForwardMaxDays: 10},
+			balance:   &LeaveBalance{Year: 2026, TotalDays: 20, UsedDays: 5},
+			unused:    15,
+			carried:   10,
+			forfeited: 5,
+		},
+		{
+			name:    "unused days below the cap are all carried",
+			policy:  &LeavePolicy{CarryForwardMaxDays: 10},
+			balance: &LeaveBalance{Year: 2026, TotalDays: 8},
+			unused:  8,
+			carried: 8,
+		},
+		{
+			name:      "carried days lapse months into the next year",
+			policy:    &LeavePolicy{CarryForwardMaxDays: 10, CarryForwardExpiryMonths: 3},
+			balance:   &LeaveBalance{Year: 2026, TotalDays: 12},
+			unused:    12,
+			carried:   10,
+			forfeited: 2,
+			expiresOn: ptr(date(2027, time.April, 1)),
+		},
+		{
+			name:      "a twelve month expiry ends on Jan 1 of the year after",
+			policy:    &LeavePolicy{CarryForwardMaxDays: 5, CarryForwardExpiryMonths: 12},
+			balance:   &LeaveBalance{Year: 2026, TotalDays: 5},
+			unused:    5,
+			carried:   5,
+			expiresOn: ptr(date(2028, time.January, 1)),
+		},
+		{
+			name:      "no cap forfeits everything and sets no expiry",
+			policy:    &LeavePolicy{CarryForwardExpiryMonths: 3},
+			balance:   &LeaveBalance{Year: 2026, TotalDays: 6},
+			unused:    6,
+			forfeited: 6,
+		},
+		{
+			name:    "overdrawn balance has nothing to carry",
+			policy:  &LeavePolicy{CarryForwardMaxDays: 10, CarryForwardExpiryMonths: 3},
+			balance: &LeaveBalance{Year: 2026, TotalDays: 5, UsedDays: 7},
+		},
+		{
+			name:      "expired and encashed days are not carried",
+			policy:    &LeavePolicy{CarryForwardMaxDays: 10},
+			balance:   &LeaveBalance{Year: 2026, TotalDays: 20, UsedDays: 2, ExpiredDays: 3, EncashedDays: 4},
+			unused:    11,
+			carried:   10,
+			forfeited: 1,
+		},
+		{
+			name:      "fractional days are rounded",
+			policy:    &LeavePolicy{CarryForwardMaxDays: 10},
+			balance:   &LeaveBalance{Year: 2026, TotalDays: 12.5, UsedDays: 0.25},
+			unused:    12.25,
+			carried:   10,
+			forfeited: 2.25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.CarryForward(tt.balance)
+			if got.Year != tt.balance.Year {
+				t.Errorf("CarryForward() year = %d, want %d", got.Year, tt.balance.Year)
+			}
+			if got.UnusedDays != tt.unused || got.CarriedDays != tt.carried || got.ForfeitedDays != tt.forfeited {
+				t.Errorf("CarryForward() unused, carried, forfeited = %v, %v, %v, want %v, %v, %v",
+					got.UnusedDays, got.CarriedDays, got.ForfeitedDays, tt.unused, tt.carried, tt.forfeited)
+			}
+			switch {
+			case tt.expiresOn == nil && got.ExpiresOn != nil:
+				t.Errorf("CarryForward() expires on %v, want no expiry", *got.ExpiresOn)
+			case tt.expiresOn != nil && (got.ExpiresOn == nil || !got.ExpiresOn.Equal(*tt.expiresOn)):
+				t.Errorf("CarryForward() expires on %v, want %v", got.ExpiresOn, *tt.expiresOn)
+			}
+		})
+	}
+}
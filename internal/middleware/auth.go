@@ -2,9 +2,12 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"slices"
 	"strings"
 
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/internal/mtls"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -13,59 +16,197 @@ import (
 
 type contextKey string
 
-func AuthFunc(ctx context.Context) (context.Context, error) {
+const tokenContextKey contextKey = "token"
+
+// APIKeyMetadataKey is the metadata key machine-to-machine callers send their API key in
+const APIKeyMetadataKey = "x-api-key"
+
+type Authenticator struct {
+	jwtService  *auth.JWTService
+	revocations auth.RevocationStore
+	apiKeys     *auth.APIKeyVerifier
+	sessions    *auth.SessionTracker
+	identities  *mtls.Identities
+}
+
+// NewAuthenticator returns the authenticator of every request. identities is
+// nil when client certificates are not mapped to services.
+func NewAuthenticator(jwtService *auth.JWTService, revocations auth.RevocationStore, apiKeys *auth.APIKeyVerifier, sessions *auth.SessionTracker, identities *mtls.Identities) *Authenticator {
+	return &Authenticator{
+		jwtService:  jwtService,
+		revocations: revocations,
+		apiKeys:     apiKeys,
+		sessions:    sessions,
+		identities:  identities,
+	}
+}
+
+// AuthFunc validates the bearer token or API key of the request and stores its
+// claims in the context. Requests without either are authenticated by their
+// client certificate when it maps to a service. Public endpoints are passed
+// through untouched.
+func (a *Authenticator) AuthFunc(ctx context.Context) (context.Context, error) {
+	method, _ := grpc.Method(ctx)
+	if SkipAuth(method) {
+		return ctx, nil
+	}
+
+	if key, ok := apiKey(ctx); ok {
+		return a.authenticateAPIKey(ctx, key)
+	}
+
+	if !hasAuthorization(ctx) {
+		if identity, ok := a.certificateIdentity(ctx); ok {
+			return auth.ContextWithClaims(ctx, identity.Claims()), nil
+		}
+	}
+
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := a.jwtService.ValidateToken(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+
+	revoked, err := auth.IsRevoked(ctx, a.revocations, claims)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check token revocation")
+	}
+	if revoked {
+		return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+	}
+
+	// A login that still awaits its second factor can only finish it
+	if claims.TokenType == auth.TokenTypeMFA && !MFAChallengeEndpoint(method) {
+		return nil, status.Error(codes.Unauthenticated, "multi-factor authentication required")
+	}
+
+	if claims.SessionID != "" {
+		a.sessions.Touch(ctx, claims.SessionID)
+	}
+
+	ctx = context.WithValue(ctx, tokenContextKey, token)
+	ctx = auth.ContextWithClaims(ctx, claims)
+
+	return ctx, nil
+}
+
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, key string) (context.Context, error) {
+	claims, err := a.apiKeys.Verify(ctx, key)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrAPIKeyNotFound), errors.Is(err, auth.ErrAPIKeyExpired), errors.Is(err, auth.ErrAPIKeyRevoked):
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired api key")
+		default:
+			return nil, status.Error(codes.Internal, "failed to verify api key")
+		}
+	}
+
+	return auth.ContextWithClaims(ctx, claims), nil
+}
+
+// certificateIdentity returns the service of the verified client certificate
+func (a *Authenticator) certificateIdentity(ctx context.Context) (*mtls.Identity, bool) {
+	if a.identities == nil {
+		return nil, false
+	}
+
+	cert, ok := mtls.PeerCertificate(ctx)
+	if !ok {
+		return nil, false
+	}
+	return a.identities.Lookup(cert)
+}
+
+func hasAuthorization(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get("authorization")) > 0
+}
+
+func apiKey(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	keys := md.Get(APIKeyMetadataKey)
+	if len(keys) == 0 || strings.TrimSpace(keys[0]) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(keys[0]), true
+}
+
+func bearerToken(ctx context.Context) (string, error) {
 	// Get Metadata from context
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
 	}
 
-	// Get Authoriation header
-	authHeaders := md["authrorization"]
+	// Get Authorization header
+	authHeaders := md.Get("authorization")
 	if len(authHeaders) == 0 {
-		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		return "", status.Error(codes.Unauthenticated, "missing authorization header")
 	}
 
 	// Extract token from "Bearer <token>"
-	authHeader := authHeaders[0]
-	if !strings.HasPrefix(authHeader, "Bearer") {
-		return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+	scheme, token, found := strings.Cut(authHeaders[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", status.Error(codes.Unauthenticated, "invalid authorization format")
 	}
 
-	token := strings.TrimPrefix(authHeader, "Bearer ")
+	token = strings.TrimSpace(token)
 	if token == "" {
-		return nil, status.Error(codes.Unauthenticated, "missing token")
+		return "", status.Error(codes.Unauthenticated, "missing token")
 	}
 
-	// Todo : Validate the token here
-
-	ctx = context.WithValue(ctx, contextKey("token"), token)
-
-	return ctx, nil
+	return token, nil
 }
 
 func GetTokenFromContext(ctx context.Context) (string, bool) {
-	token, ok := ctx.Value("token").(string)
+	token, ok := ctx.Value(tokenContextKey).(string)
 	return token, ok
 }
 
+// GetClaimsFromContext returns the claims of the authenticated caller
+func GetClaimsFromContext(ctx context.Context) (*auth.Claims, bool) {
+	return auth.ClaimsFromContext(ctx)
+}
+
 func SkipAuth(fullMethodName string) bool {
 	publicEndpoints := []string{
 		"/hr.auth.v1.AuthService/Login",
-		"/hr.auth.v1.AuthServce/RefreshToken",
+		"/hr.auth.v1.AuthService/RefreshToken",
+		"/hr.auth.v1.AuthService/RequestPasswordReset",
+		"/hr.auth.v1.AuthService/ConfirmPasswordReset",
 	}
 
 	return slices.Contains(publicEndpoints, fullMethodName)
 }
 
-func UnaryAuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+// MFAChallengeEndpoint reports whether the method accepts the token issued by
+// the password step of a login that requires MFA
+func MFAChallengeEndpoint(fullMethodName string) bool {
+	mfaEndpoints := []string{
+		"/hr.auth.v1.AuthService/EnrollMfa",
+		"/hr.auth.v1.AuthService/ConfirmMfaEnrollment",
+		"/hr.auth.v1.AuthService/VerifyMfa",
+	}
+
+	return slices.Contains(mfaEndpoints, fullMethodName)
+}
+
+func (a *Authenticator) UnaryAuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	// Skip authentication for public endpoints
 	if SkipAuth(info.FullMethod) {
 		return handler(ctx, req)
 	}
 
 	// Authenticate request
-	newCtx, err := AuthFunc(ctx)
+	newCtx, err := a.AuthFunc(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -73,14 +214,14 @@ func UnaryAuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerIn
 	return handler(newCtx, req)
 }
 
-func StreamAuthInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+func (a *Authenticator) StreamAuthInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	// Skip authentication for public endpoints
 	if SkipAuth(info.FullMethod) {
 		return handler(srv, ss)
 	}
 
 	// Authenticate request
-	newCtx, err := AuthFunc(ss.Context())
+	newCtx, err := a.AuthFunc(ss.Context())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,336 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/internal/mtls"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type memoryRevocationStore struct {
+	mu        sync.Mutex
+	tokens    map[string]bool
+	employees map[string]time.Time
+	sessions  map[string]bool
+}
+
+func newMemoryRevocationStore() *memoryRevocationStore {
+	return &memoryRevocationStore{
+		tokens:    make(map[string]bool),
+		employees: make(map[string]time.Time),
+		sessions:  make(map[string]bool),
+	}
+}
+
+func (m *memoryRevocationStore) RevokeToken(ctx context.Context, jti, employeeID string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[jti] = true
+	return nil
+}
+
+func (m *memoryRevocationStore) RevokeEmployee(ctx context.Context, employeeID string, before time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.employees[employeeID] = before
+	return nil
+}
+
+func (m *memoryRevocationStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tokens[jti], nil
+}
+
+func (m *memoryRevocationStore) RevokedBefore(ctx context.Context, employeeID string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.employees[employeeID], nil
+}
+
+func (m *memoryRevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[sessionID] = true
+	return nil
+}
+
+func (m *memoryRevocationStore) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sessions[sessionID], nil
+}
+
+type stubAuthRepository struct {
+	auth.Repository
+	revokedEmployees []string
+	apiKeys          map[string]*auth.APIKey
+	sessions         map[string]*auth.Session
+}
+
+func (r *stubAuthRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*auth.APIKey, error) {
+	if key, ok := r.apiKeys[keyHash]; ok {
+		return key, nil
+	}
+	return nil, auth.ErrAPIKeyNotFound
+}
+
+func (r *stubAuthRepository) GetSession(ctx context.Context, id string) (*auth.Session, error) {
+	if session, ok := r.sessions[id]; ok {
+		return session, nil
+	}
+	return nil, auth.ErrSessionNotFound
+}
+
+func (r *stubAuthRepository) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	return nil
+}
+
+func (r *stubAuthRepository) TouchSession(ctx context.Context, id string, seenAt time.Time, interval time.Duration) error {
+	return nil
+}
+
+func (r *stubAuthRepository) TouchAPIKey(ctx context.Context, id string, usedAt time.Time, interval time.Duration) error {
+	return nil
+}
+
+func (r *stubAuthRepository) RevokeEmployeeTokens(ctx context.Context, employeeID string) error {
+	r.revokedEmployees = append(r.revokedEmployees, employeeID)
+	return nil
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestRevokeEmployeeSessions(t *testing.T) {
+	jwtService := auth.NewJWTService(auth.NewHMACKeyStore("test-secret"), time.Hour)
+	revocations := newMemoryRevocationStore()
+	repo := &stubAuthRepository{}
+	service := auth.NewService(jwtService, repo, revocations, nil, nil, auth.MFAPolicy{}, nil, auth.PasswordResetPolicy{}, nil, nil, nil, time.Hour, time.Hour, logger.NewLogger("panic", "text"))
+	authenticator := NewAuthenticator(jwtService, revocations, nil, nil, nil)
+
+	issue := func(userID string) string {
+		t.Helper()
+		token, err := jwtService.GenerateToken(auth.Claims{UserID: userID, Role: auth.RoleEmployee})
+		if err != nil {
+			t.Fatalf("GenerateToken() error = %v", err)
+		}
+		return token
+	}
+
+	before := issue("employee")
+	other := issue("other")
+
+	// Revoke at the start of a fresh second, the tokens above were issued in an earlier one
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+	if err := service.RevokeEmployeeSessions(context.Background(), "employee"); err != nil {
+		t.Fatalf("RevokeEmployeeSessions() error = %v", err)
+	}
+	after := issue("employee")
+
+	if len(repo.revokedEmployees) != 1 || repo.revokedEmployees[0] != "employee" {
+		t.Errorf("refresh tokens revoked for %v, want [employee]", repo.revokedEmployees)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  codes.Code
+	}{
+		{name: "token issued before the revocation", token: before, want: codes.Unauthenticated},
+		{name: "token issued in the second of the revocation", token: after, want: codes.OK},
+		{name: "token of another employee", token: other, want: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := authenticator.AuthFunc(bearerContext(tt.token))
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("AuthFunc() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if err == nil {
+				if _, ok := auth.ClaimsFromContext(ctx); !ok {
+					t.Error("AuthFunc() did not store the claims")
+				}
+			}
+		})
+	}
+}
+
+func TestAuthFuncAPIKey(t *testing.T) {
+	hash := func(key string) string {
+		sum := sha256.Sum256([]byte(key))
+		return hex.EncodeToString(sum[:])
+	}
+	past := time.Now().Add(-time.Minute)
+	repo := &stubAuthRepository{apiKeys: map[string]*auth.APIKey{
+		hash("hrk_valid"):   {ID: "valid", Scopes: []string{auth.PermEmployeeRead}},
+		hash("hrk_revoked"): {ID: "revoked", Scopes: []string{auth.PermEmployeeRead}, RevokedAt: &past},
+		hash("hrk_expired"): {ID: "expired", Scopes: []string{auth.PermEmployeeRead}, ExpiresAt: &past},
+	}}
+	log := logger.NewLogger("panic", "text")
+	authenticator := NewAuthenticator(auth.NewJWTService(auth.NewHMACKeyStore("test-secret"), time.Hour), newMemoryRevocationStore(), auth.NewAPIKeyVerifier(repo, log), nil, nil)
+
+	tests := []struct {
+		name string
+		key  string
+		want codes.Code
+	}{
+		{name: "valid key", key: "hrk_valid", want: codes.OK},
+		{name: "revoked key", key: "hrk_revoked", want: codes.Unauthenticated},
+		{name: "expired key", key: "hrk_expired", want: codes.Unauthenticated},
+		{name: "unknown key", key: "hrk_unknown", want: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyMetadataKey, tt.key))
+			ctx, err := authenticator.AuthFunc(ctx)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("AuthFunc() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			claims, ok := auth.ClaimsFromContext(ctx)
+			if !ok {
+				t.Fatal("AuthFunc() did not store the claims")
+			}
+			if claims.Role != auth.RoleService || claims.TokenType != auth.TokenTypeAPIKey || !slices.Contains(claims.Permissions, auth.PermEmployeeRead) {
+				t.Errorf("AuthFunc() claims = %+v, want a service caller with the key scopes", claims)
+			}
+		})
+	}
+}
+
+func TestAuthFuncClientCertificate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "identities.json")
+	content := `[{"common_name": "payroll.internal", "name": "payroll", "scopes": ["employee:read"]}]`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write identities: %v", err)
+	}
+	identities, err := mtls.LoadIdentities(path)
+	if err != nil {
+		t.Fatalf("LoadIdentities() error = %v", err)
+	}
+
+	jwtService := auth.NewJWTService(auth.NewHMACKeyStore("test-secret"), time.Hour)
+	authenticator := NewAuthenticator(jwtService, newMemoryRevocationStore(), nil, nil, identities)
+	token, err := jwtService.GenerateToken(auth.Claims{UserID: "employee", Role: auth.RoleEmployee})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	withCertificate := func(ctx context.Context, commonName string) context.Context {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+		return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		}})
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		want     codes.Code
+		wantUser string
+	}{
+		{
+			name:     "known certificate",
+			ctx:      withCertificate(context.Background(), "payroll.internal"),
+			want:     codes.OK,
+			wantUser: "payroll",
+		},
+		{
+			name: "unknown certificate",
+			ctx:  withCertificate(context.Background(), "billing.internal"),
+			want: codes.Unauthenticated,
+		},
+		{
+			name:     "bearer token takes precedence over the certificate",
+			ctx:      withCertificate(bearerContext(token), "payroll.internal"),
+			want:     codes.OK,
+			wantUser: "employee",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := authenticator.AuthFunc(tt.ctx)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("AuthFunc() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			claims, ok := auth.ClaimsFromContext(ctx)
+			if !ok || claims.UserID != tt.wantUser {
+				t.Errorf("AuthFunc() claims = %+v, want user %s", claims, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	log := logger.NewLogger("panic", "text")
+	jwtService := auth.NewJWTService(auth.NewHMACKeyStore("test-secret"), time.Hour)
+	revocations := newMemoryRevocationStore()
+	repo := &stubAuthRepository{sessions: map[string]*auth.Session{
+		"phone":  {ID: "phone", EmployeeID: "employee"},
+		"laptop": {ID: "laptop", EmployeeID: "employee"},
+	}}
+	service := auth.NewService(jwtService, repo, revocations, nil, nil, auth.MFAPolicy{}, nil, auth.PasswordResetPolicy{}, nil, nil, nil, time.Hour, time.Hour, log)
+	authenticator := NewAuthenticator(jwtService, revocations, nil, auth.NewSessionTracker(repo, log), nil)
+
+	issue := func(sessionID string) string {
+		t.Helper()
+		token, err := jwtService.GenerateToken(auth.Claims{UserID: "employee", Role: auth.RoleEmployee, SessionID: sessionID})
+		if err != nil {
+			t.Fatalf("GenerateToken() error = %v", err)
+		}
+		return token
+	}
+	phone := issue("phone")
+	laptop := issue("laptop")
+
+	if err := service.RevokeSession(context.Background(), &auth.Claims{UserID: "employee", Role: auth.RoleEmployee}, "phone"); err != nil {
+		t.Fatalf("RevokeSession() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  codes.Code
+	}{
+		{name: "token of the revoked session", token: phone, want: codes.Unauthenticated},
+		{name: "token of another session", token: laptop, want: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authenticator.AuthFunc(bearerContext(tt.token))
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("AuthFunc() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+		})
+	}
+}
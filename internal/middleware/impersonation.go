@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/dmehra2102/hr-management-system/internal/audit"
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ImpersonationAuditInterceptor records every call made with an impersonation
+// token, including the ones authorization denies, with the admin as actor and
+// the impersonated employee as subject.
+func ImpersonationAuditInterceptor(auditRepo audit.Repository, log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		claims, ok := auth.ClaimsFromContext(ctx)
+		if !ok || claims.Actor == nil {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+		auditImpersonatedCall(ctx, auditRepo, log, claims, info.FullMethod, err)
+		return resp, err
+	}
+}
+
+// StreamImpersonationAuditInterceptor is the streaming counterpart of
+// ImpersonationAuditInterceptor, the stream is recorded once it ends
+func StreamImpersonationAuditInterceptor(auditRepo audit.Repository, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		claims, ok := auth.ClaimsFromContext(ctx)
+		if !ok || claims.Actor == nil {
+			return handler(srv, ss)
+		}
+
+		err := handler(srv, ss)
+		auditImpersonatedCall(ctx, auditRepo, log, claims, info.FullMethod, err)
+		return err
+	}
+}
+
+func auditImpersonatedCall(ctx context.Context, auditRepo audit.Repository, log *logger.Logger, claims *auth.Claims, method string, err error) {
+	code := status.Code(err)
+	log.WithFields(map[string]any{
+		"method":      method,
+		"actor_id":    claims.Actor.UserID,
+		"actor_email": claims.Actor.Email,
+		"user_id":     claims.UserID,
+		"email":       claims.Email,
+		"status_code": code,
+	}).Warn("Impersonated call")
+
+	// The call itself already happened, a failed audit write must not hide its result
+	if auditErr := auditRepo.Create(context.WithoutCancel(ctx), &audit.Event{
+		Action:    audit.ActionImpersonatedCall,
+		ActorID:   &claims.Actor.UserID,
+		SubjectID: &claims.UserID,
+		IPAddress: auth.PeerIP(ctx),
+		Details: map[string]any{
+			"method":      method,
+			"status_code": code.String(),
+			"jti":         claims.ID,
+		},
+	}); auditErr != nil {
+		log.Error("Failed to audit impersonated call", "method", method, "error", auditErr)
+	}
+}
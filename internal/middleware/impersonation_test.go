@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	"github.com/dmehra2102/hr-management-system/internal/audit"
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordingAuditRepository struct {
+	mu     sync.Mutex
+	events []*audit.Event
+}
+
+func (r *recordingAuditRepository) Create(ctx context.Context, event *audit.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func impersonatedClaims(userID, role string) *auth.Claims {
+	claims := claimsFor(userID, role)
+	claims.ID = "impersonation-jti"
+	claims.Actor = &auth.Actor{UserID: "admin", Role: auth.RoleAdmin}
+	return claims
+}
+
+func TestImpersonationOnlyReads(t *testing.T) {
+	for method, rule := range DefaultPolicy(newTestChecker()) {
+		if !rule.AllowImpersonation {
+			continue
+		}
+
+		name := method[strings.LastIndex(method, "/")+1:]
+		switch {
+		case strings.HasPrefix(name, "Get"), strings.HasPrefix(name, "List"), strings.HasPrefix(name, "Export"), name == "ValidateToken", name == "Logout":
+		default:
+			t.Errorf("%s allows impersonation but is not a read operation", method)
+		}
+	}
+}
+
+func TestAuthorizationInterceptorImpersonation(t *testing.T) {
+	interceptor := AuthorizationInterceptor(DefaultPolicy(newTestChecker()), logger.NewLogger("panic", "text"))
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	tests := []struct {
+		name   string
+		method string
+		claims *auth.Claims
+		req    any
+		want   codes.Code
+	}{
+		{
+			name:   "impersonated read",
+			method: leavepb.LeaveService_GetLeaveRequest_FullMethodName,
+			claims: impersonatedClaims("employee", auth.RoleEmployee),
+			req:    &leavepb.GetLeaveRequestRequest{Id: "employee-leave"},
+			want:   codes.OK,
+		},
+		{
+			name:   "impersonated write",
+			method: leavepb.LeaveService_CreateLeaveRequest_FullMethodName,
+			claims: impersonatedClaims("employee", auth.RoleEmployee),
+			req:    &leavepb.CreateLeaveRequestRequest{EmployeeId: "employee"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "same write without impersonation",
+			method: leavepb.LeaveService_CreateLeaveRequest_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.CreateLeaveRequestRequest{EmployeeId: "employee"},
+			want:   codes.OK,
+		},
+		{
+			name:   "impersonated read of someone else",
+			method: leavepb.LeaveService_GetLeaveRequest_FullMethodName,
+			claims: impersonatedClaims("employee", auth.RoleEmployee),
+			req:    &leavepb.GetLeaveRequestRequest{Id: "stranger-leave"},
+			want:   codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.ContextWithClaims(context.Background(), tt.claims)
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("interceptor code = %v, want %v (error %v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestImpersonationAuditInterceptor(t *testing.T) {
+	const method = "/hr.leave.v1.LeaveService/GetLeaveRequest"
+
+	tests := []struct {
+		name       string
+		claims     *auth.Claims
+		handlerErr error
+		wantEvent  bool
+		wantCode   codes.Code
+	}{
+		{
+			name:      "impersonated call",
+			claims:    impersonatedClaims("employee", auth.RoleEmployee),
+			wantEvent: true,
+			wantCode:  codes.OK,
+		},
+		{
+			name:       "impersonated call that fails",
+			claims:     impersonatedClaims("employee", auth.RoleEmployee),
+			handlerErr: status.Error(codes.PermissionDenied, "impersonation tokens are read-only"),
+			wantEvent:  true,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:     "regular call",
+			claims:   claimsFor("employee", auth.RoleEmployee),
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, streaming := range []bool{false, true} {
+			name := tt.name
+			if streaming {
+				name += " on a stream"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				auditRepo := &recordingAuditRepository{}
+				log := logger.NewLogger("panic", "text")
+				ctx := auth.ContextWithClaims(context.Background(), tt.claims)
+
+				var err error
+				if streaming {
+					interceptor := StreamImpersonationAuditInterceptor(auditRepo, log)
+					err = interceptor(nil, &wrappedServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method},
+						func(srv any, stream grpc.ServerStream) error { return tt.handlerErr })
+				} else {
+					interceptor := ImpersonationAuditInterceptor(auditRepo, log)
+					_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+						func(ctx context.Context, req any) (any, error) { return nil, tt.handlerErr })
+				}
+				if got := status.Code(err); got != tt.wantCode {
+					t.Fatalf("interceptor code = %v, want %v", got, tt.wantCode)
+				}
+
+				if !tt.wantEvent {
+					if len(auditRepo.events) != 0 {
+						t.Errorf("recorded %d audit events for a regular call", len(auditRepo.events))
+					}
+					return
+				}
+				if len(auditRepo.events) != 1 {
+					t.Fatalf("recorded %d audit events, want 1", len(auditRepo.events))
+				}
+
+				event := auditRepo.events[0]
+				if event.Action != audit.ActionImpersonatedCall {
+					t.Errorf("action = %s, want %s", event.Action, audit.ActionImpersonatedCall)
+				}
+				if event.ActorID == nil || *event.ActorID != "admin" || event.SubjectID == nil || *event.SubjectID != "employee" {
+					t.Errorf("actor = %v, subject = %v, want admin acting as employee", event.ActorID, event.SubjectID)
+				}
+				if event.Details["method"] != method || event.Details["status_code"] != tt.wantCode.String() {
+					t.Errorf("details = %v, want method %s and status %s", event.Details, method, tt.wantCode)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/internal/department"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"github.com/dmehra2102/hr-management-system/internal/leave"
+	"github.com/dmehra2102/hr-management-system/internal/performance"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type idRequest interface {
+	GetId() string
+}
+
+type employeeIDRequest interface {
+	GetEmployeeId() string
+}
+
+type approverIDRequest interface {
+	GetApproverId() string
+}
+
+type departmentIDRequest interface {
+	GetDepartmentId() string
+}
+
+type managerIDRequest interface {
+	GetManagerId() string
+}
+
+type reviewerIDRequest interface {
+	GetReviewerId() string
+}
+
+// OwnershipChecker builds conditions that compare the requested resource with the caller
+type OwnershipChecker struct {
+	employeeRepo   employee.Repository
+	departmentRepo department.Repository
+	leaveRepo      leave.Repository
+	reviewRepo     performance.Repository
+}
+
+func NewOwnershipChecker(employeeRepo employee.Repository, departmentRepo department.Repository, leaveRepo leave.Repository, reviewRepo performance.Repository) *OwnershipChecker {
+	return &OwnershipChecker{
+		employeeRepo:   employeeRepo,
+		departmentRepo: departmentRepo,
+		leaveRepo:      leaveRepo,
+		reviewRepo:     reviewRepo,
+	}
+}
+
+// SelfByID allows the call when the id field of the request is the caller
+func (o *OwnershipChecker) SelfByID() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		r, ok := req.(idRequest)
+		if !ok || r.GetId() != claims.UserID {
+			return status.Error(codes.PermissionDenied, "access is limited to your own record")
+		}
+		return nil
+	}
+}
+
+// SelfByEmployeeID allows the call when the employee_id field of the request is the caller
+func (o *OwnershipChecker) SelfByEmployeeID() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		r, ok := req.(employeeIDRequest)
+		if !ok || r.GetEmployeeId() != claims.UserID {
+			return status.Error(codes.PermissionDenied, "access is limited to your own records")
+		}
+		return nil
+	}
+}
+
+// SelfOrReportByEmployeeID allows the call for the caller and the employees they manage
+func (o *OwnershipChecker) SelfOrReportByEmployeeID() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		r, ok := req.(employeeIDRequest)
+		if !ok || r.GetEmployeeId() == "" {
+			return status.Error(codes.PermissionDenied, "employee id is required")
+		}
+		if r.GetEmployeeId() == claims.UserID {
+			return nil
+		}
+		return o.checkReport(ctx, r.GetEmployeeId(), claims.UserID)
+	}
+}
+
+// LeaveOwner allows the call when the leave request identified by id belongs to the caller
+func (o *OwnershipChecker) LeaveOwner() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		leaveRequest, err := o.leaveFromRequest(ctx, req)
+		if err != nil {
+			return err
+		}
+		if leaveRequest.EmployeeID != claims.UserID {
+			return status.Error(codes.PermissionDenied, "access is limited to your own leave requests")
+		}
+		return nil
+	}
+}
+
+// LeaveOwnerOrReport allows the call for leave requests of the caller and of the employees they manage
+func (o *OwnershipChecker) LeaveOwnerOrReport() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		leaveRequest, err := o.leaveFromRequest(ctx, req)
+		if err != nil {
+			return err
+		}
+		if leaveRequest.EmployeeID == claims.UserID {
+			return nil
+		}
+		return o.checkReport(ctx, leaveRequest.EmployeeID, claims.UserID)
+	}
+}
+
+// LeaveApprover allows the call when the caller acts as the approver and does not
+// decide on their own leave. Whether the caller is a pending approver of the
+// current step, directly or as a delegate, is checked by the leave service.
+func (o *OwnershipChecker) LeaveApprover() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		if r, ok := req.(approverIDRequest); ok && r.GetApproverId() != "" && r.GetApproverId() != claims.UserID {
+			return status.Error(codes.PermissionDenied, "approver must be the caller")
+		}
+
+		leaveRequest, err := o.leaveFromRequest(ctx, req)
+		if err != nil {
+			return err
+		}
+		if leaveRequest.EmployeeID == claims.UserID {
+			return status.Error(codes.PermissionDenied, "cannot decide on your own leave request")
+		}
+		return nil
+	}
+}
+
+// DelegationOwner allows the call when the approval delegation identified by id was made by the caller
+func (o *OwnershipChecker) DelegationOwner() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		r, ok := req.(idRequest)
+		if !ok || r.GetId() == "" {
+			return status.Error(codes.PermissionDenied, "delegation id is required")
+		}
+
+		delegation, err := o.leaveRepo.GetDelegation(ctx, r.GetId())
+		if err != nil {
+			return status.Error(codes.NotFound, "Approval delegation not found")
+		}
+		if delegation.DelegatorID != claims.UserID {
+			return status.Error(codes.PermissionDenied, "access is limited to your own delegations")
+		}
+		return nil
+	}
+}
+
+// TeamCalendar allows the calendar of the caller, of the employees they manage and
+// of the department they manage
+func (o *OwnershipChecker) TeamCalendar() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		if r, ok := req.(employeeIDRequest); ok && r.GetEmployeeId() != "" {
+			if r.GetEmployeeId() == claims.UserID {
+				return nil
+			}
+			return o.checkReport(ctx, r.GetEmployeeId(), claims.UserID)
+		}
+		if r, ok := req.(managerIDRequest); ok && r.GetManagerId() != "" {
+			if r.GetManagerId() != claims.UserID {
+				return status.Error(codes.PermissionDenied, "access is limited to your own reports")
+			}
+			return nil
+		}
+		if r, ok := req.(departmentIDRequest); ok && r.GetDepartmentId() != "" {
+			return o.checkDepartment(ctx, r.GetDepartmentId(), claims.UserID)
+		}
+		return status.Error(codes.PermissionDenied, "department, manager or employee id is required")
+	}
+}
+
+// ReviewSubject allows the call when the performance review identified by id is of the caller
+func (o *OwnershipChecker) ReviewSubject() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		review, err := o.reviewFromRequest(ctx, req)
+		if err != nil {
+			return err
+		}
+		if review.EmployeeID != claims.UserID {
+			return status.Error(codes.PermissionDenied, "access is limited to your own performance reviews")
+		}
+		return nil
+	}
+}
+
+// ReviewParticipantOrReport allows the call for performance reviews of the caller,
+// written by the caller and of the employees they manage
+func (o *OwnershipChecker) ReviewParticipantOrReport() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		review, err := o.reviewFromRequest(ctx, req)
+		if err != nil {
+			return err
+		}
+		if review.EmployeeID == claims.UserID || review.ReviewerID == claims.UserID {
+			return nil
+		}
+		return o.checkReport(ctx, review.EmployeeID, claims.UserID)
+	}
+}
+
+// Reviewer allows the call when the caller is the reviewer of the performance review identified by id
+func (o *OwnershipChecker) Reviewer() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		review, err := o.reviewFromRequest(ctx, req)
+		if err != nil {
+			return err
+		}
+		if review.ReviewerID != claims.UserID {
+			return status.Error(codes.PermissionDenied, "access is limited to performance reviews you write")
+		}
+		return nil
+	}
+}
+
+// ReviewerOfReport allows the call when the caller reviews an employee they manage
+func (o *OwnershipChecker) ReviewerOfReport() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		if r, ok := req.(reviewerIDRequest); ok && r.GetReviewerId() != "" && r.GetReviewerId() != claims.UserID {
+			return status.Error(codes.PermissionDenied, "reviewer must be the caller")
+		}
+
+		r, ok := req.(employeeIDRequest)
+		if !ok || r.GetEmployeeId() == "" {
+			return status.Error(codes.PermissionDenied, "employee id is required")
+		}
+		return o.checkReport(ctx, r.GetEmployeeId(), claims.UserID)
+	}
+}
+
+// ReviewsOfSelfOrReport allows listing the performance reviews of the caller, of the
+// employees they manage and those the caller writes
+func (o *OwnershipChecker) ReviewsOfSelfOrReport() Condition {
+	return func(ctx context.Context, claims *auth.Claims, req any) error {
+		if r, ok := req.(employeeIDRequest); ok && r.GetEmployeeId() != "" {
+			if r.GetEmployeeId() == claims.UserID {
+				return nil
+			}
+			return o.checkReport(ctx, r.GetEmployeeId(), claims.UserID)
+		}
+		if r, ok := req.(reviewerIDRequest); ok && r.GetReviewerId() == claims.UserID {
+			return nil
+		}
+		return status.Error(codes.PermissionDenied, "employee id or your own reviewer id is required")
+	}
+}
+
+func (o *OwnershipChecker) reviewFromRequest(ctx context.Context, req any) (*performance.Review, error) {
+	r, ok := req.(idRequest)
+	if !ok || r.GetId() == "" {
+		return nil, status.Error(codes.PermissionDenied, "performance review id is required")
+	}
+
+	review, err := o.reviewRepo.GetByID(ctx, r.GetId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Performance review not found")
+	}
+	return review, nil
+}
+
+func (o *OwnershipChecker) leaveFromRequest(ctx context.Context, req any) (*leave.LeaveRequest, error) {
+	r, ok := req.(idRequest)
+	if !ok || r.GetId() == "" {
+		return nil, status.Error(codes.PermissionDenied, "leave request id is required")
+	}
+
+	leaveRequest, err := o.leaveRepo.GetByID(ctx, r.GetId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Leave request not found")
+	}
+	return leaveRequest, nil
+}
+
+func (o *OwnershipChecker) checkReport(ctx context.Context, employeeID, managerID string) error {
+	managed, err := o.employeeRepo.IsManagedBy(ctx, employeeID, managerID)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to check reporting line")
+	}
+	if !managed {
+		return status.Error(codes.PermissionDenied, "employee does not report to you")
+	}
+	return nil
+}
+
+func (o *OwnershipChecker) checkDepartment(ctx context.Context, departmentID, callerID string) error {
+	dept, err := o.departmentRepo.GetByID(ctx, departmentID)
+	if err != nil {
+		return status.Error(codes.NotFound, "Department not found")
+	}
+	if dept.ManagerID == nil || *dept.ManagerID != callerID {
+		return status.Error(codes.PermissionDenied, "access is limited to the department you manage")
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package middleware
+
+import (
+	authpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/auth"
+	departmentpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/department"
+	employeepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/employee"
+	holidaypb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/holiday"
+	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	performancepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/performance"
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+)
+
+// DefaultPolicy is the authorization table of every RPC served by the system.
+// Methods missing from the table are denied, as are methods not marked
+// AllowImpersonation when called with an impersonation token.
+func DefaultPolicy(checker *OwnershipChecker) Policy {
+	return Policy{
+		// Auth
+		authpb.AuthService_Logout_FullMethodName:               {AllowImpersonation: true},
+		authpb.AuthService_ValidateToken_FullMethodName:        {AllowImpersonation: true},
+		authpb.AuthService_ChangePassword_FullMethodName:       {},
+		authpb.AuthService_EnrollMfa_FullMethodName:            {},
+		authpb.AuthService_ConfirmMfaEnrollment_FullMethodName: {},
+		authpb.AuthService_VerifyMfa_FullMethodName:            {},
+		authpb.AuthService_RevokeEmployeeSessions_FullMethodName: {
+			Roles: []string{auth.RoleAdmin},
+		},
+		authpb.AuthService_UnlockAccount_FullMethodName: {
+			Roles: []string{auth.RoleAdmin, auth.RoleHR},
+		},
+		authpb.AuthService_CreateApiKey_FullMethodName: {
+			Roles: []string{auth.RoleAdmin},
+		},
+		authpb.AuthService_ListApiKeys_FullMethodName: {
+			Roles: []string{auth.RoleAdmin},
+		},
+		authpb.AuthService_RevokeApiKey_FullMethodName: {
+			Roles: []string{auth.RoleAdmin},
+		},
+		authpb.AuthService_ImpersonateEmployee_FullMethodName: {
+			Roles: []string{auth.RoleAdmin},
+		},
+		authpb.AuthService_ListSessions_FullMethodName: {
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.SelfByEmployeeID(),
+			},
+		},
+		authpb.AuthService_RevokeSession_FullMethodName: {},
+
+		// Employee
+		employeepb.EmployeeService_GetEmployee_FullMethodName: {
+			Permissions: []string{auth.PermEmployeeRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByID(),
+			},
+			AllowImpersonation: true,
+		},
+		employeepb.EmployeeService_ListEmployees_FullMethodName: {
+			Roles:              []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager, auth.RoleService},
+			Permissions:        []string{auth.PermEmployeeRead},
+			AllowImpersonation: true,
+		},
+		employeepb.EmployeeService_GetEmployeesByDepartment_FullMethodName: {
+			Roles:              []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager, auth.RoleService},
+			Permissions:        []string{auth.PermEmployeeRead},
+			AllowImpersonation: true,
+		},
+		employeepb.EmployeeService_CreateEmployee_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermEmployeeWrite},
+		},
+		employeepb.EmployeeService_UpdateEmployee_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermEmployeeWrite},
+		},
+		employeepb.EmployeeService_DeleteEmployee_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermEmployeeDelete},
+		},
+
+		// Department
+		departmentpb.DepartmentService_GetDepartment_FullMethodName: {
+			Permissions:        []string{auth.PermDepartmentRead},
+			AllowImpersonation: true,
+		},
+		departmentpb.DepartmentService_ListDepartments_FullMethodName: {
+			Permissions:        []string{auth.PermDepartmentRead},
+			AllowImpersonation: true,
+		},
+		departmentpb.DepartmentService_CreateDepartment_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermDepartmentWrite},
+		},
+		departmentpb.DepartmentService_UpdateDepartment_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermDepartmentWrite},
+		},
+		departmentpb.DepartmentService_DeleteDepartment_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin},
+			Permissions: []string{auth.PermDepartmentDelete},
+		},
+
+		// Holiday
+		holidaypb.HolidayService_CreateHoliday_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermHolidayWrite},
+		},
+		holidaypb.HolidayService_GetHoliday_FullMethodName: {
+			Permissions:        []string{auth.PermHolidayRead},
+			AllowImpersonation: true,
+		},
+		holidaypb.HolidayService_UpdateHoliday_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermHolidayWrite},
+		},
+		holidaypb.HolidayService_DeleteHoliday_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermHolidayWrite},
+		},
+		holidaypb.HolidayService_ListHolidays_FullMethodName: {
+			Permissions:        []string{auth.PermHolidayRead},
+			AllowImpersonation: true,
+		},
+
+		// Leave
+		leavepb.LeaveService_CreateLeaveRequest_FullMethodName: {
+			Permissions: []string{auth.PermLeaveWrite},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.SelfByEmployeeID(),
+			},
+		},
+		leavepb.LeaveService_GetLeaveRequest_FullMethodName: {
+			Permissions: []string{auth.PermLeaveRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.LeaveOwner(),
+				auth.RoleManager:  checker.LeaveOwnerOrReport(),
+			},
+			AllowImpersonation: true,
+		},
+		leavepb.LeaveService_ListLeaveRequests_FullMethodName: {
+			Permissions: []string{auth.PermLeaveRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.SelfOrReportByEmployeeID(),
+			},
+			AllowImpersonation: true,
+		},
+		leavepb.LeaveService_UpdateLeaveRequest_FullMethodName: {
+			Permissions: []string{auth.PermLeaveWrite},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.LeaveOwner(),
+				auth.RoleManager:  checker.LeaveOwner(),
+			},
+		},
+		leavepb.LeaveService_DeleteLeaveRequest_FullMethodName: {
+			Permissions: []string{auth.PermLeaveWrite},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.LeaveOwner(),
+				auth.RoleManager:  checker.LeaveOwner(),
+			},
+		},
+		leavepb.LeaveService_ApproveLeaveRequest_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager},
+			Permissions: []string{auth.PermLeaveApprove},
+			Conditions: map[string]Condition{
+				auth.RoleAdmin:   checker.LeaveApprover(),
+				auth.RoleHR:      checker.LeaveApprover(),
+				auth.RoleManager: checker.LeaveApprover(),
+			},
+		},
+		leavepb.LeaveService_RejectLeaveRequest_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager},
+			Permissions: []string{auth.PermLeaveApprove},
+			Conditions: map[string]Condition{
+				auth.RoleAdmin:   checker.LeaveApprover(),
+				auth.RoleHR:      checker.LeaveApprover(),
+				auth.RoleManager: checker.LeaveApprover(),
+			},
+		},
+		leavepb.LeaveService_CancelLeaveRequest_FullMethodName: {
+			Permissions: []string{auth.PermLeaveWrite},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.LeaveOwner(),
+				auth.RoleManager:  checker.LeaveOwner(),
+			},
+		},
+		leavepb.LeaveService_WithdrawLeaveRequest_FullMethodName: {
+			Permissions: []string{auth.PermLeaveWrite},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.LeaveOwner(),
+				auth.RoleManager:  checker.LeaveOwner(),
+			},
+		},
+		leavepb.LeaveService_GetTeamCalendar_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager},
+			Permissions: []string{auth.PermLeaveRead},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.TeamCalendar(),
+			},
+			AllowImpersonation: true,
+		},
+		leavepb.LeaveService_ExportLeaveCalendar_FullMethodName: {
+			Permissions: []string{auth.PermLeaveRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.TeamCalendar(),
+			},
+			AllowImpersonation: true,
+		},
+		leavepb.LeaveService_RequestCompOffCredit_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager},
+			Permissions: []string{auth.PermLeaveApprove},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.SelfOrReportByEmployeeID(),
+			},
+		},
+		leavepb.LeaveService_ListCompOffCredits_FullMethodName: {
+			Permissions: []string{auth.PermLeaveRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.SelfOrReportByEmployeeID(),
+			},
+			AllowImpersonation: true,
+		},
+		leavepb.LeaveService_ApproveCompOffCredit_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermLeaveApprove},
+		},
+		leavepb.LeaveService_RejectCompOffCredit_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermLeaveApprove},
+		},
+		leavepb.LeaveService_ListLeavePolicies_FullMethodName: {
+			Permissions:        []string{auth.PermLeaveRead},
+			AllowImpersonation: true,
+		},
+		leavepb.LeaveService_SetLeavePolicy_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermLeaveApprove},
+		},
+		leavepb.LeaveService_RunLeaveAccrual_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermLeaveApprove},
+		},
+		leavepb.LeaveService_CloseLeaveYear_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermLeaveApprove},
+		},
+		leavepb.LeaveService_EncashLeave_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermLeaveApprove, auth.PermPayrollRead},
+		},
+		leavepb.LeaveService_ListApprovalRules_FullMethodName: {
+			Permissions:        []string{auth.PermLeaveRead},
+			AllowImpersonation: true,
+		},
+		leavepb.LeaveService_SetApprovalRules_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermLeaveApprove},
+		},
+		leavepb.LeaveService_CreateApprovalDelegation_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager},
+			Permissions: []string{auth.PermLeaveApprove},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.SelfByEmployeeID(),
+			},
+		},
+		leavepb.LeaveService_ListApprovalDelegations_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager},
+			Permissions: []string{auth.PermLeaveApprove},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.SelfByEmployeeID(),
+			},
+		},
+		leavepb.LeaveService_DeleteApprovalDelegation_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR, auth.RoleManager},
+			Permissions: []string{auth.PermLeaveApprove},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.DelegationOwner(),
+			},
+		},
+		leavepb.LeaveService_GetEmployeeLeaveBalance_FullMethodName: {
+			Permissions: []string{auth.PermLeaveRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.SelfOrReportByEmployeeID(),
+			},
+			AllowImpersonation: true,
+		},
+
+		// Performance
+		performancepb.PerformanceService_CreatePerformanceReview_FullMethodName: {
+			Permissions: []string{auth.PermPerformanceWrite},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.ReviewerOfReport(),
+			},
+		},
+		performancepb.PerformanceService_GetPerformanceReview_FullMethodName: {
+			Permissions: []string{auth.PermPerformanceRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.ReviewSubject(),
+				auth.RoleManager:  checker.ReviewParticipantOrReport(),
+			},
+			AllowImpersonation: true,
+		},
+		performancepb.PerformanceService_ListPerformanceReviews_FullMethodName: {
+			Permissions: []string{auth.PermPerformanceRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.ReviewsOfSelfOrReport(),
+			},
+			AllowImpersonation: true,
+		},
+		performancepb.PerformanceService_GetEmployeePerformanceHistory_FullMethodName: {
+			Permissions: []string{auth.PermPerformanceRead},
+			Conditions: map[string]Condition{
+				auth.RoleEmployee: checker.SelfByEmployeeID(),
+				auth.RoleManager:  checker.SelfOrReportByEmployeeID(),
+			},
+			AllowImpersonation: true,
+		},
+		performancepb.PerformanceService_UpdatePerformanceReview_FullMethodName: {
+			Permissions: []string{auth.PermPerformanceWrite},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.Reviewer(),
+			},
+		},
+		performancepb.PerformanceService_DeletePerformanceReview_FullMethodName: {
+			Permissions: []string{auth.PermPerformanceWrite},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.Reviewer(),
+			},
+		},
+		performancepb.PerformanceService_SubmitPerformanceReview_FullMethodName: {
+			Permissions: []string{auth.PermPerformanceWrite},
+			Conditions: map[string]Condition{
+				auth.RoleManager: checker.Reviewer(),
+			},
+		},
+		performancepb.PerformanceService_CompletePerformanceReview_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermPerformanceWrite},
+		},
+		performancepb.PerformanceService_ArchivePerformanceReview_FullMethodName: {
+			Roles:       []string{auth.RoleAdmin, auth.RoleHR},
+			Permissions: []string{auth.PermPerformanceWrite},
+		},
+	}
+}
@@ -0,0 +1,734 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/auth"
+	departmentpb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/department"
+	employeepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/employee"
+	holidaypb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/holiday"
+	leavepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/leave"
+	performancepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/performance"
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/internal/department"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"github.com/dmehra2102/hr-management-system/internal/leave"
+	"github.com/dmehra2102/hr-management-system/internal/performance"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type stubEmployeeRepository struct {
+	employee.Repository
+	// managers maps an employee to their direct manager
+	managers map[string]string
+}
+
+func (r *stubEmployeeRepository) IsManagedBy(ctx context.Context, id, managerID string) (bool, error) {
+	return r.managers[id] == managerID, nil
+}
+
+type stubDepartmentRepository struct {
+	department.Repository
+	departments map[string]*department.Department
+}
+
+func (r *stubDepartmentRepository) GetByID(ctx context.Context, id string) (*department.Department, error) {
+	if dept, ok := r.departments[id]; ok {
+		return dept, nil
+	}
+	return nil, errors.New("department not found")
+}
+
+type stubReviewRepository struct {
+	performance.Repository
+	reviews map[string]*performance.Review
+}
+
+func (r *stubReviewRepository) GetByID(ctx context.Context, id string) (*performance.Review, error) {
+	if review, ok := r.reviews[id]; ok {
+		return review, nil
+	}
+	return nil, errors.New("performance review not found")
+}
+
+type stubLeaveRepository struct {
+	leave.Repository
+	requests    map[string]*leave.LeaveRequest
+	delegations map[string]*leave.Delegation
+}
+
+func (r *stubLeaveRepository) GetByID(ctx context.Context, id string) (*leave.LeaveRequest, error) {
+	if request, ok := r.requests[id]; ok {
+		return request, nil
+	}
+	return nil, errors.New("leave request not found")
+}
+
+func (r *stubLeaveRepository) GetDelegation(ctx context.Context, id string) (*leave.Delegation, error) {
+	if delegation, ok := r.delegations[id]; ok {
+		return delegation, nil
+	}
+	return nil, errors.New("delegation not found")
+}
+
+// newTestChecker returns a checker where "report" is managed by "manager", who
+// manages the "sales" department, and each leave request, delegation and
+// performance review is named after its owner
+func newTestChecker() *OwnershipChecker {
+	employees := &stubEmployeeRepository{
+		managers: map[string]string{"report": "manager"},
+	}
+	leaves := &stubLeaveRepository{
+		requests: map[string]*leave.LeaveRequest{
+			"employee-leave": {ID: "employee-leave", EmployeeID: "employee"},
+			"manager-leave":  {ID: "manager-leave", EmployeeID: "manager"},
+			"report-leave":   {ID: "report-leave", EmployeeID: "report"},
+			"stranger-leave": {ID: "stranger-leave", EmployeeID: "stranger"},
+		},
+		delegations: map[string]*leave.Delegation{
+			"manager-delegation":  {ID: "manager-delegation", DelegatorID: "manager", DelegateID: "report"},
+			"stranger-delegation": {ID: "stranger-delegation", DelegatorID: "stranger", DelegateID: "manager"},
+		},
+	}
+	manager := "manager"
+	departments := &stubDepartmentRepository{
+		departments: map[string]*department.Department{
+			"sales":   {ID: "sales", ManagerID: &manager},
+			"support": {ID: "support"},
+		},
+	}
+	reviews := &stubReviewRepository{
+		reviews: map[string]*performance.Review{
+			"report-review":   {ID: "report-review", EmployeeID: "report", ReviewerID: "hr"},
+			"stranger-review": {ID: "stranger-review", EmployeeID: "stranger", ReviewerID: "manager"},
+			"employee-review": {ID: "employee-review", EmployeeID: "employee", ReviewerID: "other-manager"},
+		},
+	}
+	return NewOwnershipChecker(employees, departments, leaves, reviews)
+}
+
+func claimsFor(userID, role string) *auth.Claims {
+	return &auth.Claims{
+		UserID:      userID,
+		Role:        role,
+		Permissions: auth.PermissionsForRole(role),
+	}
+}
+
+func TestDefaultPolicyCoversEveryRPC(t *testing.T) {
+	policy := DefaultPolicy(newTestChecker())
+	services := []grpc.ServiceDesc{
+		authpb.AuthService_ServiceDesc,
+		employeepb.EmployeeService_ServiceDesc,
+		departmentpb.DepartmentService_ServiceDesc,
+		holidaypb.HolidayService_ServiceDesc,
+		leavepb.LeaveService_ServiceDesc,
+		performancepb.PerformanceService_ServiceDesc,
+	}
+
+	for _, service := range services {
+		for _, method := range service.Methods {
+			fullMethod := "/" + service.ServiceName + "/" + method.MethodName
+			if _, ok := policy[fullMethod]; !ok && !SkipAuth(fullMethod) {
+				t.Errorf("DefaultPolicy() has no rule for %s", fullMethod)
+			}
+		}
+	}
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	interceptor := AuthorizationInterceptor(DefaultPolicy(newTestChecker()), logger.NewLogger("panic", "text"))
+
+	tests := []struct {
+		name   string
+		method string
+		claims *auth.Claims
+		req    any
+		want   codes.Code
+	}{
+		{
+			name:   "public method without claims",
+			method: authpb.AuthService_Login_FullMethodName,
+			req:    &authpb.LoginRequest{},
+			want:   codes.OK,
+		},
+		{
+			name:   "method missing from the policy",
+			method: "/hr.auth.v1.AuthService/Unknown",
+			claims: claimsFor("admin", auth.RoleAdmin),
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "missing claims",
+			method: authpb.AuthService_Logout_FullMethodName,
+			req:    &authpb.LogoutRequest{},
+			want:   codes.Unauthenticated,
+		},
+		{
+			name:   "any role on an open rule",
+			method: authpb.AuthService_Logout_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &authpb.LogoutRequest{},
+			want:   codes.OK,
+		},
+		{
+			name:   "role not allowed",
+			method: employeepb.EmployeeService_ListEmployees_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &employeepb.ListEmployeesRequest{},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "allowed role without the permission",
+			method: employeepb.EmployeeService_CreateEmployee_FullMethodName,
+			claims: &auth.Claims{UserID: "hr", Role: auth.RoleHR, Permissions: []string{auth.PermEmployeeRead}},
+			req:    &employeepb.CreateEmployeeRequest{},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "allowed role with the permission",
+			method: employeepb.EmployeeService_CreateEmployee_FullMethodName,
+			claims: claimsFor("hr", auth.RoleHR),
+			req:    &employeepb.CreateEmployeeRequest{},
+			want:   codes.OK,
+		},
+		{
+			name:   "api key on a rule without permissions",
+			method: authpb.AuthService_Logout_FullMethodName,
+			claims: &auth.Claims{UserID: "key", Role: auth.RoleService, Permissions: []string{auth.PermEmployeeRead}},
+			req:    &authpb.LogoutRequest{},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "api key with the scope",
+			method: employeepb.EmployeeService_ListEmployees_FullMethodName,
+			claims: &auth.Claims{UserID: "key", Role: auth.RoleService, Permissions: []string{auth.PermEmployeeRead}},
+			req:    &employeepb.ListEmployeesRequest{},
+			want:   codes.OK,
+		},
+		{
+			name:   "api key without the scope",
+			method: employeepb.EmployeeService_ListEmployees_FullMethodName,
+			claims: &auth.Claims{UserID: "key", Role: auth.RoleService, Permissions: []string{auth.PermLeaveRead}},
+			req:    &employeepb.ListEmployeesRequest{},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "conditions do not apply to other roles",
+			method: employeepb.EmployeeService_GetEmployee_FullMethodName,
+			claims: claimsFor("hr", auth.RoleHR),
+			req:    &employeepb.GetEmployeeRequest{Id: "stranger"},
+			want:   codes.OK,
+		},
+
+		// SelfByID
+		{
+			name:   "self by id on own record",
+			method: employeepb.EmployeeService_GetEmployee_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &employeepb.GetEmployeeRequest{Id: "employee"},
+			want:   codes.OK,
+		},
+		{
+			name:   "self by id on another record",
+			method: employeepb.EmployeeService_GetEmployee_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &employeepb.GetEmployeeRequest{Id: "stranger"},
+			want:   codes.PermissionDenied,
+		},
+
+		// SelfByEmployeeID
+		{
+			name:   "self by employee id for the caller",
+			method: leavepb.LeaveService_CreateLeaveRequest_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.CreateLeaveRequestRequest{EmployeeId: "employee"},
+			want:   codes.OK,
+		},
+		{
+			name:   "self by employee id for someone else",
+			method: leavepb.LeaveService_CreateLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.CreateLeaveRequestRequest{EmployeeId: "report"},
+			want:   codes.PermissionDenied,
+		},
+
+		// SelfOrReportByEmployeeID
+		{
+			name:   "self or report for a report",
+			method: leavepb.LeaveService_ListLeaveRequests_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ListLeaveRequestsRequest{EmployeeId: "report"},
+			want:   codes.OK,
+		},
+		{
+			name:   "self or report for the caller",
+			method: leavepb.LeaveService_GetEmployeeLeaveBalance_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetEmployeeLeaveBalanceRequest{EmployeeId: "manager"},
+			want:   codes.OK,
+		},
+		{
+			name:   "self or report for a stranger",
+			method: leavepb.LeaveService_ListLeaveRequests_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ListLeaveRequestsRequest{EmployeeId: "stranger"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "self or report without employee id",
+			method: leavepb.LeaveService_ListLeaveRequests_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ListLeaveRequestsRequest{},
+			want:   codes.PermissionDenied,
+		},
+
+		// LeaveOwner
+		{
+			name:   "leave owner on own leave",
+			method: leavepb.LeaveService_UpdateLeaveRequest_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.UpdateLeaveRequestRequest{Id: "employee-leave"},
+			want:   codes.OK,
+		},
+		{
+			name:   "leave owner on the leave of a report",
+			method: leavepb.LeaveService_DeleteLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.DeleteLeaveRequestRequest{Id: "report-leave"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "leave owner on an unknown leave",
+			method: leavepb.LeaveService_GetLeaveRequest_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.GetLeaveRequestRequest{Id: "missing"},
+			want:   codes.NotFound,
+		},
+		{
+			name:   "leave owner without id",
+			method: leavepb.LeaveService_GetLeaveRequest_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.GetLeaveRequestRequest{},
+			want:   codes.PermissionDenied,
+		},
+
+		// LeaveOwnerOrReport
+		{
+			name:   "leave owner or report on the leave of a report",
+			method: leavepb.LeaveService_GetLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetLeaveRequestRequest{Id: "report-leave"},
+			want:   codes.OK,
+		},
+		{
+			name:   "leave owner or report on own leave",
+			method: leavepb.LeaveService_GetLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetLeaveRequestRequest{Id: "manager-leave"},
+			want:   codes.OK,
+		},
+		{
+			name:   "leave owner or report on the leave of a stranger",
+			method: leavepb.LeaveService_GetLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetLeaveRequestRequest{Id: "stranger-leave"},
+			want:   codes.PermissionDenied,
+		},
+
+		// LeaveApprover, the pending approver itself is checked by the leave service
+		{
+			name:   "approving the leave of a report",
+			method: leavepb.LeaveService_ApproveLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ApproveLeaveRequestRequest{Id: "report-leave", ApproverId: "manager"},
+			want:   codes.OK,
+		},
+		{
+			name:   "rejecting the leave of a stranger as a delegate",
+			method: leavepb.LeaveService_RejectLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.RejectLeaveRequestRequest{Id: "stranger-leave"},
+			want:   codes.OK,
+		},
+		{
+			name:   "approving own leave",
+			method: leavepb.LeaveService_ApproveLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ApproveLeaveRequestRequest{Id: "manager-leave"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "hr rejecting own leave",
+			method: leavepb.LeaveService_RejectLeaveRequest_FullMethodName,
+			claims: claimsFor("employee", auth.RoleHR),
+			req:    &leavepb.RejectLeaveRequestRequest{Id: "employee-leave"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "approving on behalf of someone else",
+			method: leavepb.LeaveService_ApproveLeaveRequest_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ApproveLeaveRequestRequest{Id: "report-leave", ApproverId: "other-manager"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "approving an unknown leave",
+			method: leavepb.LeaveService_ApproveLeaveRequest_FullMethodName,
+			claims: claimsFor("admin", auth.RoleAdmin),
+			req:    &leavepb.ApproveLeaveRequestRequest{Id: "missing"},
+			want:   codes.NotFound,
+		},
+
+		// DelegationOwner
+		{
+			name:   "deleting own delegation",
+			method: leavepb.LeaveService_DeleteApprovalDelegation_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.DeleteApprovalDelegationRequest{Id: "manager-delegation"},
+			want:   codes.OK,
+		},
+		{
+			name:   "deleting a delegation made to the caller",
+			method: leavepb.LeaveService_DeleteApprovalDelegation_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.DeleteApprovalDelegationRequest{Id: "stranger-delegation"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "deleting an unknown delegation",
+			method: leavepb.LeaveService_DeleteApprovalDelegation_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.DeleteApprovalDelegationRequest{Id: "missing"},
+			want:   codes.NotFound,
+		},
+		{
+			name:   "hr deleting the delegation of a stranger",
+			method: leavepb.LeaveService_DeleteApprovalDelegation_FullMethodName,
+			claims: claimsFor("hr", auth.RoleHR),
+			req:    &leavepb.DeleteApprovalDelegationRequest{Id: "stranger-delegation"},
+			want:   codes.OK,
+		},
+
+		// TeamCalendar
+		{
+			name:   "team calendar of the reports of the caller",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetTeamCalendarRequest{ManagerId: "manager"},
+			want:   codes.OK,
+		},
+		{
+			name:   "team calendar of the reports of another manager",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetTeamCalendarRequest{ManagerId: "other-manager"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "team calendar of the department the caller manages",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetTeamCalendarRequest{DepartmentId: "sales"},
+			want:   codes.OK,
+		},
+		{
+			name:   "team calendar of another department",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetTeamCalendarRequest{DepartmentId: "support"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "team calendar of an unknown department",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetTeamCalendarRequest{DepartmentId: "missing"},
+			want:   codes.NotFound,
+		},
+		{
+			name:   "team calendar without department or manager",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.GetTeamCalendarRequest{},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "team calendar for HR",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("hr", auth.RoleHR),
+			req:    &leavepb.GetTeamCalendarRequest{DepartmentId: "support"},
+			want:   codes.OK,
+		},
+		{
+			name:   "team calendar for an employee",
+			method: leavepb.LeaveService_GetTeamCalendar_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.GetTeamCalendarRequest{DepartmentId: "sales"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "exporting the calendar of a report",
+			method: leavepb.LeaveService_ExportLeaveCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ExportLeaveCalendarRequest{EmployeeId: "report"},
+			want:   codes.OK,
+		},
+		{
+			name:   "exporting own calendar as a manager",
+			method: leavepb.LeaveService_ExportLeaveCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ExportLeaveCalendarRequest{EmployeeId: "manager"},
+			want:   codes.OK,
+		},
+		{
+			name:   "exporting the calendar of a stranger",
+			method: leavepb.LeaveService_ExportLeaveCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ExportLeaveCalendarRequest{EmployeeId: "stranger"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "exporting the calendar of the department the caller manages",
+			method: leavepb.LeaveService_ExportLeaveCalendar_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &leavepb.ExportLeaveCalendarRequest{DepartmentId: "sales"},
+			want:   codes.OK,
+		},
+		{
+			name:   "exporting own calendar as an employee",
+			method: leavepb.LeaveService_ExportLeaveCalendar_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.ExportLeaveCalendarRequest{EmployeeId: "employee"},
+			want:   codes.OK,
+		},
+		{
+			name:   "exporting a department calendar as an employee",
+			method: leavepb.LeaveService_ExportLeaveCalendar_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &leavepb.ExportLeaveCalendarRequest{DepartmentId: "sales"},
+			want:   codes.PermissionDenied,
+		},
+
+		// Performance reviews
+		{
+			name:   "reviewing a report",
+			method: performancepb.PerformanceService_CreatePerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.CreatePerformanceReviewRequest{EmployeeId: "report", ReviewerId: "manager"},
+			want:   codes.OK,
+		},
+		{
+			name:   "reviewing a stranger",
+			method: performancepb.PerformanceService_CreatePerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.CreatePerformanceReviewRequest{EmployeeId: "stranger", ReviewerId: "manager"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "reviewing a report on behalf of someone else",
+			method: performancepb.PerformanceService_CreatePerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.CreatePerformanceReviewRequest{EmployeeId: "report", ReviewerId: "other-manager"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "employee creating a review",
+			method: performancepb.PerformanceService_CreatePerformanceReview_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &performancepb.CreatePerformanceReviewRequest{EmployeeId: "employee"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "employee reading own review",
+			method: performancepb.PerformanceService_GetPerformanceReview_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &performancepb.GetPerformanceReviewRequest{Id: "employee-review"},
+			want:   codes.OK,
+		},
+		{
+			name:   "employee reading the review of someone else",
+			method: performancepb.PerformanceService_GetPerformanceReview_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			req:    &performancepb.GetPerformanceReviewRequest{Id: "report-review"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "manager reading the review of a report written by HR",
+			method: performancepb.PerformanceService_GetPerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.GetPerformanceReviewRequest{Id: "report-review"},
+			want:   codes.OK,
+		},
+		{
+			name:   "manager reading a review they wrote",
+			method: performancepb.PerformanceService_GetPerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.GetPerformanceReviewRequest{Id: "stranger-review"},
+			want:   codes.OK,
+		},
+		{
+			name:   "manager reading the review of a stranger",
+			method: performancepb.PerformanceService_GetPerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.GetPerformanceReviewRequest{Id: "employee-review"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "reading an unknown review",
+			method: performancepb.PerformanceService_GetPerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.GetPerformanceReviewRequest{Id: "missing"},
+			want:   codes.NotFound,
+		},
+		{
+			name:   "reviewer updating their review",
+			method: performancepb.PerformanceService_UpdatePerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.UpdatePerformanceReviewRequest{Id: "stranger-review"},
+			want:   codes.OK,
+		},
+		{
+			name:   "manager updating the review of a report written by HR",
+			method: performancepb.PerformanceService_UpdatePerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.UpdatePerformanceReviewRequest{Id: "report-review"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "manager submitting a review of someone else",
+			method: performancepb.PerformanceService_SubmitPerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.SubmitPerformanceReviewRequest{Id: "employee-review"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "manager completing a review",
+			method: performancepb.PerformanceService_CompletePerformanceReview_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.CompletePerformanceReviewRequest{Id: "stranger-review"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "HR completing a review",
+			method: performancepb.PerformanceService_CompletePerformanceReview_FullMethodName,
+			claims: claimsFor("hr", auth.RoleHR),
+			req:    &performancepb.CompletePerformanceReviewRequest{Id: "stranger-review"},
+			want:   codes.OK,
+		},
+		{
+			name:   "manager listing the reviews of a report",
+			method: performancepb.PerformanceService_ListPerformanceReviews_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.ListPerformanceReviewsRequest{EmployeeId: "report"},
+			want:   codes.OK,
+		},
+		{
+			name:   "manager listing the reviews they write",
+			method: performancepb.PerformanceService_ListPerformanceReviews_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.ListPerformanceReviewsRequest{ReviewerId: "manager"},
+			want:   codes.OK,
+		},
+		{
+			name:   "manager listing the reviews of another reviewer",
+			method: performancepb.PerformanceService_ListPerformanceReviews_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.ListPerformanceReviewsRequest{ReviewerId: "other-manager"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "manager listing every review",
+			method: performancepb.PerformanceService_ListPerformanceReviews_FullMethodName,
+			claims: claimsFor("manager", auth.RoleManager),
+			req:    &performancepb.ListPerformanceReviewsRequest{},
+			want:   codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = auth.ContextWithClaims(ctx, tt.claims)
+			}
+
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("interceptor() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if called != (tt.want == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.want == codes.OK)
+			}
+		})
+	}
+}
+
+func TestStreamAuthorizationInterceptor(t *testing.T) {
+	interceptor := StreamAuthorizationInterceptor(DefaultPolicy(newTestChecker()), logger.NewLogger("panic", "text"))
+
+	tests := []struct {
+		name   string
+		method string
+		claims *auth.Claims
+		want   codes.Code
+	}{
+		{
+			name:   "streaming method missing from the policy",
+			method: "/hr.leave.v1.LeaveService/WatchLeaveRequests",
+			claims: claimsFor("admin", auth.RoleAdmin),
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "rule without conditions",
+			method: authpb.AuthService_Logout_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			want:   codes.OK,
+		},
+		{
+			name:   "conditions cannot see the request",
+			method: employeepb.EmployeeService_GetEmployee_FullMethodName,
+			claims: claimsFor("employee", auth.RoleEmployee),
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "missing claims",
+			method: authpb.AuthService_Logout_FullMethodName,
+			want:   codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = auth.ContextWithClaims(ctx, tt.claims)
+			}
+
+			called := false
+			handler := func(srv any, stream grpc.ServerStream) error {
+				called = true
+				return nil
+			}
+
+			err := interceptor(nil, &wrappedServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("interceptor() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+			if called != (tt.want == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.want == codes.OK)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package mtls
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// TokenTypeClientCert marks claims built from a client certificate
+const TokenTypeClientCert = "client_cert"
+
+// Identity is the service a client certificate belongs to
+type Identity struct {
+	// CommonName is matched against the subject common name of the certificate
+	CommonName string `json:"common_name"`
+	// Name identifies the service in logs and claims
+	Name string `json:"name"`
+	// Scopes are the permissions granted to the service
+	Scopes []string `json:"scopes"`
+}
+
+// Claims returns the claims of the service, it acts with the SERVICE role
+// through its scopes like an API key does
+func (i *Identity) Claims() *auth.Claims {
+	return &auth.Claims{
+		UserID:      i.Name,
+		Role:        auth.RoleService,
+		Permissions: i.Scopes,
+		TokenType:   TokenTypeClientCert,
+	}
+}
+
+// Identities maps client certificate subjects to services
+type Identities struct {
+	byCommonName map[string]*Identity
+}
+
+// LoadIdentities reads a JSON array of identities from path
+func LoadIdentities(path string) (*Identities, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client identities: %w", err)
+	}
+
+	var list []*Identity
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse client identities: %w", err)
+	}
+
+	identities := &Identities{byCommonName: make(map[string]*Identity, len(list))}
+	for _, identity := range list {
+		if identity.CommonName == "" || identity.Name == "" {
+			return nil, fmt.Errorf("client identity requires common_name and name")
+		}
+		if _, ok := identities.byCommonName[identity.CommonName]; ok {
+			return nil, fmt.Errorf("duplicate client identity %s", identity.CommonName)
+		}
+		for _, scope := range identity.Scopes {
+			if !auth.IsPermission(scope) {
+				return nil, fmt.Errorf("client identity %s has unknown scope %q", identity.Name, scope)
+			}
+		}
+		identities.byCommonName[identity.CommonName] = identity
+	}
+
+	return identities, nil
+}
+
+// Lookup returns the identity of the certificate subject
+func (i *Identities) Lookup(cert *x509.Certificate) (*Identity, bool) {
+	identity, ok := i.byCommonName[cert.Subject.CommonName]
+	return identity, ok
+}
+
+// PeerCertificate returns the verified client certificate of the connection
+func PeerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return info.State.VerifiedChains[0][0], true
+}
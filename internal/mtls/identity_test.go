@@ -0,0 +1,161 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func writeIdentities(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "identities.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write identities: %v", err)
+	}
+	return path
+}
+
+func TestLoadIdentities(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "valid identities",
+			content: `[{"common_name": "payroll.internal", "name": "payroll", "scopes": ["employee:read"]}]`,
+		},
+		{
+			name:    "missing name",
+			content: `[{"common_name": "payroll.internal", "scopes": ["employee:read"]}]`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate common name",
+			content: `[{"common_name": "payroll.internal", "name": "payroll"},
+				{"common_name": "payroll.internal", "name": "billing"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown scope",
+			content: `[{"common_name": "payroll.internal", "name": "payroll", "scopes": ["payroll:run"]}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			content: `{"common_name": "payroll.internal"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadIdentities(writeIdentities(t, tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadIdentities() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIdentitiesLookup(t *testing.T) {
+	identities, err := LoadIdentities(writeIdentities(t, `[
+		{"common_name": "payroll.internal", "name": "payroll", "scopes": ["employee:read", "leave:read"]}
+	]`))
+	if err != nil {
+		t.Fatalf("LoadIdentities() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		commonName string
+		want       *auth.Claims
+	}{
+		{
+			name:       "known subject",
+			commonName: "payroll.internal",
+			want: &auth.Claims{
+				UserID:      "payroll",
+				Role:        auth.RoleService,
+				Permissions: []string{auth.PermEmployeeRead, auth.PermLeaveRead},
+				TokenType:   TokenTypeClientCert,
+			},
+		},
+		{
+			name:       "unknown subject",
+			commonName: "billing.internal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: tt.commonName}}
+			identity, ok := identities.Lookup(cert)
+			if ok != (tt.want != nil) {
+				t.Fatalf("Lookup() ok = %v, want %v", ok, tt.want != nil)
+			}
+			if !ok {
+				return
+			}
+
+			got := identity.Claims()
+			if got.UserID != tt.want.UserID || got.Role != tt.want.Role || got.TokenType != tt.want.TokenType ||
+				!slices.Equal(got.Permissions, tt.want.Permissions) {
+				t.Errorf("Claims() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeerCertificate(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "payroll.internal"}}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		wantOK bool
+	}{
+		{
+			name: "verified client certificate",
+			ctx: peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+			}}),
+			wantOK: true,
+		},
+		{
+			name: "certificate presented but not verified",
+			ctx: peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}},
+			}}),
+		},
+		{
+			name: "connection without tls",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{}),
+		},
+		{
+			name: "no peer",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PeerCertificate(tt.ctx)
+			if ok != tt.wantOK {
+				t.Fatalf("PeerCertificate() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got != cert {
+				t.Errorf("PeerCertificate() = %v, want the leaf of the verified chain", got.Subject)
+			}
+		})
+	}
+}
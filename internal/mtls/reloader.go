@@ -0,0 +1,165 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce groups the burst of events a certificate rotation produces
+const reloadDebounce = 500 * time.Millisecond
+
+// Config locates the server certificate and the CA of client certificates
+type Config struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables client certificates, they are optional unless RequireClientCert is set
+	ClientCAFile      string
+	RequireClientCert bool
+}
+
+// Reloader serves the TLS configuration of the server and reloads the
+// certificates when their files change, without restarting the server
+type Reloader struct {
+	config Config
+	logger *logger.Logger
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func NewReloader(config Config, logger *logger.Logger) (*Reloader, error) {
+	if config.RequireClientCert && config.ClientCAFile == "" {
+		return nil, fmt.Errorf("a client CA is required to require client certificates")
+	}
+
+	r := &Reloader{
+		config: config,
+		logger: logger.ServiceLogger("mtls"),
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the certificate files again, the previous certificates are
+// kept when any of them is invalid
+func (r *Reloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.config.ClientCAFile != "" {
+		pem, err := os.ReadFile(r.config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in client CA %s", r.config.ClientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.mu.Unlock()
+
+	return nil
+}
+
+// TLSConfig returns the server configuration, every handshake uses the
+// certificates loaded last
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   tls.NoClientCert,
+				NextProtos:   []string{"h2"},
+			}
+			if r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+				if r.config.RequireClientCert {
+					config.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return config, nil
+		},
+	}
+}
+
+// Watch reloads the certificates when their files change until ctx is done.
+// The directories are watched rather than the files so that atomic renames
+// and the symlink swaps of mounted secrets are noticed too.
+func (r *Reloader) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	files := []string{r.config.CertFile, r.config.KeyFile}
+	if r.config.ClientCAFile != "" {
+		files = append(files, r.config.ClientCAFile)
+	}
+
+	var dirs []string
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		if slices.Contains(dirs, dir) {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+		dirs = append(dirs, dir)
+	}
+
+	var pending <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Has(fsnotify.Chmod) {
+				continue
+			}
+			pending = time.After(reloadDebounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			r.logger.Error("Certificate watcher error", "error", err)
+		case <-pending:
+			pending = nil
+			if err := r.Reload(); err != nil {
+				r.logger.Error("Failed to reload certificates, keeping the previous ones", "error", err)
+				continue
+			}
+			r.logger.Info("Certificates reloaded", "cert", r.config.CertFile)
+		}
+	}
+}
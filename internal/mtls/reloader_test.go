@@ -0,0 +1,159 @@
+package mtls
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+)
+
+// writeCertificate writes a self signed certificate for commonName and its
+// key as PEM files and returns the DER encoded certificate
+func writeCertificate(t *testing.T, certFile, keyFile, commonName string) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return der
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+// servedConfig returns the configuration of the next handshake
+func servedConfig(t *testing.T, r *Reloader) *tls.Config {
+	t.Helper()
+	config, err := r.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatalf("GetConfigForClient() error = %v", err)
+	}
+	return config
+}
+
+func TestReloaderReload(t *testing.T) {
+	dir := t.TempDir()
+	config := Config{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+	}
+	first := writeCertificate(t, config.CertFile, config.KeyFile, "first.internal")
+
+	reloader, err := NewReloader(config, logger.NewLogger("panic", "text"))
+	if err != nil {
+		t.Fatalf("NewReloader() error = %v", err)
+	}
+	if got := servedConfig(t, reloader).Certificates[0].Certificate[0]; !bytes.Equal(got, first) {
+		t.Fatal("the loaded certificate is not served")
+	}
+
+	writeFile(t, config.CertFile, []byte("not a certificate"))
+	if err := reloader.Reload(); err == nil {
+		t.Fatal("Reload() of an invalid certificate succeeded")
+	}
+	if got := servedConfig(t, reloader).Certificates[0].Certificate[0]; !bytes.Equal(got, first) {
+		t.Error("an invalid certificate replaced the previous one")
+	}
+
+	second := writeCertificate(t, config.CertFile, config.KeyFile, "second.internal")
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if got := servedConfig(t, reloader).Certificates[0].Certificate[0]; !bytes.Equal(got, second) {
+		t.Error("the rotated certificate is not served")
+	}
+}
+
+func TestReloaderClientAuth(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	caFile := filepath.Join(dir, "ca.crt")
+	writeCertificate(t, certFile, keyFile, "server.internal")
+	writeCertificate(t, caFile, filepath.Join(dir, "ca.key"), "ca.internal")
+
+	tests := []struct {
+		name    string
+		config  Config
+		want    tls.ClientAuthType
+		wantErr bool
+	}{
+		{
+			name:   "without client CA",
+			config: Config{CertFile: certFile, KeyFile: keyFile},
+			want:   tls.NoClientCert,
+		},
+		{
+			name:   "optional client certificates",
+			config: Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile},
+			want:   tls.VerifyClientCertIfGiven,
+		},
+		{
+			name:   "required client certificates",
+			config: Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile, RequireClientCert: true},
+			want:   tls.RequireAndVerifyClientCert,
+		},
+		{
+			name:    "required client certificates without client CA",
+			config:  Config{CertFile: certFile, KeyFile: keyFile, RequireClientCert: true},
+			wantErr: true,
+		},
+		{
+			name:    "client CA without certificates",
+			config:  Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: keyFile},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reloader, err := NewReloader(tt.config, logger.NewLogger("panic", "text"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewReloader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := servedConfig(t, reloader).ClientAuth; got != tt.want {
+				t.Errorf("ClientAuth = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
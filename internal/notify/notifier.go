@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+)
+
+// Message is a notification addressed to a single recipient
+type Message struct {
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// Notifier delivers messages to employees, for example by email
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+type logNotifier struct {
+	logger *logger.Logger
+}
+
+// NewLogNotifier returns a notifier that writes messages to the application log,
+// meant for local development only since the log then contains the message body
+func NewLogNotifier(logger *logger.Logger) Notifier {
+	return &logNotifier{logger: logger.ServiceLogger("notify")}
+}
+
+func (n *logNotifier) Send(ctx context.Context, msg *Message) error {
+	n.logger.Info("Notification", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
+
+type fileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileNotifier returns a notifier that appends messages as JSON lines to the file at path
+func NewFileNotifier(path string) (Notifier, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create notification directory: %w", err)
+	}
+	return &fileNotifier{path: path}, nil
+}
+
+func (n *fileNotifier) Send(ctx context.Context, msg *Message) error {
+	if msg.SentAt.IsZero() {
+		msg.SentAt = time.Now()
+	}
+
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open notification file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package password
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxLength is the number of bytes bcrypt takes into account
+const maxLength = 72
+
+type Config struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// HistorySize is the number of previous passwords that cannot be reused
+	HistorySize int
+	// BannedListFile holds one banned password per line, empty disables the check
+	BannedListFile string
+}
+
+type Policy struct {
+	config Config
+	banned map[string]struct{}
+}
+
+func NewPolicy(config Config) (*Policy, error) {
+	policy := &Policy{
+		config: config,
+		banned: make(map[string]struct{}),
+	}
+
+	if config.BannedListFile != "" {
+		if err := policy.loadBannedList(config.BannedListFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return policy, nil
+}
+
+func (p *Policy) loadBannedList(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open banned password list: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p.banned[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read banned password list: %w", err)
+	}
+
+	return nil
+}
+
+// HistorySize returns the number of previous passwords checked for reuse
+func (p *Policy) HistorySize() int {
+	return p.config.HistorySize
+}
+
+// Validate checks the password against the policy and returns one violation
+// per broken rule, reported against field
+func (p *Policy) Validate(field, password string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	add := func(description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: description,
+		})
+	}
+
+	if len([]rune(password)) < p.config.MinLength {
+		add(fmt.Sprintf("must be at least %d characters long", p.config.MinLength))
+	}
+	if len(password) > maxLength {
+		add(fmt.Sprintf("must be at most %d bytes long", maxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.config.RequireUpper && !hasUpper {
+		add("must contain an uppercase letter")
+	}
+	if p.config.RequireLower && !hasLower {
+		add("must contain a lowercase letter")
+	}
+	if p.config.RequireDigit && !hasDigit {
+		add("must contain a digit")
+	}
+	if p.config.RequireSymbol && !hasSymbol {
+		add("must contain a symbol")
+	}
+
+	if _, ok := p.banned[strings.ToLower(password)]; ok {
+		add("is too common")
+	}
+
+	return violations
+}
+
+// CheckReuse returns a violation when the password matches one of the previous hashes
+func (p *Policy) CheckReuse(field, password string, previousHashes []string) *errdetails.BadRequest_FieldViolation {
+	for _, hash := range previousHashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			return &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: fmt.Sprintf("must not match any of the last %d passwords", p.config.HistorySize),
+			}
+		}
+	}
+	return nil
+}
+
+// InvalidArgument returns an InvalidArgument status carrying the violations as BadRequest details
+func InvalidArgument(violations []*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(codes.InvalidArgument, "Password does not meet the password policy")
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
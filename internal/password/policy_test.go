@@ -0,0 +1,154 @@
+package password
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestPolicy(t *testing.T, config Config) *Policy {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "banned.txt")
+	banned := "# most common passwords\nPassword123!\n\n  letmein1  \n"
+	if err := os.WriteFile(path, []byte(banned), 0o600); err != nil {
+		t.Fatalf("failed to write banned list: %v", err)
+	}
+	config.BannedListFile = path
+
+	policy, err := NewPolicy(config)
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+	return policy
+}
+
+func descriptions(violations []*errdetails.BadRequest_FieldViolation) []string {
+	result := make([]string, len(violations))
+	for i, violation := range violations {
+		result[i] = violation.Description
+	}
+	return result
+}
+
+func TestPolicyValidate(t *testing.T) {
+	strict := Config{MinLength: 10, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+
+	tests := []struct {
+		name     string
+		config   Config
+		password string
+		want     []string
+	}{
+		{name: "meets every rule", config: strict, password: "Correct-Horse7", want: nil},
+		{name: "too short", config: strict, password: "Ab1!", want: []string{"must be at least 10 characters long"}},
+		{name: "length counts characters not bytes", config: Config{MinLength: 4}, password: "éééé", want: nil},
+		{name: "longer than bcrypt accepts", config: Config{MinLength: 8}, password: strings.Repeat("a", 73), want: []string{"must be at most 72 bytes long"}},
+		{name: "missing uppercase", config: strict, password: "correct-horse7", want: []string{"must contain an uppercase letter"}},
+		{name: "missing lowercase", config: strict, password: "CORRECT-HORSE7", want: []string{"must contain a lowercase letter"}},
+		{name: "missing digit", config: strict, password: "Correct-Horse", want: []string{"must contain a digit"}},
+		{name: "missing symbol", config: strict, password: "CorrectHorse7", want: []string{"must contain a symbol"}},
+		{name: "space counts as symbol", config: strict, password: "Correct Horse7", want: nil},
+		{name: "classes not required", config: Config{MinLength: 8}, password: "abcdefgh", want: nil},
+		{
+			name:     "every violation is reported",
+			config:   strict,
+			password: "abc",
+			want: []string{
+				"must be at least 10 characters long",
+				"must contain an uppercase letter",
+				"must contain a digit",
+				"must contain a symbol",
+			},
+		},
+		{name: "banned password", config: Config{MinLength: 8}, password: "Password123!", want: []string{"is too common"}},
+		{name: "banned list ignores case and padding", config: Config{MinLength: 8}, password: "LetMeIn1", want: []string{"is too common"}},
+		{name: "comments are not banned", config: Config{MinLength: 8}, password: "# most common passwords", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := newTestPolicy(t, tt.config).Validate("new_password", tt.password)
+
+			got := descriptions(violations)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Validate(%q) = %q, want %q", tt.password, got, tt.want)
+			}
+			for _, violation := range violations {
+				if violation.Field != "new_password" {
+					t.Errorf("violation field = %q, want new_password", violation.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestNewPolicyMissingBannedList(t *testing.T) {
+	if _, err := NewPolicy(Config{BannedListFile: filepath.Join(t.TempDir(), "missing.txt")}); err == nil {
+		t.Error("NewPolicy() with a missing banned list error = nil, want an error")
+	}
+}
+
+func TestPolicyCheckReuse(t *testing.T) {
+	policy := newTestPolicy(t, Config{MinLength: 8, HistorySize: 3})
+
+	var history []string
+	for _, previous := range []string{"first-Password1", "second-Password2"} {
+		hash, err := bcrypt.GenerateFromPassword([]byte(previous), bcrypt.MinCost)
+		if err != nil {
+			t.Fatalf("failed to hash password: %v", err)
+		}
+		history = append(history, string(hash))
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		history  []string
+		reused   bool
+	}{
+		{name: "matches the latest password", password: "second-Password2", history: history, reused: true},
+		{name: "matches an older password", password: "first-Password1", history: history, reused: true},
+		{name: "new password", password: "third-Password3", history: history, reused: false},
+		{name: "matching is case sensitive", password: "FIRST-PASSWORD1", history: history, reused: false},
+		{name: "no history", password: "first-Password1", history: nil, reused: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violation := policy.CheckReuse("new_password", tt.password, tt.history)
+			if (violation != nil) != tt.reused {
+				t.Fatalf("CheckReuse(%q) = %v, want reused %v", tt.password, violation, tt.reused)
+			}
+			if violation != nil && violation.Description != "must not match any of the last 3 passwords" {
+				t.Errorf("CheckReuse() description = %q", violation.Description)
+			}
+		})
+	}
+}
+
+func TestInvalidArgument(t *testing.T) {
+	violations := []*errdetails.BadRequest_FieldViolation{
+		{Field: "new_password", Description: "must contain a digit"},
+	}
+
+	st := status.Convert(InvalidArgument(violations))
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("InvalidArgument() code = %v, want InvalidArgument", st.Code())
+	}
+
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("InvalidArgument() has %d details, want 1", len(details))
+	}
+	badRequest, ok := details[0].(*errdetails.BadRequest)
+	if !ok || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Description != "must contain a digit" {
+		t.Errorf("InvalidArgument() details = %v, want the violation", details[0])
+	}
+}
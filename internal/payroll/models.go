@@ -0,0 +1,30 @@
+package payroll
+
+import "time"
+
+// Types of payroll line items
+const (
+	ItemLeaveEncashment = "LEAVE_ENCASHMENT"
+)
+
+// LineItem is an amount owed to an employee on top of the basic salary. It
+// stays unassigned until a payroll run picks it up and sets PayrollID.
+type LineItem struct {
+	ID          string  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID  string  `json:"employee_id" gorm:"type:uuid;not null;index"`
+	PayrollID   *string `json:"payroll_id,omitempty" gorm:"type:uuid"`
+	ItemType    string  `json:"item_type" gorm:"not null;check:item_type IN ('LEAVE_ENCASHMENT')"`
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity" gorm:"type:numeric(8,4);not null"`
+	Rate        float64 `json:"rate" gorm:"type:decimal(15,2);not null"`
+	Amount      float64 `json:"amount" gorm:"type:decimal(15,2);not null"`
+	// ReferenceID points at the record the item was created from, such as a leave balance
+	ReferenceID *string   `json:"reference_id,omitempty" gorm:"type:uuid"`
+	CreatedBy   *string   `json:"created_by,omitempty" gorm:"type:uuid"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (LineItem) TableName() string {
+	return "payroll_line_items"
+}
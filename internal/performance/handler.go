@@ -0,0 +1,298 @@
+package performance
+
+import (
+	"context"
+	"time"
+
+	performancepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/performance"
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	performancepb.UnimplementedPerformanceServiceServer
+	service Service
+	logger  *logger.Logger
+}
+
+func NewHandler(service Service, logger *logger.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.HandlerLogger("performance"),
+	}
+}
+
+func (h *Handler) CreatePerformanceReview(ctx context.Context, req *performancepb.CreatePerformanceReviewRequest) (*performancepb.CreatePerformanceReviewResponse, error) {
+	h.logger.Info("CreatePerformanceReview called", "employee_id", req.EmployeeId, "reviewer_id", req.ReviewerId)
+
+	// Reviewers create the reviews they write unless HR assigns another reviewer
+	reviewerID := req.ReviewerId
+	if reviewerID == "" {
+		reviewerID = callerID(ctx)
+	}
+
+	createReq := &CreateReviewRequest{
+		EmployeeID:   req.EmployeeId,
+		ReviewerID:   reviewerID,
+		ReviewPeriod: reviewPeriodFromProto(req.ReviewPeriod),
+		ReviewDate:   timeFromProto(req.ReviewDate),
+		Goals:        goalsFromProto(req.Goals),
+		Competencies: competenciesFromProto(req.Competencies),
+	}
+
+	review, err := h.service.CreatePerformanceReview(ctx, createReq)
+	if err != nil {
+		h.logger.Error("Failed to create performance review", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return &performancepb.CreatePerformanceReviewResponse{
+		PerformanceReview: review.ToProto(),
+	}, nil
+}
+
+func (h *Handler) GetPerformanceReview(ctx context.Context, req *performancepb.GetPerformanceReviewRequest) (*performancepb.GetPerformanceReviewResponse, error) {
+	h.logger.Info("GetPerformanceReview called", "id", req.Id)
+
+	review, err := h.service.GetPerformanceReview(ctx, req.Id, callerID(ctx))
+	if err != nil {
+		h.logger.Error("Failed to get performance review", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &performancepb.GetPerformanceReviewResponse{
+		PerformanceReview: review.ToProto(),
+	}, nil
+}
+
+func (h *Handler) UpdatePerformanceReview(ctx context.Context, req *performancepb.UpdatePerformanceReviewRequest) (*performancepb.UpdatePerformanceReviewResponse, error) {
+	h.logger.Info("UpdatePerformanceReview called", "id", req.Id)
+
+	updateReq := &UpdateReviewRequest{
+		Goals:           goalsFromProto(req.Goals),
+		Competencies:    competenciesFromProto(req.Competencies),
+		OverallComments: req.OverallComments,
+		OverallRating:   req.OverallRating,
+	}
+
+	review, err := h.service.UpdatePerformanceReview(ctx, req.Id, updateReq)
+	if err != nil {
+		h.logger.Error("Failed to update performance review", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &performancepb.UpdatePerformanceReviewResponse{
+		PerformanceReview: review.ToProto(),
+	}, nil
+}
+
+func (h *Handler) DeletePerformanceReview(ctx context.Context, req *performancepb.DeletePerformanceReviewRequest) (*emptypb.Empty, error) {
+	h.logger.Info("DeletePerformanceReview called", "id", req.Id)
+
+	if err := h.service.DeletePerformanceReview(ctx, req.Id); err != nil {
+		h.logger.Error("Failed to delete performance review", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListPerformanceReviews(ctx context.Context, req *performancepb.ListPerformanceReviewsRequest) (*performancepb.ListPerformanceReviewsResponse, error) {
+	h.logger.Info("ListPerformanceReviews called", "page", req.Page, "page_size", req.PageSize, "employee_id", req.EmployeeId, "reviewer_id", req.ReviewerId)
+
+	listReq := &ListReviewsRequest{
+		Page:         int(req.Page),
+		PageSize:     int(req.PageSize),
+		EmployeeID:   req.EmployeeId,
+		ReviewerID:   req.ReviewerId,
+		Status:       reviewStatusFromProto(req.Status),
+		ReviewPeriod: reviewPeriodFromProto(req.ReviewPeriod),
+		CallerID:     callerID(ctx),
+	}
+
+	response, err := h.service.ListPerformanceReviews(ctx, listReq)
+	if err != nil {
+		h.logger.Error("Failed to list performance reviews", "error", err)
+		return nil, err
+	}
+
+	return &performancepb.ListPerformanceReviewsResponse{
+		PerformanceReviews: reviewsToProto(response.Reviews),
+		TotalCount:         int32(response.TotalCount),
+		Page:               int32(response.Page),
+		PageSize:           int32(response.PageSize),
+	}, nil
+}
+
+func (h *Handler) GetEmployeePerformanceHistory(ctx context.Context, req *performancepb.GetEmployeePerformanceHistoryRequest) (*performancepb.GetEmployeePerformanceHistoryResponse, error) {
+	h.logger.Info("GetEmployeePerformanceHistory called", "employee_id", req.EmployeeId)
+
+	historyReq := &ListReviewsRequest{
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		EmployeeID: req.EmployeeId,
+		CallerID:   callerID(ctx),
+	}
+
+	response, err := h.service.GetEmployeePerformanceHistory(ctx, historyReq)
+	if err != nil {
+		h.logger.Error("Failed to get employee performance history", "employee_id", req.EmployeeId, "error", err)
+		return nil, err
+	}
+
+	return &performancepb.GetEmployeePerformanceHistoryResponse{
+		PerformanceReviews: reviewsToProto(response.Reviews),
+		TotalCount:         int32(response.TotalCount),
+		Page:               int32(response.Page),
+		PageSize:           int32(response.PageSize),
+	}, nil
+}
+
+func (h *Handler) SubmitPerformanceReview(ctx context.Context, req *performancepb.SubmitPerformanceReviewRequest) (*performancepb.SubmitPerformanceReviewResponse, error) {
+	h.logger.Info("SubmitPerformanceReview called", "id", req.Id)
+
+	review, err := h.service.SubmitPerformanceReview(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to submit performance review", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &performancepb.SubmitPerformanceReviewResponse{
+		PerformanceReview: review.ToProto(),
+	}, nil
+}
+
+func (h *Handler) CompletePerformanceReview(ctx context.Context, req *performancepb.CompletePerformanceReviewRequest) (*performancepb.CompletePerformanceReviewResponse, error) {
+	h.logger.Info("CompletePerformanceReview called", "id", req.Id)
+
+	review, err := h.service.CompletePerformanceReview(ctx, req.Id, callerID(ctx))
+	if err != nil {
+		h.logger.Error("Failed to complete performance review", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &performancepb.CompletePerformanceReviewResponse{
+		PerformanceReview: review.ToProto(),
+	}, nil
+}
+
+func (h *Handler) ArchivePerformanceReview(ctx context.Context, req *performancepb.ArchivePerformanceReviewRequest) (*performancepb.ArchivePerformanceReviewResponse, error) {
+	h.logger.Info("ArchivePerformanceReview called", "id", req.Id)
+
+	review, err := h.service.ArchivePerformanceReview(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to archive performance review", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &performancepb.ArchivePerformanceReviewResponse{
+		PerformanceReview: review.ToProto(),
+	}, nil
+}
+
+// callerID returns the id of the authenticated caller
+func callerID(ctx context.Context) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return claims.UserID
+	}
+	return ""
+}
+
+// timeFromProto returns the zero time for unset timestamps
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func goalsFromProto(goals []*performancepb.Goal) []*Goal {
+	if len(goals) == 0 {
+		return nil
+	}
+
+	result := make([]*Goal, len(goals))
+	for i, goal := range goals {
+		result[i] = &Goal{
+			ID:            goal.Id,
+			Title:         goal.Title,
+			Description:   goal.Description,
+			TargetValue:   goal.TargetValue,
+			AchievedValue: goal.AchievedValue,
+			Unit:          goal.Unit,
+			Status:        goalStatusFromProto(goal.Status),
+			Weight:        goal.Weight,
+			Comments:      goal.Comments,
+		}
+	}
+	return result
+}
+
+func competenciesFromProto(competencies []*performancepb.Competency) []*Competency {
+	if len(competencies) == 0 {
+		return nil
+	}
+
+	result := make([]*Competency, len(competencies))
+	for i, competency := range competencies {
+		result[i] = &Competency{
+			ID:          competency.Id,
+			Name:        competency.Name,
+			Description: competency.Description,
+			Rating:      competency.Rating,
+			MaxRating:   competency.MaxRating,
+			Weight:      competency.Weight,
+			Comments:    competency.Comments,
+		}
+	}
+	return result
+}
+
+func reviewPeriodFromProto(period performancepb.ReviewPeriod) string {
+	switch period {
+	case performancepb.ReviewPeriod_REVIEW_PERIOD_QUARTERLY:
+		return PeriodQuarterly
+	case performancepb.ReviewPeriod_REVIEW_PERIOD_HALF_YEARLY:
+		return PeriodHalfYearly
+	case performancepb.ReviewPeriod_REVIEW_PERIOD_ANNUAL:
+		return PeriodAnnual
+	case performancepb.ReviewPeriod_REVIEW_PERIOD_PROBATION:
+		return PeriodProbation
+	default:
+		return ""
+	}
+}
+
+func reviewStatusFromProto(status performancepb.ReviewStatus) string {
+	switch status {
+	case performancepb.ReviewStatus_REVIEW_STATUS_DRAFT:
+		return StatusDraft
+	case performancepb.ReviewStatus_REVIEW_STATUS_SUBMITTED:
+		return StatusSubmitted
+	case performancepb.ReviewStatus_REVIEW_STATUS_COMPLETED:
+		return StatusCompleted
+	case performancepb.ReviewStatus_REVIEW_STATUS_ARCHIVED:
+		return StatusArchived
+	default:
+		return ""
+	}
+}
+
+func goalStatusFromProto(status performancepb.GoalStatus) string {
+	switch status {
+	case performancepb.GoalStatus_GOAL_STATUS_NOT_STARTED:
+		return GoalNotStarted
+	case performancepb.GoalStatus_GOAL_STATUS_IN_PROGRESS:
+		return GoalInProgress
+	case performancepb.GoalStatus_GOAL_STATUS_COMPLETED:
+		return GoalCompleted
+	case performancepb.GoalStatus_GOAL_STATUS_EXCEEDED:
+		return GoalExceeded
+	case performancepb.GoalStatus_GOAL_STATUS_NOT_ACHIEVED:
+		return GoalNotAchieved
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,289 @@
+package performance
+
+import (
+	"time"
+
+	performancepb "github.com/dmehra2102/hr-management-system/api/proto/v1/gen/performance"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Statuses of a review, it moves from one to the next and never back
+const (
+	StatusDraft     = "DRAFT"
+	StatusSubmitted = "SUBMITTED"
+	StatusCompleted = "COMPLETED"
+	StatusArchived  = "ARCHIVED"
+)
+
+// Periods a review covers
+const (
+	PeriodQuarterly  = "QUARTERLY"
+	PeriodHalfYearly = "HALF_YEARLY"
+	PeriodAnnual     = "ANNUAL"
+	PeriodProbation  = "PROBATION"
+)
+
+// Statuses of a goal
+const (
+	GoalNotStarted  = "NOT_STARTED"
+	GoalInProgress  = "IN_PROGRESS"
+	GoalCompleted   = "COMPLETED"
+	GoalExceeded    = "EXCEEDED"
+	GoalNotAchieved = "NOT_ACHIEVED"
+)
+
+// MaxRating is the highest rating of a review and of its competencies
+const MaxRating = 5.0
+
+// nextStatus is the only status a review in the key status can move to
+var nextStatus = map[string]string{
+	StatusDraft:     StatusSubmitted,
+	StatusSubmitted: StatusCompleted,
+	StatusCompleted: StatusArchived,
+}
+
+type Review struct {
+	ID              string        `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID      string        `json:"employee_id" gorm:"type:uuid;not null;index"`
+	Employee        *Employee     `json:"employee,omitempty" gorm:"foreignKey:EmployeeID"`
+	ReviewerID      string        `json:"reviewer_id" gorm:"type:uuid;not null;index"`
+	Reviewer        *Employee     `json:"reviewer,omitempty" gorm:"foreignKey:ReviewerID"`
+	ReviewPeriod    string        `json:"review_period" gorm:"not null;check:review_period IN ('QUARTERLY','HALF_YEARLY','ANNUAL','PROBATION')"`
+	ReviewDate      time.Time     `json:"review_date" gorm:"type:date;not null"`
+	Status          string        `json:"status" gorm:"default:'DRAFT';check:status IN ('DRAFT','SUBMITTED','COMPLETED','ARCHIVED')"`
+	OverallRating   *float64      `json:"overall_rating,omitempty" gorm:"type:numeric(3,2)"`
+	OverallComments string        `json:"overall_comments"`
+	SubmittedAt     *time.Time    `json:"submitted_at,omitempty"`
+	Goals           []*Goal       `json:"goals,omitempty" gorm:"foreignKey:ReviewID"`
+	Competencies    []*Competency `json:"competencies,omitempty" gorm:"foreignKey:ReviewID"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Goal struct {
+	ID            string  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ReviewID      string  `json:"review_id" gorm:"type:uuid;not null;index"`
+	Title         string  `json:"title" gorm:"not null"`
+	Description   string  `json:"description"`
+	TargetValue   float64 `json:"target_value" gorm:"type:numeric(10,2)"`
+	AchievedValue float64 `json:"achieved_value" gorm:"type:numeric(10,2)"`
+	Unit          string  `json:"unit"`
+	Status        string  `json:"status" gorm:"default:'NOT_STARTED';check:status IN ('NOT_STARTED','IN_PROGRESS','COMPLETED','EXCEEDED','NOT_ACHIEVED')"`
+	Weight        float64 `json:"weight" gorm:"type:numeric(5,2);default:1"`
+	Comments      string  `json:"comments"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Competency struct {
+	ID          string  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ReviewID    string  `json:"review_id" gorm:"type:uuid;not null;index"`
+	Name        string  `json:"name" gorm:"not null"`
+	Description string  `json:"description"`
+	Rating      float64 `json:"rating" gorm:"type:numeric(3,2)"`
+	MaxRating   float64 `json:"max_rating" gorm:"type:numeric(3,2);default:5"`
+	Weight      float64 `json:"weight" gorm:"type:numeric(5,2);default:1"`
+	Comments    string  `json:"comments"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Employee struct {
+	ID         string  `json:"id" gorm:"type:uuid;primaryKey"`
+	EmployeeID string  `json:"employee_id"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	Email      string  `json:"email"`
+	Status     string  `json:"status"`
+	Role       string  `json:"role"`
+	ManagerID  *string `json:"manager_id,omitempty"`
+}
+
+func (Review) TableName() string {
+	return "performance_reviews"
+}
+
+func (Goal) TableName() string {
+	return "performance_goals"
+}
+
+func (Competency) TableName() string {
+	return "performance_competencies"
+}
+
+func (Employee) TableName() string {
+	return "employees"
+}
+
+type CreateReviewRequest struct {
+	EmployeeID   string        `json:"employee_id" validate:"required"`
+	ReviewerID   string        `json:"reviewer_id" validate:"required"`
+	ReviewPeriod string        `json:"review_period" validate:"required,oneof=QUARTERLY HALF_YEARLY ANNUAL PROBATION"`
+	ReviewDate   time.Time     `json:"review_date" validate:"required"`
+	Goals        []*Goal       `json:"goals,omitempty"`
+	Competencies []*Competency `json:"competencies,omitempty"`
+}
+
+// UpdateReviewRequest changes a draft review. Goals and competencies replace those
+// of the review when given, the ones with an id are updated and those left out are
+// removed. Empty fields keep their current value.
+type UpdateReviewRequest struct {
+	Goals           []*Goal       `json:"goals,omitempty"`
+	Competencies    []*Competency `json:"competencies,omitempty"`
+	OverallComments string        `json:"overall_comments,omitempty"`
+	OverallRating   float64       `json:"overall_rating,omitempty" validate:"gte=0,lte=5"`
+}
+
+type ListReviewsRequest struct {
+	Page         int    `json:"page" validate:"min=1"`
+	PageSize     int    `json:"page_size" validate:"min=1,max=100"`
+	EmployeeID   string `json:"employee_id,omitempty"`
+	ReviewerID   string `json:"reviewer_id,omitempty"`
+	Status       string `json:"status,omitempty" validate:"omitempty,oneof=DRAFT SUBMITTED COMPLETED ARCHIVED"`
+	ReviewPeriod string `json:"review_period,omitempty" validate:"omitempty,oneof=QUARTERLY HALF_YEARLY ANNUAL PROBATION"`
+	// CallerID is set by the handler, drafts of the caller's own reviews are left out
+	CallerID string `json:"-"`
+}
+
+type ListReviewsResponse struct {
+	Reviews    []*Review `json:"reviews"`
+	TotalCount int64     `json:"total_count"`
+	Page       int       `json:"page"`
+	PageSize   int       `json:"page_size"`
+}
+
+// VisibleTo reports whether the caller may see the review, employees only see
+// their own review once the reviewer submitted it
+func (r *Review) VisibleTo(callerID string) bool {
+	return r.EmployeeID != callerID || r.Status != StatusDraft
+}
+
+func (r *Review) GetEmployeeName() string {
+	if r.Employee != nil {
+		return r.Employee.FirstName + " " + r.Employee.LastName
+	}
+	return ""
+}
+
+func (r *Review) GetReviewerName() string {
+	if r.Reviewer != nil {
+		return r.Reviewer.FirstName + " " + r.Reviewer.LastName
+	}
+	return ""
+}
+
+func (r *Review) ToProto() *performancepb.PerformanceReview {
+	review := &performancepb.PerformanceReview{
+		Id:              r.ID,
+		EmployeeId:      r.EmployeeID,
+		EmployeeName:    r.GetEmployeeName(),
+		ReviewerId:      r.ReviewerID,
+		ReviewerName:    r.GetReviewerName(),
+		ReviewPeriod:    reviewPeriodToProto(r.ReviewPeriod),
+		Status:          reviewStatusToProto(r.Status),
+		Goals:           make([]*performancepb.Goal, len(r.Goals)),
+		Competencies:    make([]*performancepb.Competency, len(r.Competencies)),
+		OverallComments: r.OverallComments,
+		ReviewDate:      timestamppb.New(r.ReviewDate),
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+		UpdatedAt:       timestamppb.New(r.UpdatedAt),
+	}
+
+	for i, goal := range r.Goals {
+		review.Goals[i] = goal.ToProto()
+	}
+	for i, competency := range r.Competencies {
+		review.Competencies[i] = competency.ToProto()
+	}
+	if r.OverallRating != nil {
+		review.OverallRating = *r.OverallRating
+	}
+	if r.SubmittedAt != nil {
+		review.SubmittedAt = timestamppb.New(*r.SubmittedAt)
+	}
+	return review
+}
+
+func (g *Goal) ToProto() *performancepb.Goal {
+	return &performancepb.Goal{
+		Id:            g.ID,
+		Title:         g.Title,
+		Description:   g.Description,
+		TargetValue:   g.TargetValue,
+		AchievedValue: g.AchievedValue,
+		Unit:          g.Unit,
+		Status:        goalStatusToProto(g.Status),
+		Weight:        g.Weight,
+		Comments:      g.Comments,
+	}
+}
+
+func (c *Competency) ToProto() *performancepb.Competency {
+	return &performancepb.Competency{
+		Id:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		Rating:      c.Rating,
+		MaxRating:   c.MaxRating,
+		Comments:    c.Comments,
+		Weight:      c.Weight,
+	}
+}
+
+func reviewsToProto(reviews []*Review) []*performancepb.PerformanceReview {
+	result := make([]*performancepb.PerformanceReview, len(reviews))
+	for i, review := range reviews {
+		result[i] = review.ToProto()
+	}
+	return result
+}
+
+func reviewPeriodToProto(period string) performancepb.ReviewPeriod {
+	switch period {
+	case PeriodQuarterly:
+		return performancepb.ReviewPeriod_REVIEW_PERIOD_QUARTERLY
+	case PeriodHalfYearly:
+		return performancepb.ReviewPeriod_REVIEW_PERIOD_HALF_YEARLY
+	case PeriodAnnual:
+		return performancepb.ReviewPeriod_REVIEW_PERIOD_ANNUAL
+	case PeriodProbation:
+		return performancepb.ReviewPeriod_REVIEW_PERIOD_PROBATION
+	default:
+		return performancepb.ReviewPeriod_REVIEW_PERIOD_UNSPECIFIED
+	}
+}
+
+func reviewStatusToProto(status string) performancepb.ReviewStatus {
+	switch status {
+	case StatusDraft:
+		return performancepb.ReviewStatus_REVIEW_STATUS_DRAFT
+	case StatusSubmitted:
+		return performancepb.ReviewStatus_REVIEW_STATUS_SUBMITTED
+	case StatusCompleted:
+		return performancepb.ReviewStatus_REVIEW_STATUS_COMPLETED
+	case StatusArchived:
+		return performancepb.ReviewStatus_REVIEW_STATUS_ARCHIVED
+	default:
+		return performancepb.ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+func goalStatusToProto(status string) performancepb.GoalStatus {
+	switch status {
+	case GoalNotStarted:
+		return performancepb.GoalStatus_GOAL_STATUS_NOT_STARTED
+	case GoalInProgress:
+		return performancepb.GoalStatus_GOAL_STATUS_IN_PROGRESS
+	case GoalCompleted:
+		return performancepb.GoalStatus_GOAL_STATUS_COMPLETED
+	case GoalExceeded:
+		return performancepb.GoalStatus_GOAL_STATUS_EXCEEDED
+	case GoalNotAchieved:
+		return performancepb.GoalStatus_GOAL_STATUS_NOT_ACHIEVED
+	default:
+		return performancepb.GoalStatus_GOAL_STATUS_UNSPECIFIED
+	}
+}
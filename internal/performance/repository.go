@@ -0,0 +1,274 @@
+package performance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrReviewNotFound     = errors.New("performance review not found")
+	ErrEmployeeNotFound   = errors.New("employee not found")
+	ErrReviewNotDraft     = errors.New("performance review is not a draft")
+	ErrReviewChanged      = errors.New("performance review was changed by someone else")
+	ErrGoalNotFound       = errors.New("goal not found in performance review")
+	ErrCompetencyNotFound = errors.New("competency not found in performance review")
+)
+
+type Repository interface {
+	// Create saves the review together with its goals and competencies
+	Create(ctx context.Context, review *Review) error
+	GetByID(ctx context.Context, id string) (*Review, error)
+	// Update saves the overall rating and comments of a draft review and replaces its
+	// goals and competencies, keeping the ids of those updated
+	Update(ctx context.Context, review *Review) error
+	// Delete removes a draft review
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error)
+	// UpdateStatus moves the review from one status to the next, ErrReviewChanged
+	// when it is no longer in the status it moves from
+	UpdateStatus(ctx context.Context, id, from, to string) error
+	GetEmployee(ctx context.Context, id string) (*Employee, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, review *Review) error {
+	if err := r.db.WithContext(ctx).Create(review).Error; err != nil {
+		return fmt.Errorf("failed to create performance review: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetByID(ctx context.Context, id string) (*Review, error) {
+	var review Review
+	err := r.db.WithContext(ctx).
+		Preload("Employee").
+		Preload("Reviewer").
+		Preload("Goals", inCreationOrder).
+		Preload("Competencies", inCreationOrder).
+		Where("id = ?", id).
+		First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("performance review by id (%s): %w", id, ErrReviewNotFound)
+		}
+		return nil, fmt.Errorf("failed to get performance review by ID %s: %w", id, err)
+	}
+	return &review, nil
+}
+
+func (r *repository) Update(ctx context.Context, review *Review) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(review).
+			Where("status = ?", StatusDraft).
+			Select("overall_rating", "overall_comments").
+			Updates(review)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update performance review: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return r.statusError(ctx, review.ID, ErrReviewNotDraft)
+		}
+
+		if err := saveGoals(tx, review.ID, review.Goals); err != nil {
+			return err
+		}
+		return saveCompetencies(tx, review.ID, review.Competencies)
+	})
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND status = ?", id, StatusDraft).Delete(&Review{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete performance review with id %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return r.statusError(ctx, id, ErrReviewNotDraft)
+	}
+	return nil
+}
+
+func (r *repository) List(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error) {
+	var reviews []*Review
+	var totalCount int64
+
+	query := r.db.WithContext(ctx).Model(&Review{}).
+		Preload("Employee").
+		Preload("Reviewer").
+		Preload("Goals", inCreationOrder).
+		Preload("Competencies", inCreationOrder)
+
+	if req.EmployeeID != "" {
+		query = query.Where("employee_id = ?", req.EmployeeID)
+	}
+	if req.ReviewerID != "" {
+		query = query.Where("reviewer_id = ?", req.ReviewerID)
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+	if req.ReviewPeriod != "" {
+		query = query.Where("review_period = ?", req.ReviewPeriod)
+	}
+	if req.CallerID != "" {
+		query = query.Where("NOT (employee_id = ? AND status = ?)", req.CallerID, StatusDraft)
+	}
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, fmt.Errorf("failed to count performance reviews: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	if err := query.Offset(offset).Limit(req.PageSize).Order("review_date DESC, created_at DESC").Find(&reviews).Error; err != nil {
+		return nil, fmt.Errorf("failed to list performance reviews: %w", err)
+	}
+
+	return &ListReviewsResponse{
+		Reviews:    reviews,
+		TotalCount: totalCount,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}, nil
+}
+
+func (r *repository) UpdateStatus(ctx context.Context, id, from, to string) error {
+	updates := map[string]any{"status": to}
+	if to == StatusSubmitted {
+		updates["submitted_at"] = time.Now()
+	}
+
+	result := r.db.WithContext(ctx).Model(&Review{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to set status of performance review %s to %s: %w", id, to, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return r.statusError(ctx, id, ErrReviewChanged)
+	}
+	return nil
+}
+
+func (r *repository) GetEmployee(ctx context.Context, id string) (*Employee, error) {
+	var employee Employee
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&employee).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("employee %s: %w", id, ErrEmployeeNotFound)
+		}
+		return nil, fmt.Errorf("failed to get employee %s: %w", id, err)
+	}
+	return &employee, nil
+}
+
+// statusError tells a review that is missing from one in the wrong status when a
+// guarded write matched nothing
+func (r *repository) statusError(ctx context.Context, id string, wrongStatus error) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&Review{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check performance review %s: %w", id, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("performance review by id (%s): %w", id, ErrReviewNotFound)
+	}
+	return fmt.Errorf("performance review %s: %w", id, wrongStatus)
+}
+
+// saveGoals makes goals the goals of the review. Goals with an id must already
+// belong to the review, goals without one are added and the others removed.
+func saveGoals(tx *gorm.DB, reviewID string, goals []*Goal) error {
+	removed := tx.Where("review_id = ?", reviewID)
+	if kept := goalIDs(goals); len(kept) > 0 {
+		removed = removed.Where("id NOT IN ?", kept)
+	}
+	if err := removed.Delete(&Goal{}).Error; err != nil {
+		return fmt.Errorf("failed to remove goals: %w", err)
+	}
+
+	for _, goal := range goals {
+		goal.ReviewID = reviewID
+		if goal.ID == "" {
+			if err := tx.Create(goal).Error; err != nil {
+				return fmt.Errorf("failed to add goal: %w", err)
+			}
+			continue
+		}
+
+		result := tx.Model(goal).
+			Where("review_id = ?", reviewID).
+			Select("title", "description", "target_value", "achieved_value", "unit", "status", "weight", "comments").
+			Updates(goal)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update goal %s: %w", goal.ID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("goal %s: %w", goal.ID, ErrGoalNotFound)
+		}
+	}
+	return nil
+}
+
+// saveCompetencies makes competencies the competencies of the review the way saveGoals does for goals
+func saveCompetencies(tx *gorm.DB, reviewID string, competencies []*Competency) error {
+	removed := tx.Where("review_id = ?", reviewID)
+	if kept := competencyIDs(competencies); len(kept) > 0 {
+		removed = removed.Where("id NOT IN ?", kept)
+	}
+	if err := removed.Delete(&Competency{}).Error; err != nil {
+		return fmt.Errorf("failed to remove competencies: %w", err)
+	}
+
+	for _, competency := range competencies {
+		competency.ReviewID = reviewID
+		if competency.ID == "" {
+			if err := tx.Create(competency).Error; err != nil {
+				return fmt.Errorf("failed to add competency: %w", err)
+			}
+			continue
+		}
+
+		result := tx.Model(competency).
+			Where("review_id = ?", reviewID).
+			Select("name", "description", "rating", "max_rating", "weight", "comments").
+			Updates(competency)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update competency %s: %w", competency.ID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("competency %s: %w", competency.ID, ErrCompetencyNotFound)
+		}
+	}
+	return nil
+}
+
+func goalIDs(goals []*Goal) []string {
+	var ids []string
+	for _, goal := range goals {
+		if goal.ID != "" {
+			ids = append(ids, goal.ID)
+		}
+	}
+	return ids
+}
+
+func competencyIDs(competencies []*Competency) []string {
+	var ids []string
+	for _, competency := range competencies {
+		if competency.ID != "" {
+			ids = append(ids, competency.ID)
+		}
+	}
+	return ids
+}
+
+// inCreationOrder keeps goals and competencies in the order they were added
+func inCreationOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("created_at, id")
+}
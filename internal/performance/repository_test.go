@@ -0,0 +1,109 @@
+package performance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/hr-management-system/internal/database/dbtest"
+	"github.com/dmehra2102/hr-management-system/internal/employee"
+	"gorm.io/gorm"
+)
+
+func createTestEmployee(t *testing.T, db *gorm.DB, role string) *employee.Employee {
+	t.Helper()
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	emp := &employee.Employee{
+		EmployeeID: "EMP-" + suffix,
+		FirstName:  "Test",
+		LastName:   "Employee",
+		Email:      suffix + "@example.com",
+		HireDate:   time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC),
+		Status:     "ACTIVE",
+		Role:       role,
+	}
+	if err := employee.NewRepository(db).Create(context.Background(), emp); err != nil {
+		t.Fatalf("failed to create employee: %v", err)
+	}
+	return emp
+}
+
+func createTestReview(t *testing.T, db *gorm.DB, repo Repository) *Review {
+	t.Helper()
+
+	review := &Review{
+		EmployeeID:   createTestEmployee(t, db, "EMPLOYEE").ID,
+		ReviewerID:   createTestEmployee(t, db, "MANAGER").ID,
+		ReviewPeriod: PeriodAnnual,
+		ReviewDate:   time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+		Status:       StatusDraft,
+		Goals:        []*Goal{{Title: "Ship the release", Status: GoalInProgress, Weight: 1}},
+	}
+	if err := repo.Create(context.Background(), review); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return review
+}
+
+func TestUpdateStatusIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	review := createTestReview(t, db, repo)
+
+	// A change made from a status the review already left is refused
+	if err := repo.UpdateStatus(ctx, review.ID, StatusSubmitted, StatusCompleted); !errors.Is(err, ErrReviewChanged) {
+		t.Fatalf("UpdateStatus() from a stale status error = %v, want %v", err, ErrReviewChanged)
+	}
+
+	if err := repo.UpdateStatus(ctx, review.ID, StatusDraft, StatusSubmitted); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+	stored, err := repo.GetByID(ctx, review.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if stored.Status != StatusSubmitted || stored.SubmittedAt == nil {
+		t.Errorf("review = %s submitted at %v, want %s with the time it was submitted", stored.Status, stored.SubmittedAt, StatusSubmitted)
+	}
+
+	if err := repo.UpdateStatus(ctx, "00000000-0000-0000-0000-000000000000", StatusDraft, StatusSubmitted); !errors.Is(err, ErrReviewNotFound) {
+		t.Errorf("UpdateStatus() of a missing review error = %v, want %v", err, ErrReviewNotFound)
+	}
+}
+
+func TestUpdateAndDeleteOnlyDraftsIntegration(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+	repo := NewRepository(db)
+	review := createTestReview(t, db, repo)
+
+	rating := 4.0
+	review.OverallRating = &rating
+	if err := repo.Update(ctx, review); err != nil {
+		t.Fatalf("Update() of a draft error = %v", err)
+	}
+
+	if err := repo.UpdateStatus(ctx, review.ID, StatusDraft, StatusSubmitted); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+
+	review.OverallComments = "Rewritten after submission"
+	if err := repo.Update(ctx, review); !errors.Is(err, ErrReviewNotDraft) {
+		t.Errorf("Update() of a submitted review error = %v, want %v", err, ErrReviewNotDraft)
+	}
+	if err := repo.Delete(ctx, review.ID); !errors.Is(err, ErrReviewNotDraft) {
+		t.Errorf("Delete() of a submitted review error = %v, want %v", err, ErrReviewNotDraft)
+	}
+
+	stored, err := repo.GetByID(ctx, review.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if stored.OverallComments != "" || len(stored.Goals) != 1 {
+		t.Errorf("submitted review has comments %q and %d goals, want it unchanged", stored.OverallComments, len(stored.Goals))
+	}
+}
@@ -0,0 +1,393 @@
+package performance
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/dmehra2102/hr-management-system/internal/auth"
+	"github.com/dmehra2102/hr-management-system/internal/holiday"
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service interface {
+	CreatePerformanceReview(ctx context.Context, req *CreateReviewRequest) (*Review, error)
+	// GetPerformanceReview hides drafts of the caller's own review
+	GetPerformanceReview(ctx context.Context, id, callerID string) (*Review, error)
+	UpdatePerformanceReview(ctx context.Context, id string, req *UpdateReviewRequest) (*Review, error)
+	DeletePerformanceReview(ctx context.Context, id string) error
+	ListPerformanceReviews(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error)
+	GetEmployeePerformanceHistory(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error)
+	SubmitPerformanceReview(ctx context.Context, id string) (*Review, error)
+	// CompletePerformanceReview signs a submitted review off, neither the employee
+	// reviewed nor the reviewer can
+	CompletePerformanceReview(ctx context.Context, id, callerID string) (*Review, error)
+	ArchivePerformanceReview(ctx context.Context, id string) (*Review, error)
+}
+
+type service struct {
+	repo   Repository
+	logger *logger.Logger
+}
+
+func NewService(repo Repository, logger *logger.Logger) Service {
+	return &service{
+		repo:   repo,
+		logger: logger.ServiceLogger("performance"),
+	}
+}
+
+func (s *service) CreatePerformanceReview(ctx context.Context, req *CreateReviewRequest) (*Review, error) {
+	s.logger.Info("Creating performance review", "employee_id", req.EmployeeID, "reviewer_id", req.ReviewerID, "review_period", req.ReviewPeriod)
+
+	if req.EmployeeID == "" || req.ReviewerID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID and reviewer ID are required")
+	}
+	if req.EmployeeID == req.ReviewerID {
+		return nil, status.Error(codes.InvalidArgument, "Employees cannot review themselves")
+	}
+	if req.ReviewPeriod == "" {
+		return nil, status.Error(codes.InvalidArgument, "Review period is required")
+	}
+	if req.ReviewDate.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "Review date is required")
+	}
+	if err := validateGoals(req.Goals); err != nil {
+		return nil, err
+	}
+	if err := validateCompetencies(req.Competencies); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkEmployee(ctx, req.EmployeeID); err != nil {
+		return nil, err
+	}
+	reviewer, err := s.checkEmployee(ctx, req.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if reviewer.Role != auth.RoleManager && reviewer.Role != auth.RoleHR && reviewer.Role != auth.RoleAdmin {
+		return nil, status.Error(codes.FailedPrecondition, "Reviewer must be a manager, HR or admin")
+	}
+
+	review := &Review{
+		EmployeeID:   req.EmployeeID,
+		ReviewerID:   req.ReviewerID,
+		ReviewPeriod: req.ReviewPeriod,
+		ReviewDate:   holiday.DateOf(req.ReviewDate),
+		Status:       StatusDraft,
+		Goals:        req.Goals,
+		Competencies: req.Competencies,
+	}
+	if err := s.repo.Create(ctx, review); err != nil {
+		s.logger.Error("Failed to create performance review", "employee_id", req.EmployeeID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create performance review")
+	}
+
+	s.logger.Info("Performance review created successfully", "id", review.ID)
+	return s.getReview(ctx, review.ID)
+}
+
+func (s *service) GetPerformanceReview(ctx context.Context, id, callerID string) (*Review, error) {
+	s.logger.Info("Getting performance review", "id", id)
+
+	review, err := s.getReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !review.VisibleTo(callerID) {
+		return nil, statusFromError(ErrReviewNotFound, "Failed to get performance review")
+	}
+	return review, nil
+}
+
+func (s *service) UpdatePerformanceReview(ctx context.Context, id string, req *UpdateReviewRequest) (*Review, error) {
+	s.logger.Info("Updating performance review", "id", id)
+
+	if req.OverallRating < 0 || req.OverallRating > MaxRating {
+		return nil, status.Error(codes.InvalidArgument, "Overall rating must be between 0 and 5")
+	}
+	if err := validateGoals(req.Goals); err != nil {
+		return nil, err
+	}
+	if err := validateCompetencies(req.Competencies); err != nil {
+		return nil, err
+	}
+
+	review, err := s.getReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != StatusDraft {
+		return nil, statusFromError(ErrReviewNotDraft, "Failed to update performance review")
+	}
+
+	if len(req.Goals) > 0 {
+		if err := checkGoalIDs(review.Goals, req.Goals); err != nil {
+			return nil, statusFromError(err, "Failed to update performance review")
+		}
+		review.Goals = req.Goals
+	}
+	if len(req.Competencies) > 0 {
+		if err := checkCompetencyIDs(review.Competencies, req.Competencies); err != nil {
+			return nil, statusFromError(err, "Failed to update performance review")
+		}
+		review.Competencies = req.Competencies
+	}
+	if req.OverallComments != "" {
+		review.OverallComments = req.OverallComments
+	}
+	if req.OverallRating != 0 {
+		review.OverallRating = &req.OverallRating
+	}
+
+	if err := s.repo.Update(ctx, review); err != nil {
+		s.logger.Error("Failed to update performance review", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to update performance review")
+	}
+
+	s.logger.Info("Performance review updated successfully", "id", id)
+	return s.getReview(ctx, id)
+}
+
+func (s *service) DeletePerformanceReview(ctx context.Context, id string) error {
+	s.logger.Info("Deleting performance review", "id", id)
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.logger.Error("Failed to delete performance review", "id", id, "error", err)
+		return statusFromError(err, "Failed to delete performance review")
+	}
+
+	s.logger.Info("Performance review deleted successfully", "id", id)
+	return nil
+}
+
+func (s *service) ListPerformanceReviews(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error) {
+	s.logger.Info("Listing performance reviews", "page", req.Page, "page_size", req.PageSize, "employee_id", req.EmployeeID, "reviewer_id", req.ReviewerID)
+
+	normalizePage(req)
+
+	response, err := s.repo.List(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list performance reviews", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list performance reviews")
+	}
+
+	s.logger.Info("Successfully listed performance reviews", "count", len(response.Reviews), "total", response.TotalCount)
+	return response, nil
+}
+
+func (s *service) GetEmployeePerformanceHistory(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error) {
+	s.logger.Info("Getting employee performance history", "employee_id", req.EmployeeID, "page", req.Page, "page_size", req.PageSize)
+
+	if req.EmployeeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Employee ID is required")
+	}
+	if _, err := s.checkEmployee(ctx, req.EmployeeID); err != nil {
+		return nil, err
+	}
+
+	normalizePage(req)
+
+	response, err := s.repo.List(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to get employee performance history", "employee_id", req.EmployeeID, "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get employee performance history")
+	}
+	return response, nil
+}
+
+func (s *service) SubmitPerformanceReview(ctx context.Context, id string) (*Review, error) {
+	s.logger.Info("Submitting performance review", "id", id)
+
+	review, err := s.getReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status == StatusDraft {
+		if len(review.Goals) == 0 && len(review.Competencies) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "Add goals or competencies before submitting the performance review")
+		}
+		if review.OverallRating == nil {
+			return nil, status.Error(codes.FailedPrecondition, "Rate the performance review before submitting it")
+		}
+	}
+	return s.advance(ctx, review, StatusSubmitted)
+}
+
+func (s *service) CompletePerformanceReview(ctx context.Context, id, callerID string) (*Review, error) {
+	s.logger.Info("Completing performance review", "id", id, "caller_id", callerID)
+
+	review, err := s.getReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if callerID == review.EmployeeID || callerID == review.ReviewerID {
+		return nil, status.Error(codes.PermissionDenied, "Performance reviews must be completed by someone other than the employee or the reviewer")
+	}
+	return s.advance(ctx, review, StatusCompleted)
+}
+
+func (s *service) ArchivePerformanceReview(ctx context.Context, id string) (*Review, error) {
+	s.logger.Info("Archiving performance review", "id", id)
+
+	review, err := s.getReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.advance(ctx, review, StatusArchived)
+}
+
+// advance moves the review on to status to, which must be the one following its current status
+func (s *service) advance(ctx context.Context, review *Review, to string) (*Review, error) {
+	if nextStatus[review.Status] != to {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot move performance review from %s to %s", review.Status, to)
+	}
+
+	if err := s.repo.UpdateStatus(ctx, review.ID, review.Status, to); err != nil {
+		s.logger.Error("Failed to update performance review status", "id", review.ID, "status", to, "error", err)
+		return nil, statusFromError(err, "Failed to update performance review status")
+	}
+
+	s.logger.Info("Performance review status changed", "id", review.ID, "from", review.Status, "to", to)
+	return s.getReview(ctx, review.ID)
+}
+
+func (s *service) getReview(ctx context.Context, id string) (*Review, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Performance review ID is required")
+	}
+
+	review, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get performance review", "id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get performance review")
+	}
+	return review, nil
+}
+
+func (s *service) checkEmployee(ctx context.Context, id string) (*Employee, error) {
+	employee, err := s.repo.GetEmployee(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get employee", "employee_id", id, "error", err)
+		return nil, statusFromError(err, "Failed to get employee")
+	}
+	return employee, nil
+}
+
+func normalizePage(req *ListReviewsRequest) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+}
+
+// validateGoals checks the goals and fills in the status and weight of goals without one
+func validateGoals(goals []*Goal) error {
+	for _, goal := range goals {
+		goal.Title = strings.TrimSpace(goal.Title)
+		if goal.Title == "" {
+			return status.Error(codes.InvalidArgument, "Goal title is required")
+		}
+		if len(goal.Title) > 255 {
+			return status.Error(codes.InvalidArgument, "Goal title must be at most 255 characters")
+		}
+		if len(goal.Unit) > 50 {
+			return status.Error(codes.InvalidArgument, "Goal unit must be at most 50 characters")
+		}
+		if goal.Status == "" {
+			goal.Status = GoalNotStarted
+		}
+		if goal.Weight == 0 {
+			goal.Weight = 1
+		}
+		if goal.Weight < 0 || goal.Weight > 1 {
+			return status.Error(codes.InvalidArgument, "Goal weight must be between 0 and 1")
+		}
+	}
+	return nil
+}
+
+// validateCompetencies checks the competencies and fills in the max rating and weight of those without one
+func validateCompetencies(competencies []*Competency) error {
+	for _, competency := range competencies {
+		competency.Name = strings.TrimSpace(competency.Name)
+		if competency.Name == "" {
+			return status.Error(codes.InvalidArgument, "Competency name is required")
+		}
+		if len(competency.Name) > 255 {
+			return status.Error(codes.InvalidArgument, "Competency name must be at most 255 characters")
+		}
+		if competency.MaxRating == 0 {
+			competency.MaxRating = MaxRating
+		}
+		if competency.MaxRating < 0 || competency.MaxRating > MaxRating {
+			return status.Error(codes.InvalidArgument, "Competency max rating must be between 0 and 5")
+		}
+		if competency.Rating < 0 || competency.Rating > competency.MaxRating {
+			return status.Error(codes.InvalidArgument, "Competency rating must be between 0 and its max rating")
+		}
+		if competency.Weight == 0 {
+			competency.Weight = 1
+		}
+		if competency.Weight < 0 || competency.Weight > 1 {
+			return status.Error(codes.InvalidArgument, "Competency weight must be between 0 and 1")
+		}
+	}
+	return nil
+}
+
+// checkGoalIDs makes sure the goals being updated belong to the review
+func checkGoalIDs(current, updated []*Goal) error {
+	ids := make(map[string]bool, len(current))
+	for _, goal := range current {
+		ids[goal.ID] = true
+	}
+	for _, goal := range updated {
+		if goal.ID != "" && !ids[goal.ID] {
+			return ErrGoalNotFound
+		}
+	}
+	return nil
+}
+
+// checkCompetencyIDs makes sure the competencies being updated belong to the review
+func checkCompetencyIDs(current, updated []*Competency) error {
+	ids := make(map[string]bool, len(current))
+	for _, competency := range current {
+		ids[competency.ID] = true
+	}
+	for _, competency := range updated {
+		if competency.ID != "" && !ids[competency.ID] {
+			return ErrCompetencyNotFound
+		}
+	}
+	return nil
+}
+
+// statusFromError maps repository errors to gRPC status errors
+func statusFromError(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, ErrReviewNotFound):
+		return status.Error(codes.NotFound, "Performance review not found")
+	case errors.Is(err, ErrEmployeeNotFound):
+		return status.Error(codes.NotFound, "Employee not found")
+	case errors.Is(err, ErrReviewNotDraft):
+		return status.Error(codes.FailedPrecondition, "Only draft performance reviews can be changed or deleted")
+	case errors.Is(err, ErrReviewChanged):
+		return status.Error(codes.Aborted, "Performance review was changed in the meantime, reload it and try again")
+	case errors.Is(err, ErrGoalNotFound):
+		return status.Error(codes.NotFound, "Goal not found in performance review")
+	case errors.Is(err, ErrCompetencyNotFound):
+		return status.Error(codes.NotFound, "Competency not found in performance review")
+	default:
+		return status.Error(codes.Internal, internalMessage)
+	}
+}
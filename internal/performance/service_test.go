@@ -0,0 +1,241 @@
+package performance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dmehra2102/hr-management-system/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubRepository keeps a single review with the guards of the database repository
+type stubRepository struct {
+	Repository
+	review *Review
+	// moved is every status change made, as from and to
+	moved [][2]string
+}
+
+func (r *stubRepository) GetByID(ctx context.Context, id string) (*Review, error) {
+	if r.review == nil || r.review.ID != id {
+		return nil, ErrReviewNotFound
+	}
+	copied := *r.review
+	return &copied, nil
+}
+
+func (r *stubRepository) UpdateStatus(ctx context.Context, id, from, to string) error {
+	if r.review.Status != from {
+		return ErrReviewChanged
+	}
+	r.review.Status = to
+	r.moved = append(r.moved, [2]string{from, to})
+	return nil
+}
+
+func (r *stubRepository) Update(ctx context.Context, review *Review) error {
+	if r.review.Status != StatusDraft {
+		return ErrReviewNotDraft
+	}
+	r.review = review
+	return nil
+}
+
+func (r *stubRepository) Delete(ctx context.Context, id string) error {
+	if r.review.Status != StatusDraft {
+		return ErrReviewNotDraft
+	}
+	r.review = nil
+	return nil
+}
+
+func newStubRepository(reviewStatus string) *stubRepository {
+	rating := 4.0
+	return &stubRepository{
+		review: &Review{
+			ID:            "review",
+			EmployeeID:    "employee",
+			ReviewerID:    "reviewer",
+			Status:        reviewStatus,
+			OverallRating: &rating,
+			Goals:         []*Goal{{ID: "goal", Title: "Ship the release"}},
+		},
+	}
+}
+
+func newTestService(repo Repository) Service {
+	return NewService(repo, logger.NewLogger("panic", "text"))
+}
+
+// transition calls the service method that moves the review to status to
+func transition(svc Service, id, to, callerID string) (*Review, error) {
+	ctx := context.Background()
+	switch to {
+	case StatusSubmitted:
+		return svc.SubmitPerformanceReview(ctx, id)
+	case StatusCompleted:
+		return svc.CompletePerformanceReview(ctx, id, callerID)
+	default:
+		return svc.ArchivePerformanceReview(ctx, id)
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		id       string
+		callerID string
+		want     codes.Code
+	}{
+		{name: "submitting a draft", from: StatusDraft, to: StatusSubmitted, want: codes.OK},
+		{name: "completing a submitted review", from: StatusSubmitted, to: StatusCompleted, callerID: "hr", want: codes.OK},
+		{name: "archiving a completed review", from: StatusCompleted, to: StatusArchived, want: codes.OK},
+		{name: "completing a draft", from: StatusDraft, to: StatusCompleted, callerID: "hr", want: codes.FailedPrecondition},
+		{name: "archiving a draft", from: StatusDraft, to: StatusArchived, want: codes.FailedPrecondition},
+		{name: "archiving a submitted review", from: StatusSubmitted, to: StatusArchived, want: codes.FailedPrecondition},
+		{name: "submitting a completed review", from: StatusCompleted, to: StatusSubmitted, want: codes.FailedPrecondition},
+		{name: "submitting a submitted review", from: StatusSubmitted, to: StatusSubmitted, want: codes.FailedPrecondition},
+		{name: "completing a completed review", from: StatusCompleted, to: StatusCompleted, callerID: "hr", want: codes.FailedPrecondition},
+		{name: "archiving an archived review", from: StatusArchived, to: StatusArchived, want: codes.FailedPrecondition},
+		{name: "completing own review", from: StatusSubmitted, to: StatusCompleted, callerID: "employee", want: codes.PermissionDenied},
+		{name: "completing a review you wrote", from: StatusSubmitted, to: StatusCompleted, callerID: "reviewer", want: codes.PermissionDenied},
+		{name: "unknown review", from: StatusDraft, to: StatusSubmitted, id: "missing", want: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository(tt.from)
+			id := tt.id
+			if id == "" {
+				id = "review"
+			}
+
+			review, err := transition(newTestService(repo), id, tt.to, tt.callerID)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("moving to %s code = %v, want %v (error %v)", tt.to, got, tt.want, err)
+			}
+			if tt.want != codes.OK {
+				if len(repo.moved) != 0 {
+					t.Errorf("status moved %v, want it unchanged", repo.moved)
+				}
+				return
+			}
+
+			if review.Status != tt.to {
+				t.Errorf("status = %s, want %s", review.Status, tt.to)
+			}
+			if len(repo.moved) != 1 || repo.moved[0] != [2]string{tt.from, tt.to} {
+				t.Errorf("status moved %v, want once from %s to %s", repo.moved, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+// A review that moved on between reading it and changing its status is not moved again
+func TestAdvanceStaleStatus(t *testing.T) {
+	repo := &staleRepository{stubRepository: newStubRepository(StatusSubmitted)}
+
+	_, err := newTestService(repo).CompletePerformanceReview(context.Background(), "review", "hr")
+	if got := status.Code(err); got != codes.Aborted {
+		t.Fatalf("CompletePerformanceReview() code = %v, want %v (error %v)", got, codes.Aborted, err)
+	}
+	if repo.review.Status != StatusCompleted {
+		t.Errorf("status = %s, want the %s set in the meantime", repo.review.Status, StatusCompleted)
+	}
+}
+
+// staleRepository completes the review as soon as it was read
+type staleRepository struct {
+	*stubRepository
+}
+
+func (r *staleRepository) GetByID(ctx context.Context, id string) (*Review, error) {
+	review, err := r.stubRepository.GetByID(ctx, id)
+	r.review.Status = StatusCompleted
+	return review, err
+}
+
+func TestSubmitPerformanceReviewIncomplete(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(review *Review)
+	}{
+		{name: "without a rating", modify: func(review *Review) { review.OverallRating = nil }},
+		{name: "without goals or competencies", modify: func(review *Review) { review.Goals = nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubRepository(StatusDraft)
+			tt.modify(repo.review)
+
+			_, err := newTestService(repo).SubmitPerformanceReview(context.Background(), "review")
+			if got := status.Code(err); got != codes.FailedPrecondition {
+				t.Fatalf("SubmitPerformanceReview() code = %v, want %v (error %v)", got, codes.FailedPrecondition, err)
+			}
+			if repo.review.Status != StatusDraft {
+				t.Errorf("status = %s, want %s", repo.review.Status, StatusDraft)
+			}
+		})
+	}
+}
+
+func TestUpdateAndDeleteOnlyDrafts(t *testing.T) {
+	statuses := []string{StatusDraft, StatusSubmitted, StatusCompleted, StatusArchived}
+
+	for _, reviewStatus := range statuses {
+		want := codes.FailedPrecondition
+		if reviewStatus == StatusDraft {
+			want = codes.OK
+		}
+
+		t.Run("updating a "+reviewStatus+" review", func(t *testing.T) {
+			repo := newStubRepository(reviewStatus)
+
+			_, err := newTestService(repo).UpdatePerformanceReview(context.Background(), "review", &UpdateReviewRequest{OverallComments: "Strong year"})
+			if got := status.Code(err); got != want {
+				t.Fatalf("UpdatePerformanceReview() code = %v, want %v (error %v)", got, want, err)
+			}
+			if changed := repo.review.OverallComments == "Strong year"; changed != (want == codes.OK) {
+				t.Errorf("review updated = %v, want %v", changed, want == codes.OK)
+			}
+		})
+
+		t.Run("deleting a "+reviewStatus+" review", func(t *testing.T) {
+			repo := newStubRepository(reviewStatus)
+
+			err := newTestService(repo).DeletePerformanceReview(context.Background(), "review")
+			if got := status.Code(err); got != want {
+				t.Fatalf("DeletePerformanceReview() code = %v, want %v (error %v)", got, want, err)
+			}
+			if deleted := repo.review == nil; deleted != (want == codes.OK) {
+				t.Errorf("review deleted = %v, want %v", deleted, want == codes.OK)
+			}
+		})
+	}
+}
+
+func TestGetPerformanceReviewHidesOwnDraft(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		callerID string
+		want     codes.Code
+	}{
+		{name: "own draft", status: StatusDraft, callerID: "employee", want: codes.NotFound},
+		{name: "own submitted review", status: StatusSubmitted, callerID: "employee", want: codes.OK},
+		{name: "draft of the reviewer", status: StatusDraft, callerID: "reviewer", want: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestService(newStubRepository(tt.status)).GetPerformanceReview(context.Background(), "review", tt.callerID)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("GetPerformanceReview() code = %v, want %v (error %v)", got, tt.want, err)
+			}
+		})
+	}
+}